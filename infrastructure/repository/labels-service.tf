@@ -147,6 +147,7 @@ variable "service_labels" {
     "neptune",
     "networkfirewall",
     "networkmanager",
+    "opensearch",
     "opsworks",
     "opsworkscm",
     "organizations",
@@ -182,6 +183,7 @@ variable "service_labels" {
     "securityhub",
     "serverlessapplicationrepository",
     "servicecatalog",
+    "servicecatalogappregistry",
     "servicediscovery",
     "servicequotas",
     "ses",
@@ -99,7 +99,7 @@ var Provider *schema.Provider
 var testAccProviderConfigure sync.Once
 
 func init() {
-	Provider = provider.Provider()
+	Provider = newProvider()
 
 	Providers = map[string]*schema.Provider{
 		ProviderName: Provider,
@@ -108,16 +108,139 @@ func init() {
 	// Always allocate a new provider instance each invocation, otherwise gRPC
 	// ProviderConfigure() can overwrite configuration during concurrent testing.
 	ProviderFactories = map[string]func() (*schema.Provider, error){
-		ProviderName: func() (*schema.Provider, error) { return provider.Provider(), nil }, //nolint:unparam
+		ProviderName: func() (*schema.Provider, error) { return newProvider(), nil }, //nolint:unparam
 	}
 }
 
+// newProvider returns a new provider instance, configured to use the
+// endpoints overridden by TF_ACC_ENDPOINT_URL, if any.
+// A malformed TF_ACC_ENDPOINT_URL fails the test in PreCheck.
+func newProvider() *schema.Provider {
+	p := provider.Provider()
+
+	overrides, err := endpointOverrides(os.Getenv(conns.EnvVarAccEndpointURL))
+
+	if err != nil || len(overrides) == 0 {
+		return p
+	}
+
+	configure := p.ConfigureFunc
+	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		if err := setEndpointOverrides(d, overrides); err != nil {
+			return nil, err
+		}
+
+		return configure(d)
+	}
+
+	return p
+}
+
+// endpointOverrides parses a TF_ACC_ENDPOINT_URL value, either a single URL for
+// all services or comma-separated service=URL pairs, into a map of service to URL.
+func endpointOverrides(v string) (map[string]string, error) {
+	v = strings.TrimSpace(v)
+
+	if v == "" {
+		return nil, nil
+	}
+
+	overrides := make(map[string]string)
+
+	if !strings.Contains(v, "=") {
+		for _, service := range provider.EndpointServiceNames {
+			overrides[service] = v
+		}
+
+		return overrides, nil
+	}
+
+	for _, pair := range strings.Split(v, ",") {
+		pair = strings.TrimSpace(pair)
+
+		if pair == "" {
+			continue
+		}
+
+		parts := strings.SplitN(pair, "=", 2)
+		service, url := strings.TrimSpace(parts[0]), ""
+
+		if len(parts) == 2 {
+			url = strings.TrimSpace(parts[1])
+		}
+
+		if service == "" || url == "" {
+			return nil, fmt.Errorf("expected service=URL, got: %s", pair)
+		}
+
+		found := false
+		for _, name := range provider.EndpointServiceNames {
+			if name == service {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("unsupported endpoint service: %s", service)
+		}
+
+		overrides[service] = url
+	}
+
+	return overrides, nil
+}
+
+// setEndpointOverrides merges the endpoint overrides into the provider configuration.
+// Endpoints configured explicitly, e.g. by provider configuration tests, are kept.
+// Path-style S3 addressing is enabled and, unless STS is overridden, credential
+// validation and account ID lookup are skipped.
+func setEndpointOverrides(d *schema.ResourceData, overrides map[string]string) error {
+	m := make(map[string]interface{})
+
+	if v, ok := d.Get("endpoints").(*schema.Set); ok && v.Len() > 0 {
+		for k, v := range v.List()[0].(map[string]interface{}) {
+			m[k] = v
+		}
+	}
+
+	for k, v := range overrides {
+		if s, ok := m[k].(string); ok && s != "" {
+			continue
+		}
+
+		m[k] = v
+	}
+
+	if err := d.Set("endpoints", []interface{}{m}); err != nil {
+		return fmt.Errorf("error setting endpoints from %s: %w", conns.EnvVarAccEndpointURL, err)
+	}
+
+	settings := map[string]bool{
+		"s3_force_path_style":     true,
+		"skip_metadata_api_check": true,
+	}
+
+	if _, ok := overrides["sts"]; !ok {
+		settings["skip_credentials_validation"] = true
+		settings["skip_requesting_account_id"] = true
+	}
+
+	for k, v := range settings {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting %s from %s: %w", k, conns.EnvVarAccEndpointURL, err)
+		}
+	}
+
+	return nil
+}
+
 // FactoriesInit creates ProviderFactories for the provider under testing.
 func FactoriesInit(providers *[]*schema.Provider, providerNames []string) map[string]func() (*schema.Provider, error) {
 	var factories = make(map[string]func() (*schema.Provider, error), len(providerNames))
 
 	for _, name := range providerNames {
-		p := provider.Provider()
+		p := newProvider()
 
 		factories[name] = func() (*schema.Provider, error) { //nolint:unparam
 			return p, nil
@@ -190,6 +313,10 @@ func FactoriesMultipleRegion(providers *[]*schema.Provider, regions int) map[str
 // These verifications and configuration are preferred at this level to prevent
 // provider developers from experiencing less clear errors for every test.
 func PreCheck(t *testing.T) {
+	if _, err := endpointOverrides(os.Getenv(conns.EnvVarAccEndpointURL)); err != nil {
+		t.Fatalf("error parsing %s: %s", conns.EnvVarAccEndpointURL, err)
+	}
+
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
@@ -670,7 +797,17 @@ func ThirdRegionPartition() string {
 	return "aws"
 }
 
+// PreCheckEndpointsNotOverridden skips tests that require real AWS endpoints
+// when TF_ACC_ENDPOINT_URL is set, e.g. when running against LocalStack.
+func PreCheckEndpointsNotOverridden(t *testing.T) {
+	if v := os.Getenv(conns.EnvVarAccEndpointURL); v != "" {
+		t.Skipf("skipping tests; %s (%s) is set and this test requires AWS endpoints", conns.EnvVarAccEndpointURL, v)
+	}
+}
+
 func PreCheckAlternateAccount(t *testing.T) {
+	PreCheckEndpointsNotOverridden(t)
+
 	conns.SkipIfAllEnvVarEmpty(t, []string{conns.EnvVarAlternateProfile, conns.EnvVarAlternateAccessKeyId}, "credentials for running acceptance testing in alternate AWS account")
 
 	if os.Getenv(conns.EnvVarAlternateAccessKeyId) != "" {
@@ -741,7 +878,9 @@ func PreCheckPartition(partition string, t *testing.T) {
 }
 
 func PreCheckOrganizationsAccount(t *testing.T) {
-	conn := Provider.Meta().(*conns.AWSClient).OrganizationsConn
+	PreCheckEndpointsNotOverridden(t)
+
+	conn := Provider.Meta().(*conns.AWSClient).OrganizationsConn()
 	input := &organizations.DescribeOrganizationInput{}
 	_, err := conn.DescribeOrganization(input)
 	if tfawserr.ErrMessageContains(err, organizations.ErrCodeAWSOrganizationsNotInUseException, "") {
@@ -754,7 +893,9 @@ func PreCheckOrganizationsAccount(t *testing.T) {
 }
 
 func PreCheckOrganizationsEnabled(t *testing.T) {
-	conn := Provider.Meta().(*conns.AWSClient).OrganizationsConn
+	PreCheckEndpointsNotOverridden(t)
+
+	conn := Provider.Meta().(*conns.AWSClient).OrganizationsConn()
 	input := &organizations.DescribeOrganizationInput{}
 	_, err := conn.DescribeOrganization(input)
 	if tfawserr.ErrMessageContains(err, organizations.ErrCodeAWSOrganizationsNotInUseException, "") {
@@ -766,13 +907,15 @@ func PreCheckOrganizationsEnabled(t *testing.T) {
 }
 
 func PreCheckOrganizationManagementAccount(t *testing.T) {
-	organization, err := tforganizations.FindOrganization(Provider.Meta().(*conns.AWSClient).OrganizationsConn)
+	PreCheckEndpointsNotOverridden(t)
+
+	organization, err := tforganizations.FindOrganization(Provider.Meta().(*conns.AWSClient).OrganizationsConn())
 
 	if err != nil {
 		t.Fatalf("error describing AWS Organization: %s", err)
 	}
 
-	callerIdentity, err := tfsts.FindCallerIdentity(Provider.Meta().(*conns.AWSClient).STSConn)
+	callerIdentity, err := tfsts.FindCallerIdentity(Provider.Meta().(*conns.AWSClient).STSConn())
 
 	if err != nil {
 		t.Fatalf("error getting current identity: %s", err)
@@ -784,7 +927,7 @@ func PreCheckOrganizationManagementAccount(t *testing.T) {
 }
 
 func PreCheckHasIAMRole(t *testing.T, roleName string) {
-	conn := Provider.Meta().(*conns.AWSClient).IAMConn
+	conn := Provider.Meta().(*conns.AWSClient).IAMConn()
 
 	input := &iam.GetRoleInput{
 		RoleName: aws.String(roleName),
@@ -803,7 +946,7 @@ func PreCheckHasIAMRole(t *testing.T, roleName string) {
 }
 
 func PreCheckIAMServiceLinkedRole(t *testing.T, pathPrefix string) {
-	conn := Provider.Meta().(*conns.AWSClient).IAMConn
+	conn := Provider.Meta().(*conns.AWSClient).IAMConn()
 
 	input := &iam.ListRolesInput{
 		PathPrefix: aws.String(pathPrefix),
@@ -1220,8 +1363,8 @@ func CheckEndpoints(providers *[]*schema.Provider) resource.TestCheckFunc {
 			return fmt.Errorf("no providers initialized")
 		}
 
-		// Match conns.AWSClient struct field names to endpoint configuration names
-		endpointFieldNameF := func(endpoint string) func(string) bool {
+		// Match conns.AWSClient client method names to endpoint configuration names
+		endpointMethodNameF := func(endpoint string) func(string) bool {
 			return func(name string) bool {
 				switch endpoint {
 				case "applicationautoscaling":
@@ -1265,16 +1408,25 @@ func CheckEndpoints(providers *[]*schema.Provider) resource.TestCheckFunc {
 				continue
 			}
 
-			providerClient := provo.Meta().(*conns.AWSClient)
+			providerClient := reflect.ValueOf(provo.Meta().(*conns.AWSClient))
 
 			for _, endpointServiceName := range provider.EndpointServiceNames {
-				providerClientField := reflect.Indirect(reflect.ValueOf(providerClient)).FieldByNameFunc(endpointFieldNameF(endpointServiceName))
+				var providerClientMethod reflect.Value
+				matchesEndpoint := endpointMethodNameF(endpointServiceName)
 
-				if !providerClientField.IsValid() {
-					return fmt.Errorf("unable to match conns.AWSClient struct field name for endpoint name: %s", endpointServiceName)
+				for i := 0; i < providerClient.NumMethod(); i++ {
+					if matchesEndpoint(providerClient.Type().Method(i).Name) {
+						providerClientMethod = providerClient.Method(i)
+						break
+					}
+				}
+
+				if !providerClientMethod.IsValid() {
+					return fmt.Errorf("unable to match conns.AWSClient client method name for endpoint name: %s", endpointServiceName)
 				}
 
-				actualEndpoint := reflect.Indirect(reflect.Indirect(providerClientField).FieldByName("Config").FieldByName("Endpoint")).String()
+				providerClientConn := providerClientMethod.Call(nil)[0]
+				actualEndpoint := reflect.Indirect(reflect.Indirect(providerClientConn).FieldByName("Config").FieldByName("Endpoint")).String()
 				expectedEndpoint := fmt.Sprintf("http://%s", endpointServiceName)
 
 				if actualEndpoint != expectedEndpoint {
@@ -1534,7 +1686,7 @@ func PreCheckEC2ClassicOrHasDefaultVPCWithDefaultSubnets(t *testing.T) {
 
 // HasDefaultVPC returns whether the current AWS region has a default VPC.
 func HasDefaultVPC(t *testing.T) bool {
-	conn := Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	resp, err := conn.DescribeAccountAttributes(&ec2.DescribeAccountAttributesInput{
 		AttributeNames: aws.StringSlice([]string{ec2.AccountAttributeNameDefaultVpc}),
@@ -1554,7 +1706,7 @@ func HasDefaultVPC(t *testing.T) bool {
 
 // DefaultSubnetCount returns the number of default subnets in the current region's default VPC.
 func DefaultSubnetCount(t *testing.T) int {
-	conn := Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeSubnetsInput{
 		Filters: buildAttributeFilterList(map[string]string{
@@ -1810,6 +1962,8 @@ provider "aws" {
 }
 
 func PreCheckAssumeRoleARN(t *testing.T) {
+	PreCheckEndpointsNotOverridden(t)
+
 	conns.SkipIfEnvVarEmpty(t, conns.EnvVarAccAssumeRoleARN, "Amazon Resource Name (ARN) of existing IAM Role to assume for testing restricted permissions")
 }
 
@@ -1936,7 +2090,7 @@ func RandomEmailAddress(domainName string) string {
 }
 
 func PreCheckOutpostsOutposts(t *testing.T) {
-	conn := Provider.Meta().(*conns.AWSClient).OutpostsConn
+	conn := Provider.Meta().(*conns.AWSClient).OutpostsConn()
 
 	input := &outposts.ListOutpostsInput{}
 
@@ -1992,7 +2146,7 @@ func ACMCertificateRandomSubDomain(rootDomain string) string {
 
 func CheckACMPCACertificateAuthorityActivateCA(certificateAuthority *acmpca.CertificateAuthority) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := Provider.Meta().(*conns.AWSClient).ACMPCAConn
+		conn := Provider.Meta().(*conns.AWSClient).ACMPCAConn()
 
 		arn := aws.StringValue(certificateAuthority.Arn)
 
@@ -2049,7 +2203,7 @@ func CheckACMPCACertificateAuthorityActivateCA(certificateAuthority *acmpca.Cert
 
 func CheckACMPCACertificateAuthorityDisableCA(certificateAuthority *acmpca.CertificateAuthority) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := Provider.Meta().(*conns.AWSClient).ACMPCAConn
+		conn := Provider.Meta().(*conns.AWSClient).ACMPCAConn()
 
 		_, err := conn.UpdateCertificateAuthority(&acmpca.UpdateCertificateAuthorityInput{
 			CertificateAuthorityArn: certificateAuthority.Arn,
@@ -2067,7 +2221,7 @@ func CheckACMPCACertificateAuthorityExists(resourceName string, certificateAutho
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := Provider.Meta().(*conns.AWSClient).ACMPCAConn
+		conn := Provider.Meta().(*conns.AWSClient).ACMPCAConn()
 		input := &acmpca.DescribeCertificateAuthorityInput{
 			CertificateAuthorityArn: aws.String(rs.Primary.ID),
 		}
@@ -2096,7 +2250,7 @@ func PreCheckAPIGatewayTypeEDGE(t *testing.T) {
 }
 
 func PreCheckDirectoryService(t *testing.T) {
-	conn := Provider.Meta().(*conns.AWSClient).DirectoryServiceConn
+	conn := Provider.Meta().(*conns.AWSClient).DirectoryServiceConn()
 
 	input := &directoryservice.DescribeDirectoriesInput{}
 
@@ -2115,7 +2269,7 @@ func PreCheckDirectoryService(t *testing.T) {
 // and we do not have a good read-only way to determine this situation. Here we
 // opt to perform a creation that will fail so we can determine Simple AD support.
 func PreCheckDirectoryServiceSimpleDirectory(t *testing.T) {
-	conn := Provider.Meta().(*conns.AWSClient).DirectoryServiceConn
+	conn := Provider.Meta().(*conns.AWSClient).DirectoryServiceConn()
 
 	input := &directoryservice.CreateDirectoryInput{
 		Name:     aws.String("corp.example.com"),
@@ -2471,7 +2625,7 @@ func CheckVPCExists(n string, vpc *ec2.Vpc) resource.TestCheckFunc {
 			return fmt.Errorf("No VPC ID is set")
 		}
 
-		conn := Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := Provider.Meta().(*conns.AWSClient).EC2Conn()
 		DescribeVpcOpts := &ec2.DescribeVpcsInput{
 			VpcIds: []*string{aws.String(rs.Primary.ID)},
 		}
@@ -64,6 +64,80 @@ func TestReverseDns(t *testing.T) {
 	}
 }
 
+func TestEndpointOverrides(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         string
+		expected      map[string]string
+		expectedError bool
+	}{
+		{
+			name:  "empty",
+			input: "",
+		},
+		{
+			name:  "service pairs",
+			input: "firehose=http://localhost:4566, cognitoidp=http://localhost:4567",
+			expected: map[string]string{
+				"cognitoidp": "http://localhost:4567",
+				"firehose":   "http://localhost:4566",
+			},
+		},
+		{
+			name:          "missing URL",
+			input:         "firehose=",
+			expectedError: true,
+		},
+		{
+			name:          "unsupported service",
+			input:         "notaservice=http://localhost:4566",
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := endpointOverrides(testCase.input)
+
+			if err == nil && testCase.expectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.expectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if len(got) != len(testCase.expected) {
+				t.Fatalf("got %d endpoints, expected %d", len(got), len(testCase.expected))
+			}
+
+			for k, want := range testCase.expected {
+				if got := got[k]; got != want {
+					t.Errorf("got %s endpoint %q, expected %q", k, got, want)
+				}
+			}
+		})
+	}
+
+	t.Run("single URL", func(t *testing.T) {
+		got, err := endpointOverrides("http://localhost:4566")
+
+		if err != nil {
+			t.Fatalf("got unexpected error: %s", err)
+		}
+
+		if len(got) != len(provider.EndpointServiceNames) {
+			t.Fatalf("got %d endpoints, expected %d", len(got), len(provider.EndpointServiceNames))
+		}
+
+		for _, service := range provider.EndpointServiceNames {
+			if got := got[service]; got != "http://localhost:4566" {
+				t.Errorf("got %s endpoint %q, expected %q", service, got, "http://localhost:4566")
+			}
+		}
+	})
+}
+
 func TestAccNASAcctest_ProviderDefaultTags_emptyBlock(t *testing.T) {
 	var providers []*schema.Provider
 
@@ -174,7 +248,7 @@ func TestAccNASAcctest_Provider_endpoints(t *testing.T) {
 	}
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { PreCheck(t) },
+		PreCheck:          func() { PreCheck(t); PreCheckEndpointsNotOverridden(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
 		CheckDestroy:      nil,
@@ -437,7 +511,7 @@ func TestAccNASAcctest_ProviderAssumeRole_empty(t *testing.T) {
 	var providers []*schema.Provider
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { PreCheck(t) },
+		PreCheck:          func() { PreCheck(t); PreCheckEndpointsNotOverridden(t) },
 		ErrorCheck:        ErrorCheck(t),
 		ProviderFactories: FactoriesInternal(&providers),
 		CheckDestroy:      nil,
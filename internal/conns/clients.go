@@ -0,0 +1,1568 @@
+package conns
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go/service/appconfig"
+	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscalingplans"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/aws/aws-sdk-go/service/budgets"
+	"github.com/aws/aws-sdk-go/service/chime"
+	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/service/cloudsearch"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/codeartifact"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	"github.com/aws/aws-sdk-go/service/codestarnotifications"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/aws/aws-sdk-go/service/costandusagereportservice"
+	"github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go/service/dataexchange"
+	"github.com/aws/aws-sdk-go/service/datapipeline"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/aws/aws-sdk-go/service/devicefarm"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/elastictranscoder"
+	"github.com/aws/aws-sdk-go/service/elb"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/aws/aws-sdk-go/service/emrcontainers"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/forecastservice"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/aws/aws-sdk-go/service/greengrass"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/aws/aws-sdk-go/service/inspector"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/aws/aws-sdk-go/service/kinesisvideo"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/aws/aws-sdk-go/service/locationservice"
+	"github.com/aws/aws-sdk-go/service/macie"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/aws/aws-sdk-go/service/managedblockchain"
+	"github.com/aws/aws-sdk-go/service/marketplacecatalog"
+	"github.com/aws/aws-sdk-go/service/mediaconnect"
+	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/aws/aws-sdk-go/service/mediapackage"
+	"github.com/aws/aws-sdk-go/service/mediastore"
+	"github.com/aws/aws-sdk-go/service/mediastoredata"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/aws/aws-sdk-go/service/mq"
+	"github.com/aws/aws-sdk-go/service/mwaa"
+	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/outposts"
+	"github.com/aws/aws-sdk-go/service/personalize"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/aws-sdk-go/service/prometheusservice"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/aws/aws-sdk-go/service/quicksight"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/s3outposts"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/schemas"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/aws-sdk-go/service/ses"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/aws/aws-sdk-go/service/signer"
+	"github.com/aws/aws-sdk-go/service/simpledb"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/aws/aws-sdk-go/service/waf"
+	"github.com/aws/aws-sdk-go/service/wafregional"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/aws/aws-sdk-go/service/worklink"
+	"github.com/aws/aws-sdk-go/service/workmail"
+	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+// conn returns the client for the named service, creating it with newConn
+// from a copy of the session and the specified configuration on first use.
+func (client *AWSClient) conn(name string, config *aws.Config, newConn func(*session.Session) interface{}) interface{} {
+	client.connsMutex.Lock()
+	defer client.connsMutex.Unlock()
+
+	if conn, ok := client.conns[name]; ok {
+		return conn
+	}
+
+	conn := newConn(client.session.Copy(config))
+
+	if client.conns == nil {
+		client.conns = make(map[string]interface{})
+	}
+
+	client.conns[name] = conn
+
+	return conn
+}
+
+// serviceConfig returns the client configuration for the specified service endpoint.
+func (client *AWSClient) serviceConfig(endpoint string) *aws.Config {
+	return &aws.Config{
+		Endpoint:   aws.String(client.config.Endpoints[endpoint]),
+		MaxRetries: client.config.serviceMaxRetries(endpoint),
+	}
+}
+
+// globalServiceConfig returns the client configuration for a "global" service,
+// forcing the region of the service's API in the partition.
+func (client *AWSClient) globalServiceConfig(endpoint string) *aws.Config {
+	config := client.serviceConfig(endpoint)
+
+	switch client.Partition {
+	case endpoints.AwsPartitionID:
+		switch endpoint {
+		case "globalaccelerator", "route53recoverycontrolconfig", "route53recoveryreadiness":
+			config.Region = aws.String(endpoints.UsWest2RegionID)
+		case "route53", "shield":
+			config.Region = aws.String(endpoints.UsEast1RegionID)
+		}
+	case endpoints.AwsCnPartitionID:
+		if endpoint == "route53" {
+			// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
+			// This can likely be removed in the future.
+			if aws.StringValue(config.Endpoint) == "" {
+				config.Endpoint = aws.String("https://api.route53.cn")
+			}
+			config.Region = aws.String(endpoints.CnNorthwest1RegionID)
+		}
+	case endpoints.AwsUsGovPartitionID:
+		if endpoint == "route53" {
+			config.Region = aws.String(endpoints.UsGovWest1RegionID)
+		}
+	}
+
+	return config
+}
+
+// s3Config returns the client configuration for S3, which requires multiple client configurations.
+func (client *AWSClient) s3Config(disableURICleaning bool) *aws.Config {
+	config := client.serviceConfig("s3")
+	config.S3ForcePathStyle = aws.Bool(client.config.S3ForcePathStyle)
+
+	if disableURICleaning {
+		config.DisableRestProtocolURICleaning = aws.Bool(true)
+	}
+
+	return config
+}
+
+// Service clients are created on first use, so that only the services
+// referenced by the configuration are initialized.
+
+func (client *AWSClient) AccessAnalyzerConn() *accessanalyzer.AccessAnalyzer {
+	return client.conn("AccessAnalyzerConn", client.serviceConfig("accessanalyzer"), func(sess *session.Session) interface{} {
+		return accessanalyzer.New(sess)
+	}).(*accessanalyzer.AccessAnalyzer)
+}
+
+func (client *AWSClient) ACMConn() *acm.ACM {
+	return client.conn("ACMConn", client.serviceConfig("acm"), func(sess *session.Session) interface{} {
+		return acm.New(sess)
+	}).(*acm.ACM)
+}
+
+func (client *AWSClient) ACMPCAConn() *acmpca.ACMPCA {
+	return client.conn("ACMPCAConn", client.serviceConfig("acmpca"), func(sess *session.Session) interface{} {
+		return acmpca.New(sess)
+	}).(*acmpca.ACMPCA)
+}
+
+func (client *AWSClient) AmplifyConn() *amplify.Amplify {
+	return client.conn("AmplifyConn", client.serviceConfig("amplify"), func(sess *session.Session) interface{} {
+		return amplify.New(sess)
+	}).(*amplify.Amplify)
+}
+
+func (client *AWSClient) APIGatewayConn() *apigateway.APIGateway {
+	return client.conn("APIGatewayConn", client.serviceConfig("apigateway"), func(sess *session.Session) interface{} {
+		conn := apigateway.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// Many operations can return an error such as:
+			//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
+			// Handle them all globally for the service client.
+			if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*apigateway.APIGateway)
+}
+
+func (client *AWSClient) APIGatewayV2Conn() *apigatewayv2.ApiGatewayV2 {
+	return client.conn("APIGatewayV2Conn", client.serviceConfig("apigateway"), func(sess *session.Session) interface{} {
+		return apigatewayv2.New(sess)
+	}).(*apigatewayv2.ApiGatewayV2)
+}
+
+func (client *AWSClient) AppConfigConn() *appconfig.AppConfig {
+	return client.conn("AppConfigConn", client.serviceConfig("appconfig"), func(sess *session.Session) interface{} {
+		conn := appconfig.New(sess)
+
+		// StartDeployment operations can return a ConflictException
+		// if ongoing deployments are in-progress, thus we handle them
+		// here for the service client.
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "StartDeployment" {
+				if tfawserr.ErrCodeEquals(r.Error, appconfig.ErrCodeConflictException) {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*appconfig.AppConfig)
+}
+
+func (client *AWSClient) ApplicationAutoScalingConn() *applicationautoscaling.ApplicationAutoScaling {
+	return client.conn("ApplicationAutoScalingConn", client.serviceConfig("applicationautoscaling"), func(sess *session.Session) interface{} {
+		conn := applicationautoscaling.New(sess)
+
+		// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
+				return
+			}
+			if tfawserr.ErrCodeEquals(r.Error, applicationautoscaling.ErrCodeFailedResourceAccessException) {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*applicationautoscaling.ApplicationAutoScaling)
+}
+
+func (client *AWSClient) ApplicationInsightsConn() *applicationinsights.ApplicationInsights {
+	return client.conn("ApplicationInsightsConn", client.serviceConfig("applicationinsights"), func(sess *session.Session) interface{} {
+		return applicationinsights.New(sess)
+	}).(*applicationinsights.ApplicationInsights)
+}
+
+func (client *AWSClient) AppMeshConn() *appmesh.AppMesh {
+	return client.conn("AppMeshConn", client.serviceConfig("appmesh"), func(sess *session.Session) interface{} {
+		return appmesh.New(sess)
+	}).(*appmesh.AppMesh)
+}
+
+func (client *AWSClient) AppRunnerConn() *apprunner.AppRunner {
+	return client.conn("AppRunnerConn", client.serviceConfig("apprunner"), func(sess *session.Session) interface{} {
+		return apprunner.New(sess)
+	}).(*apprunner.AppRunner)
+}
+
+func (client *AWSClient) AppStreamConn() *appstream.AppStream {
+	return client.conn("AppStreamConn", client.serviceConfig("appstream"), func(sess *session.Session) interface{} {
+		return appstream.New(sess)
+	}).(*appstream.AppStream)
+}
+
+func (client *AWSClient) AppSyncConn() *appsync.AppSync {
+	return client.conn("AppSyncConn", client.serviceConfig("appsync"), func(sess *session.Session) interface{} {
+		conn := appsync.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateGraphqlApi" {
+				if tfawserr.ErrMessageContains(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*appsync.AppSync)
+}
+
+func (client *AWSClient) AthenaConn() *athena.Athena {
+	return client.conn("AthenaConn", client.serviceConfig("athena"), func(sess *session.Session) interface{} {
+		return athena.New(sess)
+	}).(*athena.Athena)
+}
+
+func (client *AWSClient) AuditManagerConn() *auditmanager.AuditManager {
+	return client.conn("AuditManagerConn", client.serviceConfig("auditmanager"), func(sess *session.Session) interface{} {
+		return auditmanager.New(sess)
+	}).(*auditmanager.AuditManager)
+}
+
+func (client *AWSClient) AutoScalingConn() *autoscaling.AutoScaling {
+	return client.conn("AutoScalingConn", client.serviceConfig("autoscaling"), func(sess *session.Session) interface{} {
+		return autoscaling.New(sess)
+	}).(*autoscaling.AutoScaling)
+}
+
+func (client *AWSClient) AutoScalingPlansConn() *autoscalingplans.AutoScalingPlans {
+	return client.conn("AutoScalingPlansConn", client.serviceConfig("autoscalingplans"), func(sess *session.Session) interface{} {
+		return autoscalingplans.New(sess)
+	}).(*autoscalingplans.AutoScalingPlans)
+}
+
+func (client *AWSClient) BackupConn() *backup.Backup {
+	return client.conn("BackupConn", client.serviceConfig("backup"), func(sess *session.Session) interface{} {
+		return backup.New(sess)
+	}).(*backup.Backup)
+}
+
+func (client *AWSClient) BatchConn() *batch.Batch {
+	return client.conn("BatchConn", client.serviceConfig("batch"), func(sess *session.Session) interface{} {
+		return batch.New(sess)
+	}).(*batch.Batch)
+}
+
+func (client *AWSClient) BudgetsConn() *budgets.Budgets {
+	return client.conn("BudgetsConn", client.serviceConfig("budgets"), func(sess *session.Session) interface{} {
+		return budgets.New(sess)
+	}).(*budgets.Budgets)
+}
+
+func (client *AWSClient) ChimeConn() *chime.Chime {
+	return client.conn("ChimeConn", client.serviceConfig("chime"), func(sess *session.Session) interface{} {
+		conn := chime.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// When calling CreateVoiceConnector across multiple resources,
+			// the API can randomly return a BadRequestException without explanation
+			if r.Operation.Name == "CreateVoiceConnector" {
+				if tfawserr.ErrMessageContains(r.Error, chime.ErrCodeBadRequestException, "Service received a bad request") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*chime.Chime)
+}
+
+func (client *AWSClient) Cloud9Conn() *cloud9.Cloud9 {
+	return client.conn("Cloud9Conn", client.serviceConfig("cloud9"), func(sess *session.Session) interface{} {
+		return cloud9.New(sess)
+	}).(*cloud9.Cloud9)
+}
+
+func (client *AWSClient) CloudControlConn() *cloudcontrolapi.CloudControlApi {
+	return client.conn("CloudControlConn", client.serviceConfig("cloudcontrolapi"), func(sess *session.Session) interface{} {
+		return cloudcontrolapi.New(sess)
+	}).(*cloudcontrolapi.CloudControlApi)
+}
+
+func (client *AWSClient) CloudFormationConn() *cloudformation.CloudFormation {
+	return client.conn("CloudFormationConn", client.serviceConfig("cloudformation"), func(sess *session.Session) interface{} {
+		conn := cloudformation.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, cloudformation.ErrCodeOperationInProgressException, "Another Operation on StackSet") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*cloudformation.CloudFormation)
+}
+
+func (client *AWSClient) CloudFrontConn() *cloudfront.CloudFront {
+	return client.conn("CloudFrontConn", client.serviceConfig("cloudfront"), func(sess *session.Session) interface{} {
+		return cloudfront.New(sess)
+	}).(*cloudfront.CloudFront)
+}
+
+func (client *AWSClient) CloudHSMV2Conn() *cloudhsmv2.CloudHSMV2 {
+	return client.conn("CloudHSMV2Conn", client.serviceConfig("cloudhsm"), func(sess *session.Session) interface{} {
+		conn := cloudhsmv2.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "request was rejected because of an AWS CloudHSM internal failure") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*cloudhsmv2.CloudHSMV2)
+}
+
+func (client *AWSClient) CloudSearchConn() *cloudsearch.CloudSearch {
+	return client.conn("CloudSearchConn", client.serviceConfig("cloudsearch"), func(sess *session.Session) interface{} {
+		return cloudsearch.New(sess)
+	}).(*cloudsearch.CloudSearch)
+}
+
+func (client *AWSClient) CloudTrailConn() *cloudtrail.CloudTrail {
+	return client.conn("CloudTrailConn", client.serviceConfig("cloudtrail"), func(sess *session.Session) interface{} {
+		return cloudtrail.New(sess)
+	}).(*cloudtrail.CloudTrail)
+}
+
+func (client *AWSClient) CloudWatchConn() *cloudwatch.CloudWatch {
+	return client.conn("CloudWatchConn", client.serviceConfig("cloudwatch"), func(sess *session.Session) interface{} {
+		return cloudwatch.New(sess)
+	}).(*cloudwatch.CloudWatch)
+}
+
+func (client *AWSClient) CloudWatchEventsConn() *cloudwatchevents.CloudWatchEvents {
+	return client.conn("CloudWatchEventsConn", client.serviceConfig("cloudwatchevents"), func(sess *session.Session) interface{} {
+		return cloudwatchevents.New(sess)
+	}).(*cloudwatchevents.CloudWatchEvents)
+}
+
+func (client *AWSClient) CloudWatchLogsConn() *cloudwatchlogs.CloudWatchLogs {
+	return client.conn("CloudWatchLogsConn", client.serviceConfig("cloudwatchlogs"), func(sess *session.Session) interface{} {
+		return cloudwatchlogs.New(sess)
+	}).(*cloudwatchlogs.CloudWatchLogs)
+}
+
+func (client *AWSClient) CodeArtifactConn() *codeartifact.CodeArtifact {
+	return client.conn("CodeArtifactConn", client.serviceConfig("codeartifact"), func(sess *session.Session) interface{} {
+		return codeartifact.New(sess)
+	}).(*codeartifact.CodeArtifact)
+}
+
+func (client *AWSClient) CodeBuildConn() *codebuild.CodeBuild {
+	return client.conn("CodeBuildConn", client.serviceConfig("codebuild"), func(sess *session.Session) interface{} {
+		return codebuild.New(sess)
+	}).(*codebuild.CodeBuild)
+}
+
+func (client *AWSClient) CodeCommitConn() *codecommit.CodeCommit {
+	return client.conn("CodeCommitConn", client.serviceConfig("codecommit"), func(sess *session.Session) interface{} {
+		return codecommit.New(sess)
+	}).(*codecommit.CodeCommit)
+}
+
+func (client *AWSClient) CodeDeployConn() *codedeploy.CodeDeploy {
+	return client.conn("CodeDeployConn", client.serviceConfig("codedeploy"), func(sess *session.Session) interface{} {
+		return codedeploy.New(sess)
+	}).(*codedeploy.CodeDeploy)
+}
+
+func (client *AWSClient) CodePipelineConn() *codepipeline.CodePipeline {
+	return client.conn("CodePipelineConn", client.serviceConfig("codepipeline"), func(sess *session.Session) interface{} {
+		return codepipeline.New(sess)
+	}).(*codepipeline.CodePipeline)
+}
+
+func (client *AWSClient) CodeStarConnectionsConn() *codestarconnections.CodeStarConnections {
+	return client.conn("CodeStarConnectionsConn", client.serviceConfig("codestarconnections"), func(sess *session.Session) interface{} {
+		return codestarconnections.New(sess)
+	}).(*codestarconnections.CodeStarConnections)
+}
+
+func (client *AWSClient) CodeStarNotificationsConn() *codestarnotifications.CodeStarNotifications {
+	return client.conn("CodeStarNotificationsConn", client.serviceConfig("codestarnotifications"), func(sess *session.Session) interface{} {
+		return codestarnotifications.New(sess)
+	}).(*codestarnotifications.CodeStarNotifications)
+}
+
+func (client *AWSClient) CognitoIdentityConn() *cognitoidentity.CognitoIdentity {
+	return client.conn("CognitoIdentityConn", client.serviceConfig("cognitoidentity"), func(sess *session.Session) interface{} {
+		return cognitoidentity.New(sess)
+	}).(*cognitoidentity.CognitoIdentity)
+}
+
+func (client *AWSClient) CognitoIDPConn() *cognitoidentityprovider.CognitoIdentityProvider {
+	return client.conn("CognitoIDPConn", client.serviceConfig("cognitoidp"), func(sess *session.Session) interface{} {
+		return cognitoidentityprovider.New(sess)
+	}).(*cognitoidentityprovider.CognitoIdentityProvider)
+}
+
+func (client *AWSClient) ConfigConn() *configservice.ConfigService {
+	return client.conn("ConfigConn", client.serviceConfig("configservice"), func(sess *session.Session) interface{} {
+		conn := configservice.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// When calling Config Organization Rules API actions immediately
+			// after Organization creation, the API can randomly return the
+			// OrganizationAccessDeniedException error for a few minutes, even
+			// after succeeding a few requests.
+			switch r.Operation.Name {
+			case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
+				if !tfawserr.ErrMessageContains(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
+					return
+				}
+
+				// We only want to retry briefly as the default max retry count would
+				// excessively retry when the error could be legitimate.
+				// We currently depend on the DefaultRetryer exponential backoff here.
+				// ~10 retries gives a fair backoff of a few seconds.
+				if r.RetryCount < 9 {
+					r.Retryable = aws.Bool(true)
+				} else {
+					r.Retryable = aws.Bool(false)
+				}
+			case "DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack":
+				if !tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeOrganizationAccessDeniedException) {
+					if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeResourceInUseException) {
+						r.Retryable = aws.Bool(true)
+					}
+					return
+				}
+
+				// We only want to retry briefly as the default max retry count would
+				// excessively retry when the error could be legitimate.
+				// We currently depend on the DefaultRetryer exponential backoff here.
+				// ~10 retries gives a fair backoff of a few seconds.
+				if r.RetryCount < 9 {
+					r.Retryable = aws.Bool(true)
+				} else {
+					r.Retryable = aws.Bool(false)
+				}
+			}
+		})
+
+		return conn
+	}).(*configservice.ConfigService)
+}
+
+func (client *AWSClient) ConnectConn() *connect.Connect {
+	return client.conn("ConnectConn", client.serviceConfig("connect"), func(sess *session.Session) interface{} {
+		return connect.New(sess)
+	}).(*connect.Connect)
+}
+
+func (client *AWSClient) CURConn() *costandusagereportservice.CostandUsageReportService {
+	return client.conn("CURConn", client.serviceConfig("cur"), func(sess *session.Session) interface{} {
+		return costandusagereportservice.New(sess)
+	}).(*costandusagereportservice.CostandUsageReportService)
+}
+
+func (client *AWSClient) DataExchangeConn() *dataexchange.DataExchange {
+	return client.conn("DataExchangeConn", client.serviceConfig("dataexchange"), func(sess *session.Session) interface{} {
+		return dataexchange.New(sess)
+	}).(*dataexchange.DataExchange)
+}
+
+func (client *AWSClient) DataPipelineConn() *datapipeline.DataPipeline {
+	return client.conn("DataPipelineConn", client.serviceConfig("datapipeline"), func(sess *session.Session) interface{} {
+		return datapipeline.New(sess)
+	}).(*datapipeline.DataPipeline)
+}
+
+func (client *AWSClient) DataSyncConn() *datasync.DataSync {
+	return client.conn("DataSyncConn", client.serviceConfig("datasync"), func(sess *session.Session) interface{} {
+		return datasync.New(sess)
+	}).(*datasync.DataSync)
+}
+
+func (client *AWSClient) DAXConn() *dax.DAX {
+	return client.conn("DAXConn", client.serviceConfig("dax"), func(sess *session.Session) interface{} {
+		return dax.New(sess)
+	}).(*dax.DAX)
+}
+
+func (client *AWSClient) DetectiveConn() *detective.Detective {
+	return client.conn("DetectiveConn", client.serviceConfig("detective"), func(sess *session.Session) interface{} {
+		return detective.New(sess)
+	}).(*detective.Detective)
+}
+
+func (client *AWSClient) DeviceFarmConn() *devicefarm.DeviceFarm {
+	return client.conn("DeviceFarmConn", client.serviceConfig("devicefarm"), func(sess *session.Session) interface{} {
+		return devicefarm.New(sess)
+	}).(*devicefarm.DeviceFarm)
+}
+
+func (client *AWSClient) DirectConnectConn() *directconnect.DirectConnect {
+	return client.conn("DirectConnectConn", client.serviceConfig("directconnect"), func(sess *session.Session) interface{} {
+		return directconnect.New(sess)
+	}).(*directconnect.DirectConnect)
+}
+
+func (client *AWSClient) DirectoryServiceConn() *directoryservice.DirectoryService {
+	return client.conn("DirectoryServiceConn", client.serviceConfig("ds"), func(sess *session.Session) interface{} {
+		return directoryservice.New(sess)
+	}).(*directoryservice.DirectoryService)
+}
+
+func (client *AWSClient) DLMConn() *dlm.DLM {
+	return client.conn("DLMConn", client.serviceConfig("dlm"), func(sess *session.Session) interface{} {
+		return dlm.New(sess)
+	}).(*dlm.DLM)
+}
+
+func (client *AWSClient) DMSConn() *databasemigrationservice.DatabaseMigrationService {
+	return client.conn("DMSConn", client.serviceConfig("dms"), func(sess *session.Session) interface{} {
+		return databasemigrationservice.New(sess)
+	}).(*databasemigrationservice.DatabaseMigrationService)
+}
+
+func (client *AWSClient) DocDBConn() *docdb.DocDB {
+	return client.conn("DocDBConn", client.serviceConfig("docdb"), func(sess *session.Session) interface{} {
+		return docdb.New(sess)
+	}).(*docdb.DocDB)
+}
+
+func (client *AWSClient) DynamoDBConn() *dynamodb.DynamoDB {
+	return client.conn("DynamoDBConn", client.serviceConfig("dynamodb"), newDynamoDBConn).(*dynamodb.DynamoDB)
+}
+
+// DynamoDBConnForRegion returns a DynamoDB client for the specified region,
+// used by resources that manage a global table from one of its replica regions.
+func (client *AWSClient) DynamoDBConnForRegion(region string) *dynamodb.DynamoDB {
+	if region == client.Region {
+		return client.DynamoDBConn()
+	}
+
+	config := client.serviceConfig("dynamodb")
+	config.Region = aws.String(region)
+
+	return client.conn("DynamoDBConn."+region, config, newDynamoDBConn).(*dynamodb.DynamoDB)
+}
+
+func newDynamoDBConn(sess *session.Session) interface{} {
+	conn := dynamodb.New(sess)
+
+	// See https://github.com/aws/aws-sdk-go/pull/1276
+	conn.Handlers.Retry.PushBack(func(r *request.Request) {
+		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
+			return
+		}
+		if tfawserr.ErrMessageContains(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
+			r.Retryable = aws.Bool(true)
+		}
+	})
+
+	return conn
+}
+
+func (client *AWSClient) EC2Conn() *ec2.EC2 {
+	return client.conn("EC2Conn", client.serviceConfig("ec2"), func(sess *session.Session) interface{} {
+		conn := ec2.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateClientVpnEndpoint" {
+				if tfawserr.ErrMessageContains(r.Error, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+
+			if r.Operation.Name == "CreateVpnConnection" {
+				if tfawserr.ErrMessageContains(r.Error, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+
+			if r.Operation.Name == "CreateVpnGateway" {
+				if tfawserr.ErrMessageContains(r.Error, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+
+			if r.Operation.Name == "AttachVpnGateway" || r.Operation.Name == "DetachVpnGateway" {
+				if tfawserr.ErrMessageContains(r.Error, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*ec2.EC2)
+}
+
+func (client *AWSClient) ECRConn() *ecr.ECR {
+	return client.conn("ECRConn", client.serviceConfig("ecr"), func(sess *session.Session) interface{} {
+		return ecr.New(sess)
+	}).(*ecr.ECR)
+}
+
+func (client *AWSClient) ECRPublicConn() *ecrpublic.ECRPublic {
+	return client.conn("ECRPublicConn", client.serviceConfig("ecrpublic"), func(sess *session.Session) interface{} {
+		return ecrpublic.New(sess)
+	}).(*ecrpublic.ECRPublic)
+}
+
+func (client *AWSClient) ECSConn() *ecs.ECS {
+	return client.conn("ECSConn", client.serviceConfig("ecs"), func(sess *session.Session) interface{} {
+		return ecs.New(sess)
+	}).(*ecs.ECS)
+}
+
+func (client *AWSClient) EFSConn() *efs.EFS {
+	return client.conn("EFSConn", client.serviceConfig("efs"), func(sess *session.Session) interface{} {
+		return efs.New(sess)
+	}).(*efs.EFS)
+}
+
+func (client *AWSClient) EKSConn() *eks.EKS {
+	return client.conn("EKSConn", client.serviceConfig("eks"), func(sess *session.Session) interface{} {
+		return eks.New(sess)
+	}).(*eks.EKS)
+}
+
+func (client *AWSClient) ElastiCacheConn() *elasticache.ElastiCache {
+	return client.conn("ElastiCacheConn", client.serviceConfig("elasticache"), func(sess *session.Session) interface{} {
+		return elasticache.New(sess)
+	}).(*elasticache.ElastiCache)
+}
+
+func (client *AWSClient) ElasticBeanstalkConn() *elasticbeanstalk.ElasticBeanstalk {
+	return client.conn("ElasticBeanstalkConn", client.serviceConfig("elasticbeanstalk"), func(sess *session.Session) interface{} {
+		return elasticbeanstalk.New(sess)
+	}).(*elasticbeanstalk.ElasticBeanstalk)
+}
+
+func (client *AWSClient) ElasticSearchConn() *elasticsearch.ElasticsearchService {
+	return client.conn("ElasticSearchConn", client.serviceConfig("es"), func(sess *session.Session) interface{} {
+		return elasticsearch.New(sess)
+	}).(*elasticsearch.ElasticsearchService)
+}
+
+func (client *AWSClient) ElasticTranscoderConn() *elastictranscoder.ElasticTranscoder {
+	return client.conn("ElasticTranscoderConn", client.serviceConfig("elastictranscoder"), func(sess *session.Session) interface{} {
+		return elastictranscoder.New(sess)
+	}).(*elastictranscoder.ElasticTranscoder)
+}
+
+func (client *AWSClient) ELBConn() *elb.ELB {
+	return client.conn("ELBConn", client.serviceConfig("elb"), func(sess *session.Session) interface{} {
+		return elb.New(sess)
+	}).(*elb.ELB)
+}
+
+func (client *AWSClient) ELBV2Conn() *elbv2.ELBV2 {
+	return client.conn("ELBV2Conn", client.serviceConfig("elb"), func(sess *session.Session) interface{} {
+		return elbv2.New(sess)
+	}).(*elbv2.ELBV2)
+}
+
+func (client *AWSClient) EMRConn() *emr.EMR {
+	return client.conn("EMRConn", client.serviceConfig("emr"), func(sess *session.Session) interface{} {
+		return emr.New(sess)
+	}).(*emr.EMR)
+}
+
+func (client *AWSClient) EMRContainersConn() *emrcontainers.EMRContainers {
+	return client.conn("EMRContainersConn", client.serviceConfig("emrcontainers"), func(sess *session.Session) interface{} {
+		return emrcontainers.New(sess)
+	}).(*emrcontainers.EMRContainers)
+}
+
+func (client *AWSClient) FirehoseConn() *firehose.Firehose {
+	return client.conn("FirehoseConn", client.serviceConfig("firehose"), func(sess *session.Session) interface{} {
+		return firehose.New(sess)
+	}).(*firehose.Firehose)
+}
+
+func (client *AWSClient) FMSConn() *fms.FMS {
+	return client.conn("FMSConn", client.serviceConfig("fms"), func(sess *session.Session) interface{} {
+		conn := fms.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// Acceptance testing creates and deletes resources in quick succession.
+			// The FMS onboarding process into Organizations is opaque to consumers.
+			// Since we cannot reasonably check this status before receiving the error,
+			// set the operation as retryable.
+			switch r.Operation.Name {
+			case "AssociateAdminAccount":
+				if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded.") {
+					r.Retryable = aws.Bool(true)
+				}
+			case "DisassociateAdminAccount":
+				if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded.") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*fms.FMS)
+}
+
+func (client *AWSClient) ForecastConn() *forecastservice.ForecastService {
+	return client.conn("ForecastConn", client.serviceConfig("forecast"), func(sess *session.Session) interface{} {
+		return forecastservice.New(sess)
+	}).(*forecastservice.ForecastService)
+}
+
+func (client *AWSClient) FSxConn() *fsx.FSx {
+	return client.conn("FSxConn", client.serviceConfig("fsx"), func(sess *session.Session) interface{} {
+		return fsx.New(sess)
+	}).(*fsx.FSx)
+}
+
+func (client *AWSClient) GameLiftConn() *gamelift.GameLift {
+	return client.conn("GameLiftConn", client.serviceConfig("gamelift"), func(sess *session.Session) interface{} {
+		return gamelift.New(sess)
+	}).(*gamelift.GameLift)
+}
+
+func (client *AWSClient) GlacierConn() *glacier.Glacier {
+	return client.conn("GlacierConn", client.serviceConfig("glacier"), func(sess *session.Session) interface{} {
+		return glacier.New(sess)
+	}).(*glacier.Glacier)
+}
+
+func (client *AWSClient) GlobalAcceleratorConn() *globalaccelerator.GlobalAccelerator {
+	return client.conn("GlobalAcceleratorConn", client.globalServiceConfig("globalaccelerator"), func(sess *session.Session) interface{} {
+		return globalaccelerator.New(sess)
+	}).(*globalaccelerator.GlobalAccelerator)
+}
+
+func (client *AWSClient) GlueConn() *glue.Glue {
+	return client.conn("GlueConn", client.serviceConfig("glue"), func(sess *session.Session) interface{} {
+		return glue.New(sess)
+	}).(*glue.Glue)
+}
+
+func (client *AWSClient) GreengrassConn() *greengrass.Greengrass {
+	return client.conn("GreengrassConn", client.serviceConfig("greengrass"), func(sess *session.Session) interface{} {
+		return greengrass.New(sess)
+	}).(*greengrass.Greengrass)
+}
+
+func (client *AWSClient) GuardDutyConn() *guardduty.GuardDuty {
+	return client.conn("GuardDutyConn", client.serviceConfig("guardduty"), func(sess *session.Session) interface{} {
+		return guardduty.New(sess)
+	}).(*guardduty.GuardDuty)
+}
+
+func (client *AWSClient) IAMConn() *iam.IAM {
+	return client.conn("IAMConn", client.serviceConfig("iam"), func(sess *session.Session) interface{} {
+		return iam.New(sess)
+	}).(*iam.IAM)
+}
+
+func (client *AWSClient) IdentityStoreConn() *identitystore.IdentityStore {
+	return client.conn("IdentityStoreConn", client.serviceConfig("identitystore"), func(sess *session.Session) interface{} {
+		return identitystore.New(sess)
+	}).(*identitystore.IdentityStore)
+}
+
+func (client *AWSClient) ImageBuilderConn() *imagebuilder.Imagebuilder {
+	return client.conn("ImageBuilderConn", client.serviceConfig("imagebuilder"), func(sess *session.Session) interface{} {
+		return imagebuilder.New(sess)
+	}).(*imagebuilder.Imagebuilder)
+}
+
+func (client *AWSClient) InspectorConn() *inspector.Inspector {
+	return client.conn("InspectorConn", client.serviceConfig("inspector"), func(sess *session.Session) interface{} {
+		return inspector.New(sess)
+	}).(*inspector.Inspector)
+}
+
+func (client *AWSClient) IoTAnalyticsConn() *iotanalytics.IoTAnalytics {
+	return client.conn("IoTAnalyticsConn", client.serviceConfig("iotanalytics"), func(sess *session.Session) interface{} {
+		return iotanalytics.New(sess)
+	}).(*iotanalytics.IoTAnalytics)
+}
+
+func (client *AWSClient) IoTConn() *iot.IoT {
+	return client.conn("IoTConn", client.serviceConfig("iot"), func(sess *session.Session) interface{} {
+		return iot.New(sess)
+	}).(*iot.IoT)
+}
+
+func (client *AWSClient) IoTEventsConn() *iotevents.IoTEvents {
+	return client.conn("IoTEventsConn", client.serviceConfig("iotevents"), func(sess *session.Session) interface{} {
+		return iotevents.New(sess)
+	}).(*iotevents.IoTEvents)
+}
+
+func (client *AWSClient) KafkaConn() *kafka.Kafka {
+	return client.conn("KafkaConn", client.serviceConfig("kafka"), func(sess *session.Session) interface{} {
+		conn := kafka.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*kafka.Kafka)
+}
+
+func (client *AWSClient) KinesisAnalyticsConn() *kinesisanalytics.KinesisAnalytics {
+	return client.conn("KinesisAnalyticsConn", client.serviceConfig("kinesisanalytics"), func(sess *session.Session) interface{} {
+		return kinesisanalytics.New(sess)
+	}).(*kinesisanalytics.KinesisAnalytics)
+}
+
+func (client *AWSClient) KinesisAnalyticsV2Conn() *kinesisanalyticsv2.KinesisAnalyticsV2 {
+	return client.conn("KinesisAnalyticsV2Conn", client.serviceConfig("kinesisanalyticsv2"), func(sess *session.Session) interface{} {
+		return kinesisanalyticsv2.New(sess)
+	}).(*kinesisanalyticsv2.KinesisAnalyticsV2)
+}
+
+func (client *AWSClient) KinesisConn() *kinesis.Kinesis {
+	return client.conn("KinesisConn", client.serviceConfig("kinesis"), func(sess *session.Session) interface{} {
+		conn := kinesis.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "CreateStream" {
+				if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+			if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
+				if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*kinesis.Kinesis)
+}
+
+func (client *AWSClient) KinesisVideoConn() *kinesisvideo.KinesisVideo {
+	return client.conn("KinesisVideoConn", client.serviceConfig("kinesisvideo"), func(sess *session.Session) interface{} {
+		return kinesisvideo.New(sess)
+	}).(*kinesisvideo.KinesisVideo)
+}
+
+func (client *AWSClient) KMSConn() *kms.KMS {
+	return client.conn("KMSConn", client.serviceConfig("kms"), newKMSConn).(*kms.KMS)
+}
+
+// KMSConnForRegion returns a KMS client for the specified region,
+// used by resources that replicate a multi-Region key from its primary region.
+func (client *AWSClient) KMSConnForRegion(region string) *kms.KMS {
+	if region == client.Region {
+		return client.KMSConn()
+	}
+
+	config := client.serviceConfig("kms")
+	config.Region = aws.String(region)
+
+	return client.conn("KMSConn."+region, config, newKMSConn).(*kms.KMS)
+}
+
+func newKMSConn(sess *session.Session) interface{} {
+	return kms.New(sess)
+}
+
+func (client *AWSClient) LakeFormationConn() *lakeformation.LakeFormation {
+	return client.conn("LakeFormationConn", client.serviceConfig("lakeformation"), func(sess *session.Session) interface{} {
+		return lakeformation.New(sess)
+	}).(*lakeformation.LakeFormation)
+}
+
+func (client *AWSClient) LambdaConn() *lambda.Lambda {
+	return client.conn("LambdaConn", client.serviceConfig("lambda"), func(sess *session.Session) interface{} {
+		return lambda.New(sess)
+	}).(*lambda.Lambda)
+}
+
+func (client *AWSClient) LexModelBuildingConn() *lexmodelbuildingservice.LexModelBuildingService {
+	return client.conn("LexModelBuildingConn", client.serviceConfig("lexmodels"), func(sess *session.Session) interface{} {
+		return lexmodelbuildingservice.New(sess)
+	}).(*lexmodelbuildingservice.LexModelBuildingService)
+}
+
+func (client *AWSClient) LicenseManagerConn() *licensemanager.LicenseManager {
+	return client.conn("LicenseManagerConn", client.serviceConfig("licensemanager"), func(sess *session.Session) interface{} {
+		return licensemanager.New(sess)
+	}).(*licensemanager.LicenseManager)
+}
+
+func (client *AWSClient) LightsailConn() *lightsail.Lightsail {
+	return client.conn("LightsailConn", client.serviceConfig("lightsail"), func(sess *session.Session) interface{} {
+		return lightsail.New(sess)
+	}).(*lightsail.Lightsail)
+}
+
+func (client *AWSClient) LocationConn() *locationservice.LocationService {
+	return client.conn("LocationConn", client.serviceConfig("location"), func(sess *session.Session) interface{} {
+		return locationservice.New(sess)
+	}).(*locationservice.LocationService)
+}
+
+func (client *AWSClient) Macie2Conn() *macie2.Macie2 {
+	return client.conn("Macie2Conn", client.serviceConfig("macie2"), func(sess *session.Session) interface{} {
+		return macie2.New(sess)
+	}).(*macie2.Macie2)
+}
+
+func (client *AWSClient) MacieConn() *macie.Macie {
+	return client.conn("MacieConn", client.serviceConfig("macie"), func(sess *session.Session) interface{} {
+		return macie.New(sess)
+	}).(*macie.Macie)
+}
+
+func (client *AWSClient) ManagedBlockchainConn() *managedblockchain.ManagedBlockchain {
+	return client.conn("ManagedBlockchainConn", client.serviceConfig("managedblockchain"), func(sess *session.Session) interface{} {
+		return managedblockchain.New(sess)
+	}).(*managedblockchain.ManagedBlockchain)
+}
+
+func (client *AWSClient) MarketplaceCatalogConn() *marketplacecatalog.MarketplaceCatalog {
+	return client.conn("MarketplaceCatalogConn", client.serviceConfig("marketplacecatalog"), func(sess *session.Session) interface{} {
+		return marketplacecatalog.New(sess)
+	}).(*marketplacecatalog.MarketplaceCatalog)
+}
+
+func (client *AWSClient) MediaConnectConn() *mediaconnect.MediaConnect {
+	return client.conn("MediaConnectConn", client.serviceConfig("mediaconnect"), func(sess *session.Session) interface{} {
+		return mediaconnect.New(sess)
+	}).(*mediaconnect.MediaConnect)
+}
+
+func (client *AWSClient) MediaConvertConn() *mediaconvert.MediaConvert {
+	return client.conn("MediaConvertConn", client.serviceConfig("mediaconvert"), func(sess *session.Session) interface{} {
+		return mediaconvert.New(sess)
+	}).(*mediaconvert.MediaConvert)
+}
+
+func (client *AWSClient) MediaLiveConn() *medialive.MediaLive {
+	return client.conn("MediaLiveConn", client.serviceConfig("medialive"), func(sess *session.Session) interface{} {
+		return medialive.New(sess)
+	}).(*medialive.MediaLive)
+}
+
+func (client *AWSClient) MediaPackageConn() *mediapackage.MediaPackage {
+	return client.conn("MediaPackageConn", client.serviceConfig("mediapackage"), func(sess *session.Session) interface{} {
+		return mediapackage.New(sess)
+	}).(*mediapackage.MediaPackage)
+}
+
+func (client *AWSClient) MediaStoreConn() *mediastore.MediaStore {
+	return client.conn("MediaStoreConn", client.serviceConfig("mediastore"), func(sess *session.Session) interface{} {
+		return mediastore.New(sess)
+	}).(*mediastore.MediaStore)
+}
+
+func (client *AWSClient) MediaStoreDataConn() *mediastoredata.MediaStoreData {
+	return client.conn("MediaStoreDataConn", client.serviceConfig("mediastoredata"), func(sess *session.Session) interface{} {
+		return mediastoredata.New(sess)
+	}).(*mediastoredata.MediaStoreData)
+}
+
+func (client *AWSClient) MemoryDBConn() *memorydb.MemoryDB {
+	return client.conn("MemoryDBConn", client.serviceConfig("memorydb"), func(sess *session.Session) interface{} {
+		return memorydb.New(sess)
+	}).(*memorydb.MemoryDB)
+}
+
+func (client *AWSClient) MQConn() *mq.MQ {
+	return client.conn("MQConn", client.serviceConfig("mq"), func(sess *session.Session) interface{} {
+		return mq.New(sess)
+	}).(*mq.MQ)
+}
+
+func (client *AWSClient) MWAAConn() *mwaa.MWAA {
+	return client.conn("MWAAConn", client.serviceConfig("mwaa"), func(sess *session.Session) interface{} {
+		return mwaa.New(sess)
+	}).(*mwaa.MWAA)
+}
+
+func (client *AWSClient) NeptuneConn() *neptune.Neptune {
+	return client.conn("NeptuneConn", client.serviceConfig("neptune"), func(sess *session.Session) interface{} {
+		return neptune.New(sess)
+	}).(*neptune.Neptune)
+}
+
+func (client *AWSClient) NetworkFirewallConn() *networkfirewall.NetworkFirewall {
+	return client.conn("NetworkFirewallConn", client.serviceConfig("networkfirewall"), func(sess *session.Session) interface{} {
+		return networkfirewall.New(sess)
+	}).(*networkfirewall.NetworkFirewall)
+}
+
+func (client *AWSClient) NetworkManagerConn() *networkmanager.NetworkManager {
+	return client.conn("NetworkManagerConn", client.serviceConfig("networkmanager"), func(sess *session.Session) interface{} {
+		return networkmanager.New(sess)
+	}).(*networkmanager.NetworkManager)
+}
+
+func (client *AWSClient) OpenSearchConn() *opensearchservice.OpenSearchService {
+	return client.conn("OpenSearchConn", client.serviceConfig("opensearch"), func(sess *session.Session) interface{} {
+		return opensearchservice.New(sess)
+	}).(*opensearchservice.OpenSearchService)
+}
+
+func (client *AWSClient) OpsWorksConn() *opsworks.OpsWorks {
+	return client.conn("OpsWorksConn", client.serviceConfig("opsworks"), func(sess *session.Session) interface{} {
+		return opsworks.New(sess)
+	}).(*opsworks.OpsWorks)
+}
+
+func (client *AWSClient) OrganizationsConn() *organizations.Organizations {
+	return client.conn("OrganizationsConn", client.serviceConfig("organizations"), func(sess *session.Session) interface{} {
+		conn := organizations.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// Retry on the following error:
+			// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
+			if tfawserr.ErrMessageContains(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*organizations.Organizations)
+}
+
+func (client *AWSClient) OutpostsConn() *outposts.Outposts {
+	return client.conn("OutpostsConn", client.serviceConfig("outposts"), func(sess *session.Session) interface{} {
+		return outposts.New(sess)
+	}).(*outposts.Outposts)
+}
+
+func (client *AWSClient) PersonalizeConn() *personalize.Personalize {
+	return client.conn("PersonalizeConn", client.serviceConfig("personalize"), func(sess *session.Session) interface{} {
+		return personalize.New(sess)
+	}).(*personalize.Personalize)
+}
+
+func (client *AWSClient) PinpointConn() *pinpoint.Pinpoint {
+	return client.conn("PinpointConn", client.serviceConfig("pinpoint"), func(sess *session.Session) interface{} {
+		return pinpoint.New(sess)
+	}).(*pinpoint.Pinpoint)
+}
+
+func (client *AWSClient) PricingConn() *pricing.Pricing {
+	return client.conn("PricingConn", client.serviceConfig("pricing"), func(sess *session.Session) interface{} {
+		return pricing.New(sess)
+	}).(*pricing.Pricing)
+}
+
+func (client *AWSClient) PrometheusConn() *prometheusservice.PrometheusService {
+	return client.conn("PrometheusConn", client.serviceConfig("prometheusservice"), func(sess *session.Session) interface{} {
+		return prometheusservice.New(sess)
+	}).(*prometheusservice.PrometheusService)
+}
+
+func (client *AWSClient) QLDBConn() *qldb.QLDB {
+	return client.conn("QLDBConn", client.serviceConfig("qldb"), func(sess *session.Session) interface{} {
+		return qldb.New(sess)
+	}).(*qldb.QLDB)
+}
+
+func (client *AWSClient) QuickSightConn() *quicksight.QuickSight {
+	return client.conn("QuickSightConn", client.serviceConfig("quicksight"), func(sess *session.Session) interface{} {
+		return quicksight.New(sess)
+	}).(*quicksight.QuickSight)
+}
+
+func (client *AWSClient) RAMConn() *ram.RAM {
+	return client.conn("RAMConn", client.serviceConfig("ram"), func(sess *session.Session) interface{} {
+		return ram.New(sess)
+	}).(*ram.RAM)
+}
+
+func (client *AWSClient) RDSConn() *rds.RDS {
+	return client.conn("RDSConn", client.serviceConfig("rds"), func(sess *session.Session) interface{} {
+		return rds.New(sess)
+	}).(*rds.RDS)
+}
+
+func (client *AWSClient) RedshiftConn() *redshift.Redshift {
+	return client.conn("RedshiftConn", client.serviceConfig("redshift"), func(sess *session.Session) interface{} {
+		return redshift.New(sess)
+	}).(*redshift.Redshift)
+}
+
+func (client *AWSClient) ResourceGroupsConn() *resourcegroups.ResourceGroups {
+	return client.conn("ResourceGroupsConn", client.serviceConfig("resourcegroups"), func(sess *session.Session) interface{} {
+		return resourcegroups.New(sess)
+	}).(*resourcegroups.ResourceGroups)
+}
+
+func (client *AWSClient) ResourceGroupsTaggingConn() *resourcegroupstaggingapi.ResourceGroupsTaggingAPI {
+	return client.conn("ResourceGroupsTaggingConn", client.serviceConfig("resourcegroupstaggingapi"), func(sess *session.Session) interface{} {
+		return resourcegroupstaggingapi.New(sess)
+	}).(*resourcegroupstaggingapi.ResourceGroupsTaggingAPI)
+}
+
+func (client *AWSClient) Route53Conn() *route53.Route53 {
+	return client.conn("Route53Conn", client.globalServiceConfig("route53"), func(sess *session.Session) interface{} {
+		return route53.New(sess)
+	}).(*route53.Route53)
+}
+
+func (client *AWSClient) Route53DomainsConn() *route53domains.Route53Domains {
+	return client.conn("Route53DomainsConn", client.serviceConfig("route53domains"), func(sess *session.Session) interface{} {
+		return route53domains.New(sess)
+	}).(*route53domains.Route53Domains)
+}
+
+func (client *AWSClient) Route53RecoveryControlConfigConn() *route53recoverycontrolconfig.Route53RecoveryControlConfig {
+	return client.conn("Route53RecoveryControlConfigConn", client.globalServiceConfig("route53recoverycontrolconfig"), func(sess *session.Session) interface{} {
+		return route53recoverycontrolconfig.New(sess)
+	}).(*route53recoverycontrolconfig.Route53RecoveryControlConfig)
+}
+
+func (client *AWSClient) Route53RecoveryReadinessConn() *route53recoveryreadiness.Route53RecoveryReadiness {
+	return client.conn("Route53RecoveryReadinessConn", client.globalServiceConfig("route53recoveryreadiness"), func(sess *session.Session) interface{} {
+		return route53recoveryreadiness.New(sess)
+	}).(*route53recoveryreadiness.Route53RecoveryReadiness)
+}
+
+func (client *AWSClient) Route53ResolverConn() *route53resolver.Route53Resolver {
+	return client.conn("Route53ResolverConn", client.serviceConfig("route53resolver"), func(sess *session.Session) interface{} {
+		return route53resolver.New(sess)
+	}).(*route53resolver.Route53Resolver)
+}
+
+func (client *AWSClient) S3Conn() *s3.S3 {
+	return client.conn("S3Conn", client.s3Config(false), func(sess *session.Session) interface{} {
+		return s3.New(sess)
+	}).(*s3.S3)
+}
+
+func (client *AWSClient) S3ConnURICleaningDisabled() *s3.S3 {
+	return client.conn("S3ConnURICleaningDisabled", client.s3Config(true), func(sess *session.Session) interface{} {
+		return s3.New(sess)
+	}).(*s3.S3)
+}
+
+func (client *AWSClient) S3ControlConn() *s3control.S3Control {
+	return client.conn("S3ControlConn", client.serviceConfig("s3control"), func(sess *session.Session) interface{} {
+		return s3control.New(sess)
+	}).(*s3control.S3Control)
+}
+
+func (client *AWSClient) S3OutpostsConn() *s3outposts.S3Outposts {
+	return client.conn("S3OutpostsConn", client.serviceConfig("s3outposts"), func(sess *session.Session) interface{} {
+		return s3outposts.New(sess)
+	}).(*s3outposts.S3Outposts)
+}
+
+func (client *AWSClient) SageMakerConn() *sagemaker.SageMaker {
+	return client.conn("SageMakerConn", client.serviceConfig("sagemaker"), func(sess *session.Session) interface{} {
+		return sagemaker.New(sess)
+	}).(*sagemaker.SageMaker)
+}
+
+func (client *AWSClient) SchemasConn() *schemas.Schemas {
+	return client.conn("SchemasConn", client.serviceConfig("schemas"), func(sess *session.Session) interface{} {
+		return schemas.New(sess)
+	}).(*schemas.Schemas)
+}
+
+func (client *AWSClient) SecretsManagerConn() *secretsmanager.SecretsManager {
+	return client.conn("SecretsManagerConn", client.serviceConfig("secretsmanager"), func(sess *session.Session) interface{} {
+		return secretsmanager.New(sess)
+	}).(*secretsmanager.SecretsManager)
+}
+
+func (client *AWSClient) SecurityHubConn() *securityhub.SecurityHub {
+	return client.conn("SecurityHubConn", client.serviceConfig("securityhub"), func(sess *session.Session) interface{} {
+		conn := securityhub.New(sess)
+
+		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			switch r.Operation.Name {
+			case "EnableOrganizationAdminAccount":
+				if tfawserr.ErrCodeEquals(r.Error, securityhub.ErrCodeResourceConflictException) {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*securityhub.SecurityHub)
+}
+
+func (client *AWSClient) ServerlessAppRepoConn() *serverlessapplicationrepository.ServerlessApplicationRepository {
+	return client.conn("ServerlessAppRepoConn", client.serviceConfig("serverlessrepo"), func(sess *session.Session) interface{} {
+		return serverlessapplicationrepository.New(sess)
+	}).(*serverlessapplicationrepository.ServerlessApplicationRepository)
+}
+
+func (client *AWSClient) ServiceCatalogConn() *servicecatalog.ServiceCatalog {
+	return client.conn("ServiceCatalogConn", client.serviceConfig("servicecatalog"), func(sess *session.Session) interface{} {
+		return servicecatalog.New(sess)
+	}).(*servicecatalog.ServiceCatalog)
+}
+
+func (client *AWSClient) ServiceCatalogAppRegistryConn() *appregistry.AppRegistry {
+	return client.conn("ServiceCatalogAppRegistryConn", client.serviceConfig("servicecatalogappregistry"), func(sess *session.Session) interface{} {
+		return appregistry.New(sess)
+	}).(*appregistry.AppRegistry)
+}
+
+func (client *AWSClient) ServiceDiscoveryConn() *servicediscovery.ServiceDiscovery {
+	return client.conn("ServiceDiscoveryConn", client.serviceConfig("servicediscovery"), func(sess *session.Session) interface{} {
+		return servicediscovery.New(sess)
+	}).(*servicediscovery.ServiceDiscovery)
+}
+
+func (client *AWSClient) ServiceQuotasConn() *servicequotas.ServiceQuotas {
+	return client.conn("ServiceQuotasConn", client.serviceConfig("servicequotas"), func(sess *session.Session) interface{} {
+		return servicequotas.New(sess)
+	}).(*servicequotas.ServiceQuotas)
+}
+
+func (client *AWSClient) SESConn() *ses.SES {
+	return client.conn("SESConn", client.serviceConfig("ses"), func(sess *session.Session) interface{} {
+		return ses.New(sess)
+	}).(*ses.SES)
+}
+
+func (client *AWSClient) SESV2Conn() *sesv2.SESV2 {
+	return client.conn("SESV2Conn", client.serviceConfig("sesv2"), func(sess *session.Session) interface{} {
+		return sesv2.New(sess)
+	}).(*sesv2.SESV2)
+}
+
+func (client *AWSClient) SFNConn() *sfn.SFN {
+	return client.conn("SFNConn", client.serviceConfig("stepfunctions"), func(sess *session.Session) interface{} {
+		return sfn.New(sess)
+	}).(*sfn.SFN)
+}
+
+func (client *AWSClient) ShieldConn() *shield.Shield {
+	return client.conn("ShieldConn", client.globalServiceConfig("shield"), func(sess *session.Session) interface{} {
+		return shield.New(sess)
+	}).(*shield.Shield)
+}
+
+func (client *AWSClient) SignerConn() *signer.Signer {
+	return client.conn("SignerConn", client.serviceConfig("signer"), func(sess *session.Session) interface{} {
+		return signer.New(sess)
+	}).(*signer.Signer)
+}
+
+func (client *AWSClient) SimpleDBConn() *simpledb.SimpleDB {
+	return client.conn("SimpleDBConn", client.serviceConfig("sdb"), func(sess *session.Session) interface{} {
+		return simpledb.New(sess)
+	}).(*simpledb.SimpleDB)
+}
+
+func (client *AWSClient) SNSConn() *sns.SNS {
+	return client.conn("SNSConn", client.serviceConfig("sns"), func(sess *session.Session) interface{} {
+		return sns.New(sess)
+	}).(*sns.SNS)
+}
+
+func (client *AWSClient) SQSConn() *sqs.SQS {
+	return client.conn("SQSConn", client.serviceConfig("sqs"), func(sess *session.Session) interface{} {
+		return sqs.New(sess)
+	}).(*sqs.SQS)
+}
+
+func (client *AWSClient) SSMConn() *ssm.SSM {
+	return client.conn("SSMConn", client.serviceConfig("ssm"), func(sess *session.Session) interface{} {
+		return ssm.New(sess)
+	}).(*ssm.SSM)
+}
+
+func (client *AWSClient) SSOAdminConn() *ssoadmin.SSOAdmin {
+	return client.conn("SSOAdminConn", client.serviceConfig("ssoadmin"), func(sess *session.Session) interface{} {
+		conn := ssoadmin.New(sess)
+
+		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if r.Operation.Name == "AttachManagedPolicyToPermissionSet" || r.Operation.Name == "DetachManagedPolicyFromPermissionSet" {
+				if tfawserr.ErrCodeEquals(r.Error, ssoadmin.ErrCodeConflictException) {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*ssoadmin.SSOAdmin)
+}
+
+func (client *AWSClient) StorageGatewayConn() *storagegateway.StorageGateway {
+	return client.conn("StorageGatewayConn", client.serviceConfig("storagegateway"), func(sess *session.Session) interface{} {
+		conn := storagegateway.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+			if tfawserr.ErrMessageContains(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*storagegateway.StorageGateway)
+}
+
+func (client *AWSClient) STSConn() *sts.STS {
+	return client.conn("STSConn", client.serviceConfig("sts"), func(sess *session.Session) interface{} {
+		return sts.New(sess)
+	}).(*sts.STS)
+}
+
+func (client *AWSClient) SWFConn() *swf.SWF {
+	return client.conn("SWFConn", client.serviceConfig("swf"), func(sess *session.Session) interface{} {
+		return swf.New(sess)
+	}).(*swf.SWF)
+}
+
+func (client *AWSClient) SyntheticsConn() *synthetics.Synthetics {
+	return client.conn("SyntheticsConn", client.serviceConfig("synthetics"), func(sess *session.Session) interface{} {
+		return synthetics.New(sess)
+	}).(*synthetics.Synthetics)
+}
+
+func (client *AWSClient) TimestreamWriteConn() *timestreamwrite.TimestreamWrite {
+	return client.conn("TimestreamWriteConn", client.serviceConfig("timestreamwrite"), func(sess *session.Session) interface{} {
+		return timestreamwrite.New(sess)
+	}).(*timestreamwrite.TimestreamWrite)
+}
+
+func (client *AWSClient) TransferConn() *transfer.Transfer {
+	return client.conn("TransferConn", client.serviceConfig("transfer"), func(sess *session.Session) interface{} {
+		return transfer.New(sess)
+	}).(*transfer.Transfer)
+}
+
+func (client *AWSClient) WAFConn() *waf.WAF {
+	return client.conn("WAFConn", client.serviceConfig("waf"), func(sess *session.Session) interface{} {
+		return waf.New(sess)
+	}).(*waf.WAF)
+}
+
+func (client *AWSClient) WAFRegionalConn() *wafregional.WAFRegional {
+	return client.conn("WAFRegionalConn", client.serviceConfig("wafregional"), func(sess *session.Session) interface{} {
+		return wafregional.New(sess)
+	}).(*wafregional.WAFRegional)
+}
+
+func (client *AWSClient) WAFV2Conn() *wafv2.WAFV2 {
+	return client.conn("WAFV2Conn", client.serviceConfig("wafv2"), func(sess *session.Session) interface{} {
+		conn := wafv2.New(sess)
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
+				r.Retryable = aws.Bool(true)
+			}
+
+			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
+				r.Retryable = aws.Bool(true)
+			}
+
+			if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
+				r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
+				// WAFv2 supports tag on create which can result in the below error codes according to the documentation
+				if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
+					r.Retryable = aws.Bool(true)
+				}
+				if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
+					r.Retryable = aws.Bool(true)
+				}
+			}
+		})
+
+		return conn
+	}).(*wafv2.WAFV2)
+}
+
+func (client *AWSClient) WorkLinkConn() *worklink.WorkLink {
+	return client.conn("WorkLinkConn", client.serviceConfig("worklink"), func(sess *session.Session) interface{} {
+		return worklink.New(sess)
+	}).(*worklink.WorkLink)
+}
+
+func (client *AWSClient) WorkMailConn() *workmail.WorkMail {
+	return client.conn("WorkMailConn", client.serviceConfig("workmail"), func(sess *session.Session) interface{} {
+		return workmail.New(sess)
+	}).(*workmail.WorkMail)
+}
+
+func (client *AWSClient) WorkSpacesConn() *workspaces.WorkSpaces {
+	return client.conn("WorkSpacesConn", client.serviceConfig("workspaces"), func(sess *session.Session) interface{} {
+		return workspaces.New(sess)
+	}).(*workspaces.WorkSpaces)
+}
+
+func (client *AWSClient) XRayConn() *xray.XRay {
+	return client.conn("XRayConn", client.serviceConfig("xray"), func(sess *session.Session) interface{} {
+		return xray.New(sess)
+	}).(*xray.XRay)
+}
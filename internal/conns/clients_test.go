@@ -0,0 +1,58 @@
+package conns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestAWSClientConn(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Region: aws.String(endpoints.UsWest2RegionID),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &AWSClient{
+		Partition: endpoints.AwsPartitionID,
+		config: &Config{
+			Endpoints: map[string]string{
+				"ec2": "http://ec2.example.com",
+			},
+			ServiceMaxRetries: map[string]int{
+				"ec2": 3,
+			},
+		},
+		session: sess,
+	}
+
+	conn := client.EC2Conn()
+
+	if got, expected := conn, client.EC2Conn(); got != expected {
+		t.Errorf("got new client on second call, expected cached client")
+	}
+
+	if got, expected := conn.Endpoint, "http://ec2.example.com"; got != expected {
+		t.Errorf("got endpoint %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.IntValue(conn.Config.MaxRetries), 3; got != expected {
+		t.Errorf("got max retries %d, expected %d", got, expected)
+	}
+
+	if got, expected := aws.StringValue(client.Route53Conn().Config.Region), endpoints.UsEast1RegionID; got != expected {
+		t.Errorf("got Route 53 region %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.StringValue(client.IAMConn().Config.Region), endpoints.UsWest2RegionID; got != expected {
+		t.Errorf("got IAM region %s, expected %s", got, expected)
+	}
+
+	if got, expected := len(client.conns), 3; got != expected {
+		t.Errorf("got %d clients, expected %d", got, expected)
+	}
+}
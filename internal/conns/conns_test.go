@@ -1,6 +1,7 @@
 package conns
 
 import (
+	"net/http"
 	"reflect"
 	"testing"
 
@@ -82,6 +83,206 @@ func TestAWSClientRegionalHostname(t *testing.T) {
 	}
 }
 
+func TestNoProxyMatches(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		NoProxy  string
+		Host     string
+		Expected bool
+	}{
+		{
+			Name:     "empty",
+			NoProxy:  "",
+			Host:     "ec2.us-west-2.amazonaws.com",
+			Expected: false,
+		},
+		{
+			Name:     "wildcard",
+			NoProxy:  "*",
+			Host:     "ec2.us-west-2.amazonaws.com",
+			Expected: true,
+		},
+		{
+			Name:     "exact host",
+			NoProxy:  "example.com, ec2.us-west-2.amazonaws.com",
+			Host:     "ec2.us-west-2.amazonaws.com",
+			Expected: true,
+		},
+		{
+			Name:     "domain",
+			NoProxy:  "amazonaws.com",
+			Host:     "ec2.us-west-2.amazonaws.com",
+			Expected: true,
+		},
+		{
+			Name:     "leading dot",
+			NoProxy:  ".amazonaws.com",
+			Host:     "ec2.us-west-2.amazonaws.com",
+			Expected: true,
+		},
+		{
+			Name:     "case insensitive",
+			NoProxy:  "AmazonAWS.com",
+			Host:     "ec2.us-west-2.amazonaws.com",
+			Expected: true,
+		},
+		{
+			Name:     "suffix without domain boundary",
+			NoProxy:  "zonaws.com",
+			Host:     "ec2.us-west-2.amazonaws.com",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := noProxyMatches(testCase.NoProxy, testCase.Host)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestConfigProxyFunc(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Config   *Config
+		URL      string
+		Expected string
+	}{
+		{
+			Name: "HTTP proxy for HTTPS request",
+			Config: &Config{
+				HTTPProxy: "http://proxy.example.com:3128",
+			},
+			URL:      "https://ec2.us-west-2.amazonaws.com/",
+			Expected: "http://proxy.example.com:3128",
+		},
+		{
+			Name: "HTTPS proxy for HTTPS request",
+			Config: &Config{
+				HTTPProxy:  "http://proxy.example.com:3128",
+				HTTPSProxy: "http://secure-proxy.example.com:3128",
+			},
+			URL:      "https://ec2.us-west-2.amazonaws.com/",
+			Expected: "http://secure-proxy.example.com:3128",
+		},
+		{
+			Name: "HTTP proxy for HTTP request",
+			Config: &Config{
+				HTTPProxy:  "http://proxy.example.com:3128",
+				HTTPSProxy: "http://secure-proxy.example.com:3128",
+			},
+			URL:      "http://example.com/",
+			Expected: "http://proxy.example.com:3128",
+		},
+		{
+			Name: "no proxy",
+			Config: &Config{
+				HTTPSProxy: "http://secure-proxy.example.com:3128",
+				NoProxy:    "s3.us-west-2.amazonaws.com",
+			},
+			URL:      "https://s3.us-west-2.amazonaws.com/",
+			Expected: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			proxy, err := testCase.Config.proxyFunc()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			req, err := http.NewRequest(http.MethodGet, testCase.URL, nil)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := proxy(req)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var gotURL string
+
+			if got != nil {
+				gotURL = got.String()
+			}
+
+			if gotURL != testCase.Expected {
+				t.Errorf("got %q, expected %q", gotURL, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestConfigValidateCustomCABundle(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Config      *Config
+		ExpectError bool
+	}{
+		{
+			Name:   "no custom CA bundle",
+			Config: &Config{},
+		},
+		{
+			Name: "validation and account ID lookup skipped",
+			Config: &Config{
+				CustomCABundle:          "ca.pem",
+				SkipCredsValidation:     true,
+				SkipRequestingAccountId: true,
+			},
+		},
+		{
+			Name: "credentials validated",
+			Config: &Config{
+				CustomCABundle:          "ca.pem",
+				SkipRequestingAccountId: true,
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "account ID requested",
+			Config: &Config{
+				CustomCABundle:      "ca.pem",
+				SkipCredsValidation: true,
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "assume role",
+			Config: &Config{
+				AssumeRoleARN:           "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+				CustomCABundle:          "ca.pem",
+				SkipCredsValidation:     true,
+				SkipRequestingAccountId: true,
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := testCase.Config.validateCustomCABundle()
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error, got none")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestGetSupportedEC2Platforms(t *testing.T) {
 	ec2Endpoints := []*awsbase.MockEndpoint{
 		{
@@ -54,6 +54,10 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	EnvVarAccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For tests run against AWS-compatible endpoints, such as LocalStack, either a single
+	// endpoint URL for all services or comma-separated service=URL pairs
+	EnvVarAccEndpointURL = "TF_ACC_ENDPOINT_URL"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -72,6 +76,29 @@ const (
 	EnvVarAssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used for assuming a role with a web identity with resource sweepers
+const (
+	// The ARN of the IAM Role to assume with a web identity
+	EnvVarAssumeRoleWithWebIdentityARN = "TF_AWS_ASSUME_ROLE_WITH_WEB_IDENTITY_ARN"
+
+	// The duration in seconds the IAM role will be assumed.
+	// Defaults to 1 hour (3600).
+	EnvVarAssumeRoleWithWebIdentityDuration = "TF_AWS_ASSUME_ROLE_WITH_WEB_IDENTITY_DURATION"
+
+	// A session name for the assumed role
+	EnvVarAssumeRoleWithWebIdentitySessionName = "TF_AWS_ASSUME_ROLE_WITH_WEB_IDENTITY_SESSION_NAME"
+
+	// The path to a file containing the web identity token, e.g. an OIDC token
+	EnvVarAssumeRoleWithWebIdentityTokenFile = "TF_AWS_WEB_IDENTITY_TOKEN_FILE"
+)
+
+// Custom environment variables used for tuning resource sweepers
+const (
+	// The maximum number of resources deleted concurrently by each sweeper.
+	// Defaults to 20.
+	EnvVarSweepConcurrency = "TF_AWS_SWEEP_CONCURRENCY"
+)
+
 // GetEnvVarWithDefault gets an environment variable value if non-empty or returns the default.
 func GetEnvVarWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
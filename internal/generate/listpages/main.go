@@ -298,6 +298,8 @@ func awsServiceName(s string) (string, error) {
 		return "resourcegroupstaggingapi", nil
 	case "serverlessapprepo":
 		return "serverlessapplicationrepository", nil
+	case "servicecatalogappregistry":
+		return "appregistry", nil
 	}
 
 	if _, ok := awsServiceNames[fmt.Sprintf("%sservice", s)]; ok {
@@ -327,6 +329,8 @@ func awsServiceNameUpper(s string) (string, error) {
 		return awsServiceNames["resourcegroupstaggingapi"], nil
 	case "serverlessapprepo":
 		return awsServiceNames["serverlessapplicationrepository"], nil
+	case "servicecatalogappregistry":
+		return awsServiceNames["appregistry"], nil
 	}
 
 	if v, ok := awsServiceNames[fmt.Sprintf("%sservice", s)]; ok {
@@ -536,6 +540,7 @@ func init() {
 	awsServiceNames["networkfirewall"] = "NetworkFirewall"
 	awsServiceNames["networkmanager"] = "NetworkManager"
 	awsServiceNames["nimblestudio"] = "NimbleStudio"
+	awsServiceNames["opensearchservice"] = "OpenSearchService"
 	awsServiceNames["opsworks"] = "OpsWorks"
 	awsServiceNames["opsworkscm"] = "OpsWorksCM"
 	awsServiceNames["organizations"] = "Organizations"
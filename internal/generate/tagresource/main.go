@@ -154,7 +154,7 @@ func ResourceTag() *schema.Resource {
 }
 
 func resourceTagCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn()
 
 	identifier := d.Get("{{ .IDAttribName }}").(string)
 	key := d.Get("key").(string)
@@ -174,7 +174,7 @@ func resourceTagCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTagRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn()
 	identifier, key, err := tftags.GetResourceID(d.Id())
 
 	if err != nil {
@@ -201,7 +201,7 @@ func resourceTagRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTagUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn()
 	identifier, key, err := tftags.GetResourceID(d.Id())
 
 	if err != nil {
@@ -216,7 +216,7 @@ func resourceTagUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTagDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+	conn := meta.(*conns.AWSClient).{{ .AWSServiceUpper }}Conn()
 	identifier, key, err := tftags.GetResourceID(d.Id())
 
 	if err != nil {
@@ -248,7 +248,7 @@ import (
 )
 
 func testAccCheckTagDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).{{ .AWSServiceUpper }}Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_{{ .ServicePackage }}_tag" {
@@ -294,7 +294,7 @@ func testAccCheckTagExists(resourceName string) resource.TestCheckFunc {
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).{{ .AWSServiceUpper }}Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).{{ .AWSServiceUpper }}Conn()
 
 		_, err = tf{{ .ServicePackage }}.GetTag(conn, identifier, key)
 
@@ -0,0 +1,37 @@
+package importer
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// ARNOrID converts an import ID that is either an Amazon Resource Name (ARN) of the
+// specified service or a legacy identifier to the resource's ID, using fromARN or fromID respectively.
+// The returned error lists both accepted formats, arnFormat and idFormat.
+func ARNOrID(id, service string, fromARN func(arn.ARN) (string, error), fromID func(string) (string, error), arnFormat, idFormat string) (string, error) {
+	var result string
+	var err error
+
+	if arn.IsARN(id) {
+		var parsedARN arn.ARN
+
+		parsedARN, err = arn.Parse(id)
+
+		if err == nil {
+			if actual, expected := parsedARN.Service, service; actual != expected {
+				err = fmt.Errorf("expected service %s in ARN, got: %s", expected, actual)
+			} else {
+				result, err = fromARN(parsedARN)
+			}
+		}
+	} else {
+		result, err = fromID(id)
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("unexpected format of import ID (%s), expected %s or %s: %w", id, arnFormat, idFormat, err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package importer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+func TestARNOrID(t *testing.T) {
+	fromARN := func(a arn.ARN) (string, error) {
+		parts := strings.Split(a.Resource, "/")
+
+		if len(parts) != 2 || parts[0] != "thing" {
+			return "", fmt.Errorf("unexpected resource (%s)", a.Resource)
+		}
+
+		return parts[1], nil
+	}
+	fromID := func(id string) (string, error) {
+		if id == "" || strings.Contains(id, "/") {
+			return "", fmt.Errorf("unexpected name (%s)", id)
+		}
+
+		return id, nil
+	}
+
+	testCases := []struct {
+		TestName      string
+		ID            string
+		Expected      string
+		ExpectedError bool
+	}{
+		{
+			TestName: "ARN",
+			ID:       "arn:aws:example:us-west-2:123456789012:thing/test", //lintignore:AWSAT003,AWSAT005
+			Expected: "test",
+		},
+		{
+			TestName: "legacy ID",
+			ID:       "test",
+			Expected: "test",
+		},
+		{
+			TestName:      "other service",
+			ID:            "arn:aws:other:us-west-2:123456789012:thing/test", //lintignore:AWSAT003,AWSAT005
+			ExpectedError: true,
+		},
+		{
+			TestName:      "invalid ARN resource",
+			ID:            "arn:aws:example:us-west-2:123456789012:other/test", //lintignore:AWSAT003,AWSAT005
+			ExpectedError: true,
+		},
+		{
+			TestName:      "invalid legacy ID",
+			ID:            "thing/test",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := ARNOrID(testCase.ID, "example", fromARN, fromID, "ARN", "NAME")
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !strings.Contains(err.Error(), "expected ARN or NAME") {
+				t.Errorf("expected error to list accepted formats, got: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/apigatewayv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appconfig"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/applicationautoscaling"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appmesh"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
@@ -103,6 +105,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/nas"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
@@ -129,9 +132,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/securityhub"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/serverlessapprepo"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalogappregistry"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/servicequotas"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ses"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/shield"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
@@ -186,6 +191,8 @@ func Provider() *schema.Provider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -218,6 +225,14 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"service_max_retries": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeInt},
+				ValidateFunc: validServiceMaxRetries,
+				Description:  descriptions["service_max_retries"],
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -251,12 +266,30 @@ func Provider() *schema.Provider {
 				},
 			},
 
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["custom_ca_bundle"],
+			},
+
 			"http_proxy": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: descriptions["http_proxy"],
 			},
 
+			"https_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["https_proxy"],
+			},
+
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["no_proxy"],
+			},
+
 			"endpoints": endpointsSchema(),
 
 			"ignore_tags": {
@@ -458,6 +491,7 @@ func Provider() *schema.Provider {
 			"aws_elb_hosted_zone_id":                         elb.DataSourceHostedZoneID(),
 			"aws_elb_service_account":                        elb.DataSourceServiceAccount(),
 			"aws_globalaccelerator_accelerator":              globalaccelerator.DataSourceAccelerator(),
+			"aws_globalaccelerator_custom_routing_mappings":  globalaccelerator.DataSourceCustomRoutingMappings(),
 			"aws_glue_connection":                            glue.DataSourceConnection(),
 			"aws_glue_data_catalog_encryption_settings":      glue.DataSourceDataCatalogEncryptionSettings(),
 			"aws_glue_script":                                glue.DataSourceScript(),
@@ -467,6 +501,7 @@ func Provider() *schema.Provider {
 			"aws_iam_instance_profile":                       iam.DataSourceInstanceProfile(),
 			"aws_iam_policy":                                 iam.DataSourcePolicy(),
 			"aws_iam_policy_document":                        iam.DataSourcePolicyDocument(),
+			"aws_iam_principal_policy_simulation":            iam.DataSourcePrincipalPolicySimulation(),
 			"aws_iam_role":                                   iam.DataSourceRole(),
 			"aws_iam_roles":                                  iam.DataSourceRoles(),
 			"aws_iam_server_certificate":                     iam.DataSourceServerCertificate(),
@@ -511,6 +546,7 @@ func Provider() *schema.Provider {
 			"aws_lex_intent":                                 lexmodelbuilding.DataSourceIntent(),
 			"aws_lex_slot_type":                              lexmodelbuilding.DataSourceSlotType(),
 			"aws_mq_broker":                                  mq.DataSourceBroker(),
+			"aws_msk_bootstrap_brokers":                      kafka.DataSourceBootstrapBrokers(),
 			"aws_msk_broker_nodes":                           kafka.DataSourceBrokerNodes(),
 			"aws_msk_cluster":                                kafka.DataSourceCluster(),
 			"aws_msk_configuration":                          kafka.DataSourceConfiguration(),
@@ -540,7 +576,9 @@ func Provider() *schema.Provider {
 			"aws_rds_cluster":                                rds.DataSourceCluster(),
 			"aws_rds_engine_version":                         rds.DataSourceEngineVersion(),
 			"aws_rds_orderable_db_instance":                  rds.DataSourceOrderableInstance(),
+			"aws_rds_reserved_instance_offering":             rds.DataSourceReservedInstanceOffering(),
 			"aws_redshift_cluster":                           redshift.DataSourceCluster(),
+			"aws_redshift_data_shares":                       redshift.DataSourceDataShares(),
 			"aws_redshift_orderable_cluster":                 redshift.DataSourceOrderableCluster(),
 			"aws_redshift_service_account":                   redshift.DataSourceServiceAccount(),
 			"aws_region":                                     nas.DataSourceRegion(),
@@ -551,6 +589,7 @@ func Provider() *schema.Provider {
 			"aws_route_tables":                               ec2.DataSourceRouteTables(),
 			"aws_route53_delegation_set":                     route53.DataSourceDelegationSet(),
 			"aws_route53_resolver_endpoint":                  route53resolver.DataSourceEndpoint(),
+			"aws_route53_resolver_firewall_domain_list":      route53resolver.DataSourceFirewallDomainList(),
 			"aws_route53_resolver_rule":                      route53resolver.DataSourceRule(),
 			"aws_route53_resolver_rules":                     route53resolver.DataSourceRules(),
 			"aws_route53_zone":                               route53.DataSourceZone(),
@@ -569,6 +608,7 @@ func Provider() *schema.Provider {
 			"aws_servicequotas_service":                      servicequotas.DataSourceService(),
 			"aws_servicequotas_service_quota":                servicequotas.DataSourceServiceQuota(),
 			"aws_service_discovery_dns_namespace":            servicediscovery.DataSourceDNSNamespace(),
+			"aws_service_discovery_service":                  servicediscovery.DataSourceService(),
 			"aws_sfn_activity":                               sfn.DataSourceActivity(),
 			"aws_sfn_state_machine":                          sfn.DataSourceStateMachine(),
 			"aws_signer_signing_job":                         signer.DataSourceSigningJob(),
@@ -624,6 +664,7 @@ func Provider() *schema.Provider {
 
 		ResourcesMap: map[string]*schema.Resource{
 			"aws_accessanalyzer_analyzer":                             accessanalyzer.ResourceAnalyzer(),
+			"aws_acm_account":                                         acm.ResourceAccount(),
 			"aws_acm_certificate":                                     acm.ResourceCertificate(),
 			"aws_acm_certificate_validation":                          acm.ResourceCertificateValidation(),
 			"aws_acmpca_certificate_authority":                        acmpca.ResourceCertificateAuthority(),
@@ -645,6 +686,7 @@ func Provider() *schema.Provider {
 			"aws_api_gateway_client_certificate":                      apigateway.ResourceClientCertificate(),
 			"aws_api_gateway_deployment":                              apigateway.ResourceDeployment(),
 			"aws_api_gateway_documentation_part":                      apigateway.ResourceDocumentationPart(),
+			"aws_api_gateway_documentation_parts":                     apigateway.ResourceDocumentationParts(),
 			"aws_api_gateway_documentation_version":                   apigateway.ResourceDocumentationVersion(),
 			"aws_api_gateway_domain_name":                             apigateway.ResourceDomainName(),
 			"aws_api_gateway_gateway_response":                        apigateway.ResourceGatewayResponse(),
@@ -684,6 +726,8 @@ func Provider() *schema.Provider {
 			"aws_appconfig_deployment_strategy":                       appconfig.ResourceDeploymentStrategy(),
 			"aws_appconfig_environment":                               appconfig.ResourceEnvironment(),
 			"aws_appconfig_hosted_configuration_version":              appconfig.ResourceHostedConfigurationVersion(),
+			"aws_applicationinsights_application":                     applicationinsights.ResourceApplication(),
+			"aws_applicationinsights_log_pattern":                     applicationinsights.ResourceLogPattern(),
 			"aws_appmesh_gateway_route":                               appmesh.ResourceGatewayRoute(),
 			"aws_appmesh_mesh":                                        appmesh.ResourceMesh(),
 			"aws_appmesh_route":                                       appmesh.ResourceRoute(),
@@ -705,6 +749,7 @@ func Provider() *schema.Provider {
 			"aws_appsync_resolver":                                    appsync.ResourceResolver(),
 			"aws_athena_database":                                     athena.ResourceDatabase(),
 			"aws_athena_named_query":                                  athena.ResourceNamedQuery(),
+			"aws_athena_prepared_statement":                           athena.ResourcePreparedStatement(),
 			"aws_athena_workgroup":                                    athena.ResourceWorkGroup(),
 			"aws_autoscaling_attachment":                              autoscaling.ResourceAttachment(),
 			"aws_autoscaling_group":                                   autoscaling.ResourceGroup(),
@@ -772,6 +817,7 @@ func Provider() *schema.Provider {
 			"aws_config_organization_custom_rule":                     config.ResourceOrganizationCustomRule(),
 			"aws_config_organization_managed_rule":                    config.ResourceOrganizationManagedRule(),
 			"aws_config_remediation_configuration":                    config.ResourceRemediationConfiguration(),
+			"aws_config_retention_configuration":                      config.ResourceRetentionConfiguration(),
 			"aws_cognito_identity_pool":                               cognitoidentity.ResourcePool(),
 			"aws_cognito_identity_pool_roles_attachment":              cognitoidentity.ResourcePoolRolesAttachment(),
 			"aws_cognito_identity_provider":                           cognitoidp.ResourceIdentityProvider(),
@@ -870,6 +916,7 @@ func Provider() *schema.Provider {
 			"aws_dx_transit_virtual_interface":                        directconnect.ResourceTransitVirtualInterface(),
 			"aws_dynamodb_table":                                      dynamodb.ResourceTable(),
 			"aws_dynamodb_table_item":                                 dynamodb.ResourceTableItem(),
+			"aws_dynamodb_table_replica":                              dynamodb.ResourceTableReplica(),
 			"aws_dynamodb_tag":                                        dynamodb.ResourceTag(),
 			"aws_dynamodb_global_table":                               dynamodb.ResourceGlobalTable(),
 			"aws_dynamodb_kinesis_streaming_destination":              dynamodb.ResourceKinesisStreamingDestination(),
@@ -969,6 +1016,9 @@ func Provider() *schema.Provider {
 			"aws_glacier_vault":                                       glacier.ResourceVault(),
 			"aws_glacier_vault_lock":                                  glacier.ResourceVaultLock(),
 			"aws_globalaccelerator_accelerator":                       globalaccelerator.ResourceAccelerator(),
+			"aws_globalaccelerator_custom_routing_accelerator":        globalaccelerator.ResourceCustomRoutingAccelerator(),
+			"aws_globalaccelerator_custom_routing_endpoint_group":     globalaccelerator.ResourceCustomRoutingEndpointGroup(),
+			"aws_globalaccelerator_custom_routing_listener":           globalaccelerator.ResourceCustomRoutingListener(),
 			"aws_globalaccelerator_endpoint_group":                    globalaccelerator.ResourceEndpointGroup(),
 			"aws_globalaccelerator_listener":                          globalaccelerator.ResourceListener(),
 			"aws_glue_catalog_database":                               glue.ResourceCatalogDatabase(),
@@ -1009,18 +1059,23 @@ func Provider() *schema.Provider {
 			"aws_iam_openid_connect_provider":                         iam.ResourceOpenIDConnectProvider(),
 			"aws_iam_policy":                                          iam.ResourcePolicy(),
 			"aws_iam_policy_attachment":                               iam.ResourcePolicyAttachment(),
+			"aws_iam_role_policies_exclusive":                         iam.ResourceRolePoliciesExclusive(),
 			"aws_iam_role_policy_attachment":                          iam.ResourceRolePolicyAttachment(),
+			"aws_iam_role_policy_attachments_exclusive":               iam.ResourceRolePolicyAttachmentsExclusive(),
 			"aws_iam_role_policy":                                     iam.ResourceRolePolicy(),
 			"aws_iam_role":                                            iam.ResourceRole(),
 			"aws_iam_saml_provider":                                   iam.ResourceSamlProvider(),
 			"aws_iam_server_certificate":                              iam.ResourceServerCertificate(),
 			"aws_iam_service_linked_role":                             iam.ResourceServiceLinkedRole(),
+			"aws_iam_service_specific_credential":                     iam.ResourceServiceSpecificCredential(),
+			"aws_iam_signing_certificate":                             iam.ResourceSigningCertificate(),
 			"aws_iam_user_group_membership":                           iam.ResourceUserGroupMembership(),
 			"aws_iam_user_policy_attachment":                          iam.ResourceUserPolicyAttachment(),
 			"aws_iam_user_policy":                                     iam.ResourceUserPolicy(),
 			"aws_iam_user_ssh_key":                                    iam.ResourceUserSSHKey(),
 			"aws_iam_user":                                            iam.ResourceUser(),
 			"aws_iam_user_login_profile":                              iam.ResourceUserLoginProfile(),
+			"aws_iam_virtual_mfa_device":                              iam.ResourceVirtualMFADevice(),
 			"aws_imagebuilder_component":                              imagebuilder.ResourceComponent(),
 			"aws_imagebuilder_distribution_configuration":             imagebuilder.ResourceDistributionConfiguration(),
 			"aws_imagebuilder_image":                                  imagebuilder.ResourceImage(),
@@ -1053,10 +1108,15 @@ func Provider() *schema.Provider {
 			"aws_kms_external_key":                                    kms.ResourceExternalKey(),
 			"aws_kms_grant":                                           kms.ResourceGrant(),
 			"aws_kms_key":                                             kms.ResourceKey(),
+			"aws_kms_replica_external_key":                            kms.ResourceReplicaExternalKey(),
+			"aws_kms_replica_key":                                     kms.ResourceReplicaKey(),
 			"aws_kms_ciphertext":                                      kms.ResourceCiphertext(),
 			"aws_lakeformation_data_lake_settings":                    lakeformation.ResourceDataLakeSettings(),
+			"aws_lakeformation_lf_tag":                                lakeformation.ResourceLFTag(),
 			"aws_lakeformation_permissions":                           lakeformation.ResourcePermissions(),
+			"aws_lakeformation_permissions_batch":                     lakeformation.ResourcePermissionsBatch(),
 			"aws_lakeformation_resource":                              lakeformation.ResourceResource(),
+			"aws_lakeformation_resource_lf_tag":                       lakeformation.ResourceResourceLFTag(),
 			"aws_lambda_alias":                                        lambda.ResourceAlias(),
 			"aws_lambda_code_signing_config":                          lambda.ResourceCodeSigningConfig(),
 			"aws_lambda_event_source_mapping":                         lambda.ResourceEventSourceMapping(),
@@ -1091,6 +1151,7 @@ func Provider() *schema.Provider {
 			"aws_macie2_invitation_accepter":                          macie2.ResourceInvitationAccepter(),
 			"aws_macie2_member":                                       macie2.ResourceMember(),
 			"aws_macie2_organization_admin_account":                   macie2.ResourceOrganizationAdminAccount(),
+			"aws_macie2_organization_configuration":                   macie2.ResourceOrganizationConfiguration(),
 			"aws_macie_member_account_association":                    macie.ResourceMemberAccountAssociation(),
 			"aws_macie_s3_bucket_association":                         macie.ResourceS3BucketAssociation(),
 			"aws_main_route_table_association":                        ec2.ResourceMainRouteTableAssociation(),
@@ -1123,6 +1184,12 @@ func Provider() *schema.Provider {
 			"aws_networkfirewall_logging_configuration":               networkfirewall.ResourceLoggingConfiguration(),
 			"aws_networkfirewall_resource_policy":                     networkfirewall.ResourceResourcePolicy(),
 			"aws_networkfirewall_rule_group":                          networkfirewall.ResourceRuleGroup(),
+			"aws_opensearch_domain":                                   opensearch.ResourceDomain(),
+			"aws_opensearch_domain_policy":                            opensearch.ResourceDomainPolicy(),
+			"aws_opensearch_domain_saml_options":                      opensearch.ResourceDomainSAMLOptions(),
+			"aws_opensearch_inbound_connection_accepter":              opensearch.ResourceInboundConnectionAccepter(),
+			"aws_opensearch_outbound_connection":                      opensearch.ResourceOutboundConnection(),
+			"aws_opensearch_package_association":                      opensearch.ResourcePackageAssociation(),
 			"aws_opsworks_application":                                opsworks.ResourceApplication(),
 			"aws_opsworks_stack":                                      opsworks.ResourceStack(),
 			"aws_opsworks_java_app_layer":                             opsworks.ResourceJavaAppLayer(),
@@ -1146,6 +1213,7 @@ func Provider() *schema.Provider {
 			"aws_organizations_policy_attachment":                     organizations.ResourcePolicyAttachment(),
 			"aws_organizations_organizational_unit":                   organizations.ResourceOrganizationalUnit(),
 			"aws_placement_group":                                     ec2.ResourcePlacementGroup(),
+			"aws_prometheus_alert_manager_definition":                 prometheus.ResourceAlertManagerDefinition(),
 			"aws_prometheus_workspace":                                prometheus.ResourceWorkspace(),
 			"aws_proxy_protocol_policy":                               elb.ResourceProxyProtocolPolicy(),
 			"aws_qldb_ledger":                                         qldb.ResourceLedger(),
@@ -1162,7 +1230,9 @@ func Provider() *schema.Provider {
 			"aws_rds_cluster_instance":                                rds.ResourceClusterInstance(),
 			"aws_rds_cluster_parameter_group":                         rds.ResourceClusterParameterGroup(),
 			"aws_rds_cluster_role_association":                        rds.ResourceClusterRoleAssociation(),
+			"aws_rds_export_task":                                     rds.ResourceExportTask(),
 			"aws_rds_global_cluster":                                  rds.ResourceGlobalCluster(),
+			"aws_rds_reserved_instance":                               rds.ResourceReservedInstance(),
 			"aws_redshift_cluster":                                    redshift.ResourceCluster(),
 			"aws_redshift_security_group":                             redshift.ResourceSecurityGroup(),
 			"aws_redshift_parameter_group":                            redshift.ResourceParameterGroup(),
@@ -1172,6 +1242,8 @@ func Provider() *schema.Provider {
 			"aws_redshift_snapshot_schedule_association":              redshift.ResourceSnapshotScheduleAssociation(),
 			"aws_redshift_event_subscription":                         redshift.ResourceEventSubscription(),
 			"aws_redshift_scheduled_action":                           redshift.ResourceScheduledAction(),
+			"aws_redshift_data_share_authorization":                   redshift.ResourceDataShareAuthorization(),
+			"aws_redshift_data_share_consumer_association":            redshift.ResourceDataShareConsumerAssociation(),
 			"aws_resourcegroups_group":                                resourcegroups.ResourceGroup(),
 			"aws_route53_delegation_set":                              route53.ResourceDelegationSet(),
 			"aws_route53_hosted_zone_dnssec":                          route53.ResourceHostedZoneDNSSEC(),
@@ -1201,228 +1273,250 @@ func Provider() *schema.Provider {
 			"aws_route53recoveryreadiness_readiness_check":            route53recoveryreadiness.ResourceReadinessCheck(),
 			"aws_route53recoveryreadiness_recovery_group":             route53recoveryreadiness.ResourceRecoveryGroup(),
 			"aws_route53recoveryreadiness_resource_set":               route53recoveryreadiness.ResourceResourceSet(),
-			"aws_route":                                               ec2.ResourceRoute(),
-			"aws_route_table":                                         ec2.ResourceRouteTable(),
-			"aws_default_route_table":                                 ec2.ResourceDefaultRouteTable(),
-			"aws_route_table_association":                             ec2.ResourceRouteTableAssociation(),
-			"aws_sagemaker_app":                                       sagemaker.ResourceApp(),
-			"aws_sagemaker_app_image_config":                          sagemaker.ResourceAppImageConfig(),
-			"aws_sagemaker_code_repository":                           sagemaker.ResourceCodeRepository(),
-			"aws_sagemaker_device_fleet":                              sagemaker.ResourceDeviceFleet(),
-			"aws_sagemaker_domain":                                    sagemaker.ResourceDomain(),
-			"aws_sagemaker_endpoint":                                  sagemaker.ResourceEndpoint(),
-			"aws_sagemaker_endpoint_configuration":                    sagemaker.ResourceEndpointConfiguration(),
-			"aws_sagemaker_feature_group":                             sagemaker.ResourceFeatureGroup(),
-			"aws_sagemaker_flow_definition":                           sagemaker.ResourceFlowDefinition(),
-			"aws_sagemaker_image":                                     sagemaker.ResourceImage(),
-			"aws_sagemaker_image_version":                             sagemaker.ResourceImageVersion(),
-			"aws_sagemaker_human_task_ui":                             sagemaker.ResourceHumanTaskUI(),
-			"aws_sagemaker_model":                                     sagemaker.ResourceModel(),
-			"aws_sagemaker_model_package_group":                       sagemaker.ResourceModelPackageGroup(),
-			"aws_sagemaker_model_package_group_policy":                sagemaker.ResourceModelPackageGroupPolicy(),
-			"aws_sagemaker_notebook_instance_lifecycle_configuration": sagemaker.ResourceNotebookInstanceLifeCycleConfiguration(),
-			"aws_sagemaker_notebook_instance":                         sagemaker.ResourceNotebookInstance(),
-			"aws_sagemaker_studio_lifecycle_config":                   sagemaker.ResourceStudioLifecycleConfig(),
-			"aws_sagemaker_user_profile":                              sagemaker.ResourceUserProfile(),
-			"aws_sagemaker_workforce":                                 sagemaker.ResourceWorkforce(),
-			"aws_sagemaker_workteam":                                  sagemaker.ResourceWorkteam(),
-			"aws_schemas_discoverer":                                  schemas.ResourceDiscoverer(),
-			"aws_schemas_registry":                                    schemas.ResourceRegistry(),
-			"aws_schemas_schema":                                      schemas.ResourceSchema(),
-			"aws_secretsmanager_secret":                               secretsmanager.ResourceSecret(),
-			"aws_secretsmanager_secret_policy":                        secretsmanager.ResourceSecretPolicy(),
-			"aws_secretsmanager_secret_version":                       secretsmanager.ResourceSecretVersion(),
-			"aws_secretsmanager_secret_rotation":                      secretsmanager.ResourceSecretRotation(),
-			"aws_ses_active_receipt_rule_set":                         ses.ResourceActiveReceiptRuleSet(),
-			"aws_ses_domain_identity":                                 ses.ResourceDomainIdentity(),
-			"aws_ses_domain_identity_verification":                    ses.ResourceDomainIdentityVerification(),
-			"aws_ses_domain_dkim":                                     ses.ResourceDomainDKIM(),
-			"aws_ses_domain_mail_from":                                ses.ResourceDomainMailFrom(),
-			"aws_ses_email_identity":                                  ses.ResourceEmailIdentity(),
-			"aws_ses_identity_policy":                                 ses.ResourceIdentityPolicy(),
-			"aws_ses_receipt_filter":                                  ses.ResourceReceiptFilter(),
-			"aws_ses_receipt_rule":                                    ses.ResourceReceiptRule(),
-			"aws_ses_receipt_rule_set":                                ses.ResourceReceiptRuleSet(),
-			"aws_ses_configuration_set":                               ses.ResourceConfigurationSet(),
-			"aws_ses_event_destination":                               ses.ResourceEventDestination(),
-			"aws_ses_identity_notification_topic":                     ses.ResourceIdentityNotificationTopic(),
-			"aws_ses_template":                                        ses.ResourceTemplate(),
-			"aws_s3_access_point":                                     s3control.ResourceAccessPoint(),
-			"aws_s3_account_public_access_block":                      s3control.ResourceAccountPublicAccessBlock(),
-			"aws_s3_bucket":                                           s3.ResourceBucket(),
-			"aws_s3_bucket_analytics_configuration":                   s3.ResourceBucketAnalyticsConfiguration(),
-			"aws_s3_bucket_policy":                                    s3.ResourceBucketPolicy(),
-			"aws_s3_bucket_public_access_block":                       s3.ResourceBucketPublicAccessBlock(),
-			"aws_s3_bucket_object":                                    s3.ResourceBucketObject(),
-			"aws_s3_bucket_ownership_controls":                        s3.ResourceBucketOwnershipControls(),
-			"aws_s3_bucket_notification":                              s3.ResourceBucketNotification(),
-			"aws_s3_bucket_metric":                                    s3.ResourceBucketMetric(),
-			"aws_s3_bucket_inventory":                                 s3.ResourceBucketInventory(),
-			"aws_s3_object_copy":                                      s3.ResourceObjectCopy(),
-			"aws_s3control_bucket":                                    s3control.ResourceBucket(),
-			"aws_s3control_bucket_policy":                             s3control.ResourceBucketPolicy(),
-			"aws_s3control_bucket_lifecycle_configuration":            s3control.ResourceBucketLifecycleConfiguration(),
-			"aws_s3outposts_endpoint":                                 s3outposts.ResourceEndpoint(),
-			"aws_security_group":                                      ec2.ResourceSecurityGroup(),
-			"aws_network_interface_sg_attachment":                     ec2.ResourceNetworkInterfaceSGAttachment(),
-			"aws_default_security_group":                              ec2.ResourceDefaultSecurityGroup(),
-			"aws_security_group_rule":                                 ec2.ResourceSecurityGroupRule(),
-			"aws_securityhub_account":                                 securityhub.ResourceAccount(),
-			"aws_securityhub_action_target":                           securityhub.ResourceActionTarget(),
-			"aws_securityhub_insight":                                 securityhub.ResourceInsight(),
-			"aws_securityhub_invite_accepter":                         securityhub.ResourceInviteAccepter(),
-			"aws_securityhub_member":                                  securityhub.ResourceMember(),
-			"aws_securityhub_organization_admin_account":              securityhub.ResourceOrganizationAdminAccount(),
-			"aws_securityhub_organization_configuration":              securityhub.ResourceOrganizationConfiguration(),
-			"aws_securityhub_product_subscription":                    securityhub.ResourceProductSubscription(),
-			"aws_securityhub_standards_control":                       securityhub.ResourceStandardsControl(),
-			"aws_securityhub_standards_subscription":                  securityhub.ResourceStandardsSubscription(),
-			"aws_servicecatalog_budget_resource_association":          servicecatalog.ResourceBudgetResourceAssociation(),
-			"aws_servicecatalog_constraint":                           servicecatalog.ResourceConstraint(),
-			"aws_servicecatalog_organizations_access":                 servicecatalog.ResourceOrganizationsAccess(),
-			"aws_servicecatalog_portfolio":                            servicecatalog.ResourcePortfolio(),
-			"aws_servicecatalog_portfolio_share":                      servicecatalog.ResourcePortfolioShare(),
-			"aws_servicecatalog_product":                              servicecatalog.ResourceProduct(),
-			"aws_servicecatalog_provisioned_product":                  servicecatalog.ResourceProvisionedProduct(),
-			"aws_servicecatalog_service_action":                       servicecatalog.ResourceServiceAction(),
-			"aws_servicecatalog_tag_option":                           servicecatalog.ResourceTagOption(),
-			"aws_servicecatalog_tag_option_resource_association":      servicecatalog.ResourceTagOptionResourceAssociation(),
-			"aws_servicecatalog_principal_portfolio_association":      servicecatalog.ResourcePrincipalPortfolioAssociation(),
-			"aws_servicecatalog_product_portfolio_association":        servicecatalog.ResourceProductPortfolioAssociation(),
-			"aws_servicecatalog_provisioning_artifact":                servicecatalog.ResourceProvisioningArtifact(),
-			"aws_service_discovery_instance":                          servicediscovery.ResourceInstance(),
-			"aws_service_discovery_http_namespace":                    servicediscovery.ResourceHTTPNamespace(),
-			"aws_service_discovery_private_dns_namespace":             servicediscovery.ResourcePrivateDNSNamespace(),
-			"aws_service_discovery_public_dns_namespace":              servicediscovery.ResourcePublicDNSNamespace(),
-			"aws_service_discovery_service":                           servicediscovery.ResourceService(),
-			"aws_servicequotas_service_quota":                         servicequotas.ResourceServiceQuota(),
-			"aws_shield_protection":                                   shield.ResourceProtection(),
-			"aws_shield_protection_group":                             shield.ResourceProtectionGroup(),
-			"aws_signer_signing_job":                                  signer.ResourceSigningJob(),
-			"aws_signer_signing_profile":                              signer.ResourceSigningProfile(),
-			"aws_signer_signing_profile_permission":                   signer.ResourceSigningProfilePermission(),
-			"aws_simpledb_domain":                                     simpledb.ResourceDomain(),
-			"aws_ssm_activation":                                      ssm.ResourceActivation(),
-			"aws_ssm_association":                                     ssm.ResourceAssociation(),
-			"aws_ssm_document":                                        ssm.ResourceDocument(),
-			"aws_ssm_maintenance_window":                              ssm.ResourceMaintenanceWindow(),
-			"aws_ssm_maintenance_window_target":                       ssm.ResourceMaintenanceWindowTarget(),
-			"aws_ssm_maintenance_window_task":                         ssm.ResourceMaintenanceWindowTask(),
-			"aws_ssm_patch_baseline":                                  ssm.ResourcePatchBaseline(),
-			"aws_ssm_patch_group":                                     ssm.ResourcePatchGroup(),
-			"aws_ssm_parameter":                                       ssm.ResourceParameter(),
-			"aws_ssm_resource_data_sync":                              ssm.ResourceResourceDataSync(),
-			"aws_ssoadmin_account_assignment":                         ssoadmin.ResourceAccountAssignment(),
-			"aws_ssoadmin_managed_policy_attachment":                  ssoadmin.ResourceManagedPolicyAttachment(),
-			"aws_ssoadmin_permission_set":                             ssoadmin.ResourcePermissionSet(),
-			"aws_ssoadmin_permission_set_inline_policy":               ssoadmin.ResourcePermissionSetInlinePolicy(),
-			"aws_storagegateway_cache":                                storagegateway.ResourceCache(),
-			"aws_storagegateway_cached_iscsi_volume":                  storagegateway.ResourceCachediSCSIVolume(),
-			"aws_storagegateway_file_system_association":              storagegateway.ResourceFileSystemAssociation(),
-			"aws_storagegateway_gateway":                              storagegateway.ResourceGateway(),
-			"aws_storagegateway_nfs_file_share":                       storagegateway.ResourceNFSFileShare(),
-			"aws_storagegateway_smb_file_share":                       storagegateway.ResourceSMBFileShare(),
-			"aws_storagegateway_stored_iscsi_volume":                  storagegateway.ResourceStorediSCSIVolume(),
-			"aws_storagegateway_tape_pool":                            storagegateway.ResourceTapePool(),
-			"aws_storagegateway_upload_buffer":                        storagegateway.ResourceUploadBuffer(),
-			"aws_storagegateway_working_storage":                      storagegateway.ResourceWorkingStorage(),
-			"aws_spot_datafeed_subscription":                          ec2.ResourceSpotDataFeedSubscription(),
-			"aws_spot_instance_request":                               ec2.ResourceSpotInstanceRequest(),
-			"aws_spot_fleet_request":                                  ec2.ResourceSpotFleetRequest(),
-			"aws_sqs_queue":                                           sqs.ResourceQueue(),
-			"aws_sqs_queue_policy":                                    sqs.ResourceQueuePolicy(),
-			"aws_snapshot_create_volume_permission":                   ec2.ResourceSnapshotCreateVolumePermission(),
-			"aws_sns_platform_application":                            sns.ResourcePlatformApplication(),
-			"aws_sns_sms_preferences":                                 sns.ResourceSMSPreferences(),
-			"aws_sns_topic":                                           sns.ResourceTopic(),
-			"aws_sns_topic_policy":                                    sns.ResourceTopicPolicy(),
-			"aws_sns_topic_subscription":                              sns.ResourceTopicSubscription(),
-			"aws_sfn_activity":                                        sfn.ResourceActivity(),
-			"aws_sfn_state_machine":                                   sfn.ResourceStateMachine(),
-			"aws_default_subnet":                                      ec2.ResourceDefaultSubnet(),
-			"aws_subnet":                                              ec2.ResourceSubnet(),
-			"aws_swf_domain":                                          swf.ResourceDomain(),
-			"aws_synthetics_canary":                                   synthetics.ResourceCanary(),
-			"aws_timestreamwrite_database":                            timestreamwrite.ResourceDatabase(),
-			"aws_timestreamwrite_table":                               timestreamwrite.ResourceTable(),
-			"aws_transfer_server":                                     transfer.ResourceServer(),
-			"aws_transfer_access":                                     transfer.ResourceAccess(),
-			"aws_transfer_ssh_key":                                    transfer.ResourceSSHKey(),
-			"aws_transfer_user":                                       transfer.ResourceUser(),
-			"aws_volume_attachment":                                   ec2.ResourceVolumeAttachment(),
-			"aws_vpc_dhcp_options_association":                        ec2.ResourceVPCDHCPOptionsAssociation(),
-			"aws_default_vpc_dhcp_options":                            ec2.ResourceDefaultVPCDHCPOptions(),
-			"aws_vpc_dhcp_options":                                    ec2.ResourceVPCDHCPOptions(),
-			"aws_vpc_peering_connection":                              ec2.ResourceVPCPeeringConnection(),
-			"aws_vpc_peering_connection_accepter":                     ec2.ResourceVPCPeeringConnectionAccepter(),
-			"aws_vpc_peering_connection_options":                      ec2.ResourceVPCPeeringConnectionOptions(),
-			"aws_default_vpc":                                         ec2.ResourceDefaultVPC(),
-			"aws_vpc":                                                 ec2.ResourceVPC(),
-			"aws_vpc_endpoint":                                        ec2.ResourceVPCEndpoint(),
-			"aws_vpc_endpoint_connection_notification":                ec2.ResourceVPCEndpointConnectionNotification(),
-			"aws_vpc_endpoint_route_table_association":                ec2.ResourceVPCEndpointRouteTableAssociation(),
-			"aws_vpc_endpoint_subnet_association":                     ec2.ResourceVPCEndpointSubnetAssociation(),
-			"aws_vpc_endpoint_service":                                ec2.ResourceVPCEndpointService(),
-			"aws_vpc_endpoint_service_allowed_principal":              ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
-			"aws_vpc_ipv4_cidr_block_association":                     ec2.ResourceVPCIPv4CIDRBlockAssociation(),
-			"aws_vpn_connection":                                      ec2.ResourceVPNConnection(),
-			"aws_vpn_connection_route":                                ec2.ResourceVPNConnectionRoute(),
-			"aws_vpn_gateway":                                         ec2.ResourceVPNGateway(),
-			"aws_vpn_gateway_attachment":                              ec2.ResourceVPNGatewayAttachment(),
-			"aws_vpn_gateway_route_propagation":                       ec2.ResourceVPNGatewayRoutePropagation(),
-			"aws_waf_byte_match_set":                                  waf.ResourceByteMatchSet(),
-			"aws_waf_ipset":                                           waf.ResourceIPSet(),
-			"aws_waf_rate_based_rule":                                 waf.ResourceRateBasedRule(),
-			"aws_waf_regex_match_set":                                 waf.ResourceRegexMatchSet(),
-			"aws_waf_regex_pattern_set":                               waf.ResourceRegexPatternSet(),
-			"aws_waf_rule":                                            waf.ResourceRule(),
-			"aws_waf_rule_group":                                      waf.ResourceRuleGroup(),
-			"aws_waf_size_constraint_set":                             waf.ResourceSizeConstraintSet(),
-			"aws_waf_web_acl":                                         waf.ResourceWebACL(),
-			"aws_waf_xss_match_set":                                   waf.ResourceXSSMatchSet(),
-			"aws_waf_sql_injection_match_set":                         waf.ResourceSQLInjectionMatchSet(),
-			"aws_waf_geo_match_set":                                   waf.ResourceGeoMatchSet(),
-			"aws_wafregional_byte_match_set":                          wafregional.ResourceByteMatchSet(),
-			"aws_wafregional_geo_match_set":                           wafregional.ResourceGeoMatchSet(),
-			"aws_wafregional_ipset":                                   wafregional.ResourceIPSet(),
-			"aws_wafregional_rate_based_rule":                         wafregional.ResourceRateBasedRule(),
-			"aws_wafregional_regex_match_set":                         wafregional.ResourceRegexMatchSet(),
-			"aws_wafregional_regex_pattern_set":                       wafregional.ResourceRegexPatternSet(),
-			"aws_wafregional_rule":                                    wafregional.ResourceRule(),
-			"aws_wafregional_rule_group":                              wafregional.ResourceRuleGroup(),
-			"aws_wafregional_size_constraint_set":                     wafregional.ResourceSizeConstraintSet(),
-			"aws_wafregional_sql_injection_match_set":                 wafregional.ResourceSQLInjectionMatchSet(),
-			"aws_wafregional_xss_match_set":                           wafregional.ResourceXSSMatchSet(),
-			"aws_wafregional_web_acl":                                 wafregional.ResourceWebACL(),
-			"aws_wafregional_web_acl_association":                     wafregional.ResourceWebACLAssociation(),
-			"aws_wafv2_ip_set":                                        wafv2.ResourceIPSet(),
-			"aws_wafv2_regex_pattern_set":                             wafv2.ResourceRegexPatternSet(),
-			"aws_wafv2_rule_group":                                    wafv2.ResourceRuleGroup(),
-			"aws_wafv2_web_acl":                                       wafv2.ResourceWebACL(),
-			"aws_wafv2_web_acl_association":                           wafv2.ResourceWebACLAssociation(),
-			"aws_wafv2_web_acl_logging_configuration":                 wafv2.ResourceWebACLLoggingConfiguration(),
-			"aws_worklink_fleet":                                      worklink.ResourceFleet(),
-			"aws_worklink_website_certificate_authority_association":  worklink.ResourceWebsiteCertificateAuthorityAssociation(),
-			"aws_workspaces_directory":                                workspaces.ResourceDirectory(),
-			"aws_workspaces_workspace":                                workspaces.ResourceWorkspace(),
-			"aws_batch_compute_environment":                           batch.ResourceComputeEnvironment(),
-			"aws_batch_job_definition":                                batch.ResourceJobDefinition(),
-			"aws_batch_job_queue":                                     batch.ResourceJobQueue(),
-			"aws_pinpoint_app":                                        pinpoint.ResourceApp(),
-			"aws_pinpoint_adm_channel":                                pinpoint.ResourceADMChannel(),
-			"aws_pinpoint_apns_channel":                               pinpoint.ResourceAPNSChannel(),
-			"aws_pinpoint_apns_sandbox_channel":                       pinpoint.ResourceAPNSSandboxChannel(),
-			"aws_pinpoint_apns_voip_channel":                          pinpoint.ResourceAPNSVoIPChannel(),
-			"aws_pinpoint_apns_voip_sandbox_channel":                  pinpoint.ResourceAPNSVoIPSandboxChannel(),
-			"aws_pinpoint_baidu_channel":                              pinpoint.ResourceBaiduChannel(),
-			"aws_pinpoint_email_channel":                              pinpoint.ResourceEmailChannel(),
-			"aws_pinpoint_event_stream":                               pinpoint.ResourceEventStream(),
-			"aws_pinpoint_gcm_channel":                                pinpoint.ResourceGCMChannel(),
-			"aws_pinpoint_sms_channel":                                pinpoint.ResourceSMSChannel(),
-			"aws_xray_encryption_config":                              xray.ResourceEncryptionConfig(),
-			"aws_xray_group":                                          xray.ResourceGroup(),
-			"aws_xray_sampling_rule":                                  xray.ResourceSamplingRule(),
-			"aws_workspaces_ip_group":                                 workspaces.ResourceIPGroup(),
+			"aws_route":                                                 ec2.ResourceRoute(),
+			"aws_route_table":                                           ec2.ResourceRouteTable(),
+			"aws_default_route_table":                                   ec2.ResourceDefaultRouteTable(),
+			"aws_route_table_association":                               ec2.ResourceRouteTableAssociation(),
+			"aws_sagemaker_app":                                         sagemaker.ResourceApp(),
+			"aws_sagemaker_app_image_config":                            sagemaker.ResourceAppImageConfig(),
+			"aws_sagemaker_code_repository":                             sagemaker.ResourceCodeRepository(),
+			"aws_sagemaker_device_fleet":                                sagemaker.ResourceDeviceFleet(),
+			"aws_sagemaker_domain":                                      sagemaker.ResourceDomain(),
+			"aws_sagemaker_endpoint":                                    sagemaker.ResourceEndpoint(),
+			"aws_sagemaker_endpoint_configuration":                      sagemaker.ResourceEndpointConfiguration(),
+			"aws_sagemaker_feature_group":                               sagemaker.ResourceFeatureGroup(),
+			"aws_sagemaker_flow_definition":                             sagemaker.ResourceFlowDefinition(),
+			"aws_sagemaker_image":                                       sagemaker.ResourceImage(),
+			"aws_sagemaker_image_version":                               sagemaker.ResourceImageVersion(),
+			"aws_sagemaker_human_task_ui":                               sagemaker.ResourceHumanTaskUI(),
+			"aws_sagemaker_model":                                       sagemaker.ResourceModel(),
+			"aws_sagemaker_model_package_group":                         sagemaker.ResourceModelPackageGroup(),
+			"aws_sagemaker_model_package_group_policy":                  sagemaker.ResourceModelPackageGroupPolicy(),
+			"aws_sagemaker_notebook_instance_lifecycle_configuration":   sagemaker.ResourceNotebookInstanceLifeCycleConfiguration(),
+			"aws_sagemaker_notebook_instance":                           sagemaker.ResourceNotebookInstance(),
+			"aws_sagemaker_studio_lifecycle_config":                     sagemaker.ResourceStudioLifecycleConfig(),
+			"aws_sagemaker_user_profile":                                sagemaker.ResourceUserProfile(),
+			"aws_sagemaker_workforce":                                   sagemaker.ResourceWorkforce(),
+			"aws_sagemaker_workteam":                                    sagemaker.ResourceWorkteam(),
+			"aws_schemas_discoverer":                                    schemas.ResourceDiscoverer(),
+			"aws_schemas_registry":                                      schemas.ResourceRegistry(),
+			"aws_schemas_schema":                                        schemas.ResourceSchema(),
+			"aws_secretsmanager_secret":                                 secretsmanager.ResourceSecret(),
+			"aws_secretsmanager_secret_policy":                          secretsmanager.ResourceSecretPolicy(),
+			"aws_secretsmanager_secret_version":                         secretsmanager.ResourceSecretVersion(),
+			"aws_secretsmanager_secret_rotation":                        secretsmanager.ResourceSecretRotation(),
+			"aws_ses_active_receipt_rule_set":                           ses.ResourceActiveReceiptRuleSet(),
+			"aws_ses_domain_identity":                                   ses.ResourceDomainIdentity(),
+			"aws_ses_domain_identity_verification":                      ses.ResourceDomainIdentityVerification(),
+			"aws_ses_domain_dkim":                                       ses.ResourceDomainDKIM(),
+			"aws_ses_domain_mail_from":                                  ses.ResourceDomainMailFrom(),
+			"aws_ses_email_identity":                                    ses.ResourceEmailIdentity(),
+			"aws_ses_identity_policy":                                   ses.ResourceIdentityPolicy(),
+			"aws_ses_receipt_filter":                                    ses.ResourceReceiptFilter(),
+			"aws_ses_receipt_rule":                                      ses.ResourceReceiptRule(),
+			"aws_ses_receipt_rule_set":                                  ses.ResourceReceiptRuleSet(),
+			"aws_ses_configuration_set":                                 ses.ResourceConfigurationSet(),
+			"aws_ses_event_destination":                                 ses.ResourceEventDestination(),
+			"aws_ses_identity_notification_topic":                       ses.ResourceIdentityNotificationTopic(),
+			"aws_ses_template":                                          ses.ResourceTemplate(),
+			"aws_sesv2_configuration_set":                               sesv2.ResourceConfigurationSet(),
+			"aws_sesv2_configuration_set_event_destination":             sesv2.ResourceConfigurationSetEventDestination(),
+			"aws_sesv2_contact_list":                                    sesv2.ResourceContactList(),
+			"aws_sesv2_dedicated_ip_pool":                               sesv2.ResourceDedicatedIPPool(),
+			"aws_sesv2_email_identity":                                  sesv2.ResourceEmailIdentity(),
+			"aws_s3_access_point":                                       s3control.ResourceAccessPoint(),
+			"aws_s3_account_public_access_block":                        s3control.ResourceAccountPublicAccessBlock(),
+			"aws_s3_bucket":                                             s3.ResourceBucket(),
+			"aws_s3_bucket_analytics_configuration":                     s3.ResourceBucketAnalyticsConfiguration(),
+			"aws_s3_bucket_cors_configuration":                          s3.ResourceBucketCorsConfiguration(),
+			"aws_s3_bucket_lifecycle_configuration":                     s3.ResourceBucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                                     s3.ResourceBucketLogging(),
+			"aws_s3_bucket_policy":                                      s3.ResourceBucketPolicy(),
+			"aws_s3_bucket_public_access_block":                         s3.ResourceBucketPublicAccessBlock(),
+			"aws_s3_bucket_object":                                      s3.ResourceBucketObject(),
+			"aws_s3_bucket_ownership_controls":                          s3.ResourceBucketOwnershipControls(),
+			"aws_s3_bucket_notification":                                s3.ResourceBucketNotification(),
+			"aws_s3_bucket_metric":                                      s3.ResourceBucketMetric(),
+			"aws_s3_bucket_inventory":                                   s3.ResourceBucketInventory(),
+			"aws_s3_bucket_server_side_encryption_configuration":        s3.ResourceBucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                                  s3.ResourceBucketVersioning(),
+			"aws_s3_bucket_website_configuration":                       s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_object_copy":                                        s3.ResourceObjectCopy(),
+			"aws_s3control_bucket":                                      s3control.ResourceBucket(),
+			"aws_s3control_bucket_policy":                               s3control.ResourceBucketPolicy(),
+			"aws_s3control_bucket_lifecycle_configuration":              s3control.ResourceBucketLifecycleConfiguration(),
+			"aws_s3control_multi_region_access_point":                   s3control.ResourceMultiRegionAccessPoint(),
+			"aws_s3control_multi_region_access_point_policy":            s3control.ResourceMultiRegionAccessPointPolicy(),
+			"aws_s3outposts_endpoint":                                   s3outposts.ResourceEndpoint(),
+			"aws_security_group":                                        ec2.ResourceSecurityGroup(),
+			"aws_network_interface_sg_attachment":                       ec2.ResourceNetworkInterfaceSGAttachment(),
+			"aws_default_security_group":                                ec2.ResourceDefaultSecurityGroup(),
+			"aws_security_group_rule":                                   ec2.ResourceSecurityGroupRule(),
+			"aws_securityhub_account":                                   securityhub.ResourceAccount(),
+			"aws_securityhub_action_target":                             securityhub.ResourceActionTarget(),
+			"aws_securityhub_insight":                                   securityhub.ResourceInsight(),
+			"aws_securityhub_invite_accepter":                           securityhub.ResourceInviteAccepter(),
+			"aws_securityhub_member":                                    securityhub.ResourceMember(),
+			"aws_securityhub_organization_admin_account":                securityhub.ResourceOrganizationAdminAccount(),
+			"aws_securityhub_organization_configuration":                securityhub.ResourceOrganizationConfiguration(),
+			"aws_securityhub_product_subscription":                      securityhub.ResourceProductSubscription(),
+			"aws_securityhub_standards_control":                         securityhub.ResourceStandardsControl(),
+			"aws_securityhub_standards_subscription":                    securityhub.ResourceStandardsSubscription(),
+			"aws_servicecatalog_budget_resource_association":            servicecatalog.ResourceBudgetResourceAssociation(),
+			"aws_servicecatalog_constraint":                             servicecatalog.ResourceConstraint(),
+			"aws_servicecatalog_organizations_access":                   servicecatalog.ResourceOrganizationsAccess(),
+			"aws_servicecatalog_portfolio":                              servicecatalog.ResourcePortfolio(),
+			"aws_servicecatalog_portfolio_share":                        servicecatalog.ResourcePortfolioShare(),
+			"aws_servicecatalog_product":                                servicecatalog.ResourceProduct(),
+			"aws_servicecatalog_provisioned_product":                    servicecatalog.ResourceProvisionedProduct(),
+			"aws_servicecatalog_service_action":                         servicecatalog.ResourceServiceAction(),
+			"aws_servicecatalog_tag_option":                             servicecatalog.ResourceTagOption(),
+			"aws_servicecatalog_tag_option_resource_association":        servicecatalog.ResourceTagOptionResourceAssociation(),
+			"aws_servicecatalog_principal_portfolio_association":        servicecatalog.ResourcePrincipalPortfolioAssociation(),
+			"aws_servicecatalog_product_portfolio_association":          servicecatalog.ResourceProductPortfolioAssociation(),
+			"aws_servicecatalog_provisioning_artifact":                  servicecatalog.ResourceProvisioningArtifact(),
+			"aws_servicecatalogappregistry_application":                 servicecatalogappregistry.ResourceApplication(),
+			"aws_servicecatalogappregistry_attribute_group":             servicecatalogappregistry.ResourceAttributeGroup(),
+			"aws_servicecatalogappregistry_attribute_group_association": servicecatalogappregistry.ResourceAttributeGroupAssociation(),
+			"aws_service_discovery_instance":                            servicediscovery.ResourceInstance(),
+			"aws_service_discovery_http_namespace":                      servicediscovery.ResourceHTTPNamespace(),
+			"aws_service_discovery_private_dns_namespace":               servicediscovery.ResourcePrivateDNSNamespace(),
+			"aws_service_discovery_public_dns_namespace":                servicediscovery.ResourcePublicDNSNamespace(),
+			"aws_service_discovery_service":                             servicediscovery.ResourceService(),
+			"aws_servicequotas_service_quota":                           servicequotas.ResourceServiceQuota(),
+			"aws_shield_drt_access_role_arn_association":                shield.ResourceDRTAccessRoleARNAssociation(),
+			"aws_shield_proactive_engagement":                           shield.ResourceProactiveEngagement(),
+			"aws_shield_protection":                                     shield.ResourceProtection(),
+			"aws_shield_protection_group":                               shield.ResourceProtectionGroup(),
+			"aws_signer_signing_job":                                    signer.ResourceSigningJob(),
+			"aws_signer_signing_profile":                                signer.ResourceSigningProfile(),
+			"aws_signer_signing_profile_permission":                     signer.ResourceSigningProfilePermission(),
+			"aws_simpledb_domain":                                       simpledb.ResourceDomain(),
+			"aws_ssm_activation":                                        ssm.ResourceActivation(),
+			"aws_ssm_association":                                       ssm.ResourceAssociation(),
+			"aws_ssm_document":                                          ssm.ResourceDocument(),
+			"aws_ssm_maintenance_window":                                ssm.ResourceMaintenanceWindow(),
+			"aws_ssm_maintenance_window_target":                         ssm.ResourceMaintenanceWindowTarget(),
+			"aws_ssm_maintenance_window_task":                           ssm.ResourceMaintenanceWindowTask(),
+			"aws_ssm_patch_baseline":                                    ssm.ResourcePatchBaseline(),
+			"aws_ssm_patch_group":                                       ssm.ResourcePatchGroup(),
+			"aws_ssm_parameter":                                         ssm.ResourceParameter(),
+			"aws_ssm_resource_data_sync":                                ssm.ResourceResourceDataSync(),
+			"aws_ssoadmin_account_assignment":                           ssoadmin.ResourceAccountAssignment(),
+			"aws_ssoadmin_instance_access_control_attributes":           ssoadmin.ResourceInstanceAccessControlAttributes(),
+			"aws_ssoadmin_managed_policy_attachment":                    ssoadmin.ResourceManagedPolicyAttachment(),
+			"aws_ssoadmin_permission_set":                               ssoadmin.ResourcePermissionSet(),
+			"aws_ssoadmin_permission_set_inline_policy":                 ssoadmin.ResourcePermissionSetInlinePolicy(),
+			"aws_storagegateway_cache":                                  storagegateway.ResourceCache(),
+			"aws_storagegateway_cached_iscsi_volume":                    storagegateway.ResourceCachediSCSIVolume(),
+			"aws_storagegateway_file_system_association":                storagegateway.ResourceFileSystemAssociation(),
+			"aws_storagegateway_gateway":                                storagegateway.ResourceGateway(),
+			"aws_storagegateway_nfs_file_share":                         storagegateway.ResourceNFSFileShare(),
+			"aws_storagegateway_smb_file_share":                         storagegateway.ResourceSMBFileShare(),
+			"aws_storagegateway_stored_iscsi_volume":                    storagegateway.ResourceStorediSCSIVolume(),
+			"aws_storagegateway_tape_pool":                              storagegateway.ResourceTapePool(),
+			"aws_storagegateway_upload_buffer":                          storagegateway.ResourceUploadBuffer(),
+			"aws_storagegateway_working_storage":                        storagegateway.ResourceWorkingStorage(),
+			"aws_spot_datafeed_subscription":                            ec2.ResourceSpotDataFeedSubscription(),
+			"aws_spot_instance_request":                                 ec2.ResourceSpotInstanceRequest(),
+			"aws_spot_fleet_request":                                    ec2.ResourceSpotFleetRequest(),
+			"aws_sqs_queue":                                             sqs.ResourceQueue(),
+			"aws_sqs_queue_policy":                                      sqs.ResourceQueuePolicy(),
+			"aws_sqs_queue_redrive_allow_policy":                        sqs.ResourceQueueRedriveAllowPolicy(),
+			"aws_sqs_queue_redrive_policy":                              sqs.ResourceQueueRedrivePolicy(),
+			"aws_snapshot_create_volume_permission":                     ec2.ResourceSnapshotCreateVolumePermission(),
+			"aws_sns_platform_application":                              sns.ResourcePlatformApplication(),
+			"aws_sns_sms_preferences":                                   sns.ResourceSMSPreferences(),
+			"aws_sns_topic":                                             sns.ResourceTopic(),
+			"aws_sns_topic_policy":                                      sns.ResourceTopicPolicy(),
+			"aws_sns_topic_subscription":                                sns.ResourceTopicSubscription(),
+			"aws_sfn_activity":                                          sfn.ResourceActivity(),
+			"aws_sfn_state_machine":                                     sfn.ResourceStateMachine(),
+			"aws_default_subnet":                                        ec2.ResourceDefaultSubnet(),
+			"aws_subnet":                                                ec2.ResourceSubnet(),
+			"aws_swf_domain":                                            swf.ResourceDomain(),
+			"aws_synthetics_canary":                                     synthetics.ResourceCanary(),
+			"aws_timestreamwrite_database":                              timestreamwrite.ResourceDatabase(),
+			"aws_timestreamwrite_table":                                 timestreamwrite.ResourceTable(),
+			"aws_transfer_server":                                       transfer.ResourceServer(),
+			"aws_transfer_access":                                       transfer.ResourceAccess(),
+			"aws_transfer_ssh_key":                                      transfer.ResourceSSHKey(),
+			"aws_transfer_user":                                         transfer.ResourceUser(),
+			"aws_transfer_workflow":                                     transfer.ResourceWorkflow(),
+			"aws_volume_attachment":                                     ec2.ResourceVolumeAttachment(),
+			"aws_vpc_dhcp_options_association":                          ec2.ResourceVPCDHCPOptionsAssociation(),
+			"aws_default_vpc_dhcp_options":                              ec2.ResourceDefaultVPCDHCPOptions(),
+			"aws_vpc_dhcp_options":                                      ec2.ResourceVPCDHCPOptions(),
+			"aws_vpc_peering_connection":                                ec2.ResourceVPCPeeringConnection(),
+			"aws_vpc_peering_connection_accepter":                       ec2.ResourceVPCPeeringConnectionAccepter(),
+			"aws_vpc_peering_connection_options":                        ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_default_vpc":                                           ec2.ResourceDefaultVPC(),
+			"aws_vpc":                                                   ec2.ResourceVPC(),
+			"aws_vpc_endpoint":                                          ec2.ResourceVPCEndpoint(),
+			"aws_vpc_endpoint_connection_notification":                  ec2.ResourceVPCEndpointConnectionNotification(),
+			"aws_vpc_endpoint_route_table_association":                  ec2.ResourceVPCEndpointRouteTableAssociation(),
+			"aws_vpc_endpoint_subnet_association":                       ec2.ResourceVPCEndpointSubnetAssociation(),
+			"aws_vpc_endpoint_service":                                  ec2.ResourceVPCEndpointService(),
+			"aws_vpc_endpoint_service_allowed_principal":                ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
+			"aws_vpc_ipv4_cidr_block_association":                       ec2.ResourceVPCIPv4CIDRBlockAssociation(),
+			"aws_vpn_connection":                                        ec2.ResourceVPNConnection(),
+			"aws_vpn_connection_route":                                  ec2.ResourceVPNConnectionRoute(),
+			"aws_vpn_gateway":                                           ec2.ResourceVPNGateway(),
+			"aws_vpn_gateway_attachment":                                ec2.ResourceVPNGatewayAttachment(),
+			"aws_vpn_gateway_route_propagation":                         ec2.ResourceVPNGatewayRoutePropagation(),
+			"aws_waf_byte_match_set":                                    waf.ResourceByteMatchSet(),
+			"aws_waf_ipset":                                             waf.ResourceIPSet(),
+			"aws_waf_rate_based_rule":                                   waf.ResourceRateBasedRule(),
+			"aws_waf_regex_match_set":                                   waf.ResourceRegexMatchSet(),
+			"aws_waf_regex_pattern_set":                                 waf.ResourceRegexPatternSet(),
+			"aws_waf_rule":                                              waf.ResourceRule(),
+			"aws_waf_rule_group":                                        waf.ResourceRuleGroup(),
+			"aws_waf_size_constraint_set":                               waf.ResourceSizeConstraintSet(),
+			"aws_waf_web_acl":                                           waf.ResourceWebACL(),
+			"aws_waf_xss_match_set":                                     waf.ResourceXSSMatchSet(),
+			"aws_waf_sql_injection_match_set":                           waf.ResourceSQLInjectionMatchSet(),
+			"aws_waf_geo_match_set":                                     waf.ResourceGeoMatchSet(),
+			"aws_wafregional_byte_match_set":                            wafregional.ResourceByteMatchSet(),
+			"aws_wafregional_geo_match_set":                             wafregional.ResourceGeoMatchSet(),
+			"aws_wafregional_ipset":                                     wafregional.ResourceIPSet(),
+			"aws_wafregional_rate_based_rule":                           wafregional.ResourceRateBasedRule(),
+			"aws_wafregional_regex_match_set":                           wafregional.ResourceRegexMatchSet(),
+			"aws_wafregional_regex_pattern_set":                         wafregional.ResourceRegexPatternSet(),
+			"aws_wafregional_rule":                                      wafregional.ResourceRule(),
+			"aws_wafregional_rule_group":                                wafregional.ResourceRuleGroup(),
+			"aws_wafregional_size_constraint_set":                       wafregional.ResourceSizeConstraintSet(),
+			"aws_wafregional_sql_injection_match_set":                   wafregional.ResourceSQLInjectionMatchSet(),
+			"aws_wafregional_xss_match_set":                             wafregional.ResourceXSSMatchSet(),
+			"aws_wafregional_web_acl":                                   wafregional.ResourceWebACL(),
+			"aws_wafregional_web_acl_association":                       wafregional.ResourceWebACLAssociation(),
+			"aws_wafv2_ip_set":                                          wafv2.ResourceIPSet(),
+			"aws_wafv2_regex_pattern_set":                               wafv2.ResourceRegexPatternSet(),
+			"aws_wafv2_rule_group":                                      wafv2.ResourceRuleGroup(),
+			"aws_wafv2_web_acl":                                         wafv2.ResourceWebACL(),
+			"aws_wafv2_web_acl_association":                             wafv2.ResourceWebACLAssociation(),
+			"aws_wafv2_web_acl_logging_configuration":                   wafv2.ResourceWebACLLoggingConfiguration(),
+			"aws_worklink_fleet":                                        worklink.ResourceFleet(),
+			"aws_worklink_website_certificate_authority_association":    worklink.ResourceWebsiteCertificateAuthorityAssociation(),
+			"aws_workspaces_directory":                                  workspaces.ResourceDirectory(),
+			"aws_workspaces_workspace":                                  workspaces.ResourceWorkspace(),
+			"aws_batch_compute_environment":                             batch.ResourceComputeEnvironment(),
+			"aws_batch_job_definition":                                  batch.ResourceJobDefinition(),
+			"aws_batch_job_queue":                                       batch.ResourceJobQueue(),
+			"aws_pinpoint_app":                                          pinpoint.ResourceApp(),
+			"aws_pinpoint_adm_channel":                                  pinpoint.ResourceADMChannel(),
+			"aws_pinpoint_apns_channel":                                 pinpoint.ResourceAPNSChannel(),
+			"aws_pinpoint_apns_sandbox_channel":                         pinpoint.ResourceAPNSSandboxChannel(),
+			"aws_pinpoint_apns_voip_channel":                            pinpoint.ResourceAPNSVoIPChannel(),
+			"aws_pinpoint_apns_voip_sandbox_channel":                    pinpoint.ResourceAPNSVoIPSandboxChannel(),
+			"aws_pinpoint_baidu_channel":                                pinpoint.ResourceBaiduChannel(),
+			"aws_pinpoint_email_channel":                                pinpoint.ResourceEmailChannel(),
+			"aws_pinpoint_event_stream":                                 pinpoint.ResourceEventStream(),
+			"aws_pinpoint_gcm_channel":                                  pinpoint.ResourceGCMChannel(),
+			"aws_pinpoint_sms_channel":                                  pinpoint.ResourceSMSChannel(),
+			"aws_xray_encryption_config":                                xray.ResourceEncryptionConfig(),
+			"aws_xray_group":                                            xray.ResourceGroup(),
+			"aws_xray_sampling_rule":                                    xray.ResourceSamplingRule(),
+			"aws_workspaces_ip_group":                                   workspaces.ResourceIPGroup(),
 
 			// ALBs are actually LBs because they can be type `network` or `application`
 			// To avoid regressions, we will add a new resource for each and they both point
@@ -1488,9 +1582,21 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"service_max_retries": "Override max_retries for the API clients of individual services,\n" +
+			"keyed by the same service names as the endpoints configuration block.",
+
 		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API. " +
 			"Can also be configured using the `HTTP_PROXY` or `HTTPS_PROXY` environment variables.",
 
+		"https_proxy": "The address of an HTTPS proxy to use when accessing the AWS API. " +
+			"If not set, `http_proxy` is used for HTTPS requests.",
+
+		"no_proxy": "Comma-separated list of hosts, and their subdomains, to access directly " +
+			"rather than through `http_proxy` or `https_proxy`.",
+
+		"custom_ca_bundle": "The path to a file containing PEM encoded certificates to trust " +
+			"in addition to the system certificate pool when accessing the AWS API.",
+
 		"endpoint": "Use this to override the default service endpoint URL",
 
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
@@ -1629,6 +1735,7 @@ func init() {
 		"neptune",
 		"networkfirewall",
 		"networkmanager",
+		"opensearch",
 		"opsworks",
 		"organizations",
 		"outposts",
@@ -1657,9 +1764,11 @@ func init() {
 		"securityhub",
 		"serverlessrepo",
 		"servicecatalog",
+		"servicecatalogappregistry",
 		"servicediscovery",
 		"servicequotas",
 		"ses",
+		"sesv2",
 		"shield",
 		"signer",
 		"sns",
@@ -1696,7 +1805,10 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		MaxRetries:              d.Get("max_retries").(int),
 		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
 		Insecure:                d.Get("insecure").(bool),
+		CustomCABundle:          d.Get("custom_ca_bundle").(string),
 		HTTPProxy:               d.Get("http_proxy").(string),
+		HTTPSProxy:              d.Get("https_proxy").(string),
+		NoProxy:                 d.Get("no_proxy").(string),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
@@ -1770,6 +1882,32 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
 	}
 
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+
+		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+			config.AssumeRoleWithWebIdentityDurationSeconds = v
+		}
+
+		if v, ok := m["role_arn"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityARN = v
+		}
+
+		if v, ok := m["session_name"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentitySessionName = v
+		}
+
+		if v, ok := m["web_identity_token"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityToken = v
+		}
+
+		if v, ok := m["web_identity_token_file"].(string); ok && v != "" {
+			config.AssumeRoleWithWebIdentityTokenFile = v
+		}
+
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.AssumeRoleWithWebIdentityARN, config.AssumeRoleWithWebIdentitySessionName)
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
@@ -1779,6 +1917,14 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		}
 	}
 
+	if v, ok := d.GetOk("service_max_retries"); ok {
+		config.ServiceMaxRetries = make(map[string]int)
+
+		for k, v := range v.(map[string]interface{}) {
+			config.ServiceMaxRetries[k] = v.(int)
+		}
+	}
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
 		for _, accountIDRaw := range v.(*schema.Set).List() {
 			config.AllowedAccountIds = append(config.AllowedAccountIds, accountIDRaw.(string))
@@ -1854,6 +2000,48 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "Seconds to restrict the assume role session duration.",
+					ValidateFunc: validation.IntBetween(900, 43200),
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name of an IAM Role to assume with a web identity prior to making API calls.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Identifier for the assumed role session.",
+				},
+				"web_identity_token": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					Description:  "OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+				"web_identity_token_file": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Path to a file containing an OAuth 2.0 access token or OpenID Connect ID token provided by the identity provider.",
+					ExactlyOneOf: []string{"assume_role_with_web_identity.0.web_identity_token", "assume_role_with_web_identity.0.web_identity_token_file"},
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1875,6 +2063,35 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func validServiceMaxRetries(v interface{}, k string) (ws []string, errors []error) {
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return ws, errors
+	}
+
+	for service, retries := range m {
+		found := false
+
+		for _, endpointServiceName := range EndpointServiceNames {
+			if service == endpointServiceName {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			errors = append(errors, fmt.Errorf("%q contains an unsupported service name: %s", k, service))
+		}
+
+		if v, ok := retries.(int); ok && v < 0 {
+			errors = append(errors, fmt.Errorf("%q (%s) must not be negative, got: %d", k, service, v))
+		}
+	}
+
+	return ws, errors
+}
+
 func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -69,7 +69,7 @@ func ResourceAnalyzer() *schema.Resource {
 }
 
 func resourceAnalyzerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	analyzerName := d.Get("analyzer_name").(string)
@@ -110,7 +110,7 @@ func resourceAnalyzerCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAnalyzerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -140,7 +140,7 @@ func resourceAnalyzerRead(d *schema.ResourceData, meta interface{}) error {
 	tags := KeyValueTags(output.Analyzer.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -154,7 +154,7 @@ func resourceAnalyzerRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAnalyzerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -167,7 +167,7 @@ func resourceAnalyzerUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAnalyzerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AccessAnalyzerConn
+	conn := meta.(*conns.AWSClient).AccessAnalyzerConn()
 
 	input := &accessanalyzer.DeleteAnalyzerInput{
 		AnalyzerName: aws.String(d.Id()),
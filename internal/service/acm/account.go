@@ -0,0 +1,142 @@
+package acm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	// Default number of days prior to certificate expiration when ACM starts generating EventBridge events.
+	accountExpiryEventsDaysBeforeExpiryDefault = 45
+)
+
+func ResourceAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccountPut,
+		Read:   resourceAccountRead,
+		Update: resourceAccountPut,
+		Delete: resourceAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"expiry_events": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days_before_expiry": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 45),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAccountPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ACMConn()
+
+	input := &acm.PutAccountConfigurationInput{
+		IdempotencyToken: aws.String(resource.PrefixedUniqueId("tf")), // 32 character limit
+	}
+
+	if v, ok := d.GetOk("expiry_events"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ExpiryEvents = expandAcmExpiryEventsConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Putting ACM Account configuration: %s", input)
+	_, err := conn.PutAccountConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting ACM Account configuration: %w", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	}
+
+	return resourceAccountRead(d, meta)
+}
+
+func resourceAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ACMConn()
+
+	output, err := FindAccountConfiguration(conn)
+
+	if err != nil {
+		return fmt.Errorf("error reading ACM Account configuration: %w", err)
+	}
+
+	if output.ExpiryEvents != nil {
+		if err := d.Set("expiry_events", []interface{}{flattenAcmExpiryEventsConfiguration(output.ExpiryEvents)}); err != nil {
+			return fmt.Errorf("error setting expiry_events: %w", err)
+		}
+	} else {
+		d.Set("expiry_events", nil)
+	}
+
+	return nil
+}
+
+func resourceAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ACMConn()
+
+	// Removing the resource restores the ACM default.
+	input := &acm.PutAccountConfigurationInput{
+		ExpiryEvents: &acm.ExpiryEventsConfiguration{
+			DaysBeforeExpiry: aws.Int64(accountExpiryEventsDaysBeforeExpiryDefault),
+		},
+		IdempotencyToken: aws.String(resource.PrefixedUniqueId("tf")), // 32 character limit
+	}
+
+	log.Printf("[DEBUG] Resetting ACM Account configuration: %s", input)
+	_, err := conn.PutAccountConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error resetting ACM Account configuration: %w", err)
+	}
+
+	return nil
+}
+
+func expandAcmExpiryEventsConfiguration(tfMap map[string]interface{}) *acm.ExpiryEventsConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &acm.ExpiryEventsConfiguration{}
+
+	if v, ok := tfMap["days_before_expiry"].(int); ok && v != 0 {
+		apiObject.DaysBeforeExpiry = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenAcmExpiryEventsConfiguration(apiObject *acm.ExpiryEventsConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DaysBeforeExpiry; v != nil {
+		tfMap["days_before_expiry"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,52 @@
+package acm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccACMAccount_basic(t *testing.T) {
+	resourceName := "aws_acm_account.test"
+
+	// Account-level configuration is a singleton, so this test must not run in parallel.
+	resource.Test(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, acm.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountConfig(30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "expiry_events.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "expiry_events.0.days_before_expiry", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAccountConfig(7),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "expiry_events.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "expiry_events.0.days_before_expiry", "7"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccountConfig(daysBeforeExpiry int) string {
+	return fmt.Sprintf(`
+resource "aws_acm_account" "test" {
+  expiry_events {
+    days_before_expiry = %[1]d
+  }
+}
+`, daysBeforeExpiry)
+}
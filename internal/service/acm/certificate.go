@@ -3,10 +3,13 @@ package acm
 import (
 	"context"
 	"crypto/sha1"
+	"crypto/x509"
 	"encoding/hex"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"log"
+	"math/big"
 	"regexp"
 	"strings"
 	"time"
@@ -63,6 +66,27 @@ func ResourceCertificate() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"export_passphrase": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"private_key", "certificate_body", "certificate_chain"},
+				RequiredWith:  []string{"certificate_authority_arn"},
+				ValidateFunc:  validation.StringMatch(regexp.MustCompile(`^[^#$%]{4,}$`), "must be at least 4 characters and cannot contain #, $ or %"),
+			},
+			"exported_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"exported_certificate_chain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"exported_private_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			"domain_name": {
 				// AWS Provider 3.0.0 aws_route53_zone references no longer contain a
 				// trailing period, no longer requiring a custom StateFunc
@@ -206,6 +230,35 @@ func ResourceCertificate() *schema.Resource {
 
 				return nil
 			},
+			customdiff.IfValueChange("export_passphrase",
+				func(_ context.Context, old, new, meta interface{}) bool {
+					return old.(string) != new.(string)
+				},
+				func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+					for _, k := range []string{"exported_certificate", "exported_certificate_chain", "exported_private_key"} {
+						if err := diff.SetNewComputed(k); err != nil {
+							return fmt.Errorf("error setting %s to computed: %w", k, err)
+						}
+					}
+
+					return nil
+				},
+			),
+			// Read clears the exported values once the certificate has been renewed.
+			customdiff.If(
+				func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+					return diff.Id() != "" && diff.Get("export_passphrase").(string) != "" && diff.Get("exported_certificate").(string) == ""
+				},
+				func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+					for _, k := range []string{"exported_certificate", "exported_certificate_chain", "exported_private_key"} {
+						if err := diff.SetNewComputed(k); err != nil {
+							return fmt.Errorf("error setting %s to computed: %w", k, err)
+						}
+					}
+
+					return nil
+				},
+			),
 			verify.SetTagsDiff,
 		),
 	}
@@ -231,7 +284,7 @@ func resourceCertificateCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCertificateCreateImported(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ACMConn
+	conn := meta.(*conns.AWSClient).ACMConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -260,7 +313,7 @@ func resourceCertificateCreateImported(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCertificateCreateRequested(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ACMConn
+	conn := meta.(*conns.AWSClient).ACMConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -299,11 +352,22 @@ func resourceCertificateCreateRequested(d *schema.ResourceData, meta interface{}
 
 	d.SetId(aws.StringValue(resp.CertificateArn))
 
+	if v, ok := d.GetOk("export_passphrase"); ok {
+		// Private certificates can only be exported once the certificate authority has issued them.
+		if _, err := waitCertificateIssued(conn, d.Id(), CertificateIssuedTimeout); err != nil {
+			return fmt.Errorf("error waiting for ACM Certificate (%s) to be issued: %w", d.Id(), err)
+		}
+
+		if err := exportAcmCertificate(conn, d, v.(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceCertificateRead(d, meta)
 }
 
 func resourceCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ACMConn
+	conn := meta.(*conns.AWSClient).ACMConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -363,6 +427,13 @@ func resourceCertificateRead(d *schema.ResourceData, meta interface{}) error {
 
 		d.Set("status", resp.Certificate.Status)
 
+		if v, ok := d.GetOk("exported_certificate"); ok && !isAcmExportedCertificateCurrent(v.(string), resp.Certificate) {
+			log.Printf("[DEBUG] ACM Certificate (%s) renewed since it was exported, clearing exported values", d.Id())
+			d.Set("exported_certificate", nil)
+			d.Set("exported_certificate_chain", nil)
+			d.Set("exported_private_key", nil)
+		}
+
 		tags, err := ListTags(conn, d.Id())
 
 		if err != nil {
@@ -372,7 +443,7 @@ func resourceCertificateRead(d *schema.ResourceData, meta interface{}) error {
 		tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 		//lintignore:AWSR002
-		if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 			return resource.NonRetryableError(fmt.Errorf("error setting tags: %w", err))
 		}
 
@@ -397,7 +468,7 @@ func resourceCertificateValidationMethod(certificate *acm.CertificateDetail) str
 }
 
 func resourceCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ACMConn
+	conn := meta.(*conns.AWSClient).ACMConn()
 
 	if d.HasChanges("private_key", "certificate_body", "certificate_chain") {
 		// Prior to version 3.0.0 of the Terraform AWS Provider, these attributes were stored in state as hashes.
@@ -425,6 +496,18 @@ func resourceCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if v, ok := d.GetOk("export_passphrase"); ok {
+		if d.HasChange("export_passphrase") || d.Get("exported_certificate").(string) == "" {
+			if err := exportAcmCertificate(conn, d, v.(string)); err != nil {
+				return err
+			}
+		}
+	} else if d.HasChange("export_passphrase") {
+		d.Set("exported_certificate", nil)
+		d.Set("exported_certificate_chain", nil)
+		d.Set("exported_private_key", nil)
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
@@ -434,6 +517,54 @@ func resourceCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceCertificateRead(d, meta)
 }
 
+// exportAcmCertificate exports a private certificate and its passphrase-encrypted private key into state.
+// The exported values are only refreshed when the passphrase changes or the certificate is renewed rather than on every read.
+func exportAcmCertificate(conn *acm.ACM, d *schema.ResourceData, passphrase string) error {
+	log.Printf("[DEBUG] Exporting ACM Certificate: %s", d.Id())
+	output, err := conn.ExportCertificate(&acm.ExportCertificateInput{
+		CertificateArn: aws.String(d.Id()),
+		Passphrase:     []byte(passphrase),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error exporting ACM Certificate (%s): %w", d.Id(), err)
+	}
+
+	d.Set("exported_certificate", output.Certificate)
+	d.Set("exported_certificate_chain", output.CertificateChain)
+	d.Set("exported_private_key", output.PrivateKey)
+
+	return nil
+}
+
+// isAcmExportedCertificateCurrent returns whether the PEM-encoded exported certificate
+// has the serial number and expiry of the certificate's current version.
+func isAcmExportedCertificateCurrent(exported string, certificate *acm.CertificateDetail) bool {
+	block, _ := pem.Decode([]byte(exported))
+
+	if block == nil {
+		return true
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+
+	if err != nil {
+		return true
+	}
+
+	if v := certificate.NotAfter; v != nil && !cert.NotAfter.Equal(aws.TimeValue(v)) {
+		return false
+	}
+
+	if v := aws.StringValue(certificate.Serial); v != "" {
+		if serial, ok := new(big.Int).SetString(strings.ReplaceAll(v, ":", ""), 16); ok && serial.Cmp(cert.SerialNumber) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
 func cleanUpSubjectAlternativeNames(cert *acm.CertificateDetail) []string {
 	sans := cert.SubjectAlternativeNames
 	vs := make([]string, 0)
@@ -485,7 +616,7 @@ func convertValidationOptions(certificate *acm.CertificateDetail) ([]map[string]
 }
 
 func resourceCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ACMConn
+	conn := meta.(*conns.AWSClient).ACMConn()
 
 	log.Printf("[INFO] Deleting ACM Certificate: %s", d.Id())
 
@@ -65,7 +65,7 @@ func DataSourceCertificate() *schema.Resource {
 }
 
 func dataSourceCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ACMConn
+	conn := meta.(*conns.AWSClient).ACMConn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	params := &acm.ListCertificatesInput{}
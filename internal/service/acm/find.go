@@ -0,0 +1,50 @@
+package acm
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAccountConfiguration(conn *acm.ACM) (*acm.GetAccountConfigurationOutput, error) {
+	input := &acm.GetAccountConfigurationInput{}
+
+	output, err := conn.GetAccountConfiguration(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindCertificateByARN(conn *acm.ACM, arn string) (*acm.CertificateDetail, error) {
+	input := &acm.DescribeCertificateInput{
+		CertificateArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeCertificate(input)
+
+	if tfawserr.ErrCodeEquals(err, acm.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Certificate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Certificate, nil
+}
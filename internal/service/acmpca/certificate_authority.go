@@ -282,7 +282,7 @@ func ResourceCertificateAuthority() *schema.Resource {
 }
 
 func resourceCertificateAuthorityCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ACMPCAConn
+	conn := meta.(*conns.AWSClient).ACMPCAConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -330,7 +330,7 @@ func resourceCertificateAuthorityCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceCertificateAuthorityRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ACMPCAConn
+	conn := meta.(*conns.AWSClient).ACMPCAConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -435,7 +435,7 @@ func resourceCertificateAuthorityRead(d *schema.ResourceData, meta interface{})
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -447,7 +447,7 @@ func resourceCertificateAuthorityRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCertificateAuthorityUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ACMPCAConn
+	conn := meta.(*conns.AWSClient).ACMPCAConn()
 	updateCertificateAuthority := false
 
 	input := &acmpca.UpdateCertificateAuthorityInput{
@@ -487,7 +487,7 @@ func resourceCertificateAuthorityUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceCertificateAuthorityDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ACMPCAConn
+	conn := meta.(*conns.AWSClient).ACMPCAConn()
 
 	// The Certificate Authority must be in PENDING_CERTIFICATE or DISABLED state before deleting.
 	updateInput := &acmpca.UpdateCertificateAuthorityInput{
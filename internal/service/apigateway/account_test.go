@@ -95,7 +95,7 @@ func testAccCheckAccountExists(n string, res *apigateway.Account) resource.TestC
 			return fmt.Errorf("No API Gateway Account ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
 
 		req := &apigateway.GetAccountInput{}
 		describe, err := conn.GetAccount(req)
@@ -120,7 +120,7 @@ func testAccCheckAccountDestroy(s *terraform.State) error {
 
 // testAccPreCheckAccountCloudWatchRoleARN checks whether a CloudWatch role ARN has been configured in the current AWS region.
 func testAccPreCheckAccountCloudWatchRoleARN(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
 
 	output, err := conn.GetAccount(&apigateway.GetAccountInput{})
 
@@ -225,7 +225,7 @@ func testAccCheckAPIKeyExists(n string, res *apigateway.ApiKey) resource.TestChe
 			return fmt.Errorf("No API Gateway ApiKey ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
 
 		req := &apigateway.GetApiKeyInput{
 			ApiKey: aws.String(rs.Primary.ID),
@@ -246,7 +246,7 @@ func testAccCheckAPIKeyExists(n string, res *apigateway.ApiKey) resource.TestChe
 }
 
 func testAccCheckAPIKeyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_api_gateway_api_key" {
@@ -0,0 +1,224 @@
+package apigateway
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// ResourceDocumentationParts imports the documentation parts of an OpenAPI definition in bulk and
+// tracks the IDs of the imported parts, so that large APIs do not need one resource per part.
+func ResourceDocumentationParts() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDocumentationPartsCreate,
+		Read:   resourceDocumentationPartsRead,
+		Update: resourceDocumentationPartsUpdate,
+		Delete: resourceDocumentationPartsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"body": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"fail_on_warnings": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      apigateway.PutModeMerge,
+				ValidateFunc: validation.StringInSlice(apigateway.PutMode_Values(), false),
+			},
+			"rest_api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"warnings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceDocumentationPartsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
+
+	apiID := d.Get("rest_api_id").(string)
+
+	output, err := importDocumentationParts(conn, d)
+
+	if err != nil {
+		return fmt.Errorf("error importing API Gateway Documentation Parts (%s): %w", apiID, err)
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("ids", aws.StringValueSlice(output.Ids))
+	d.Set("warnings", aws.StringValueSlice(output.Warnings))
+
+	return resourceDocumentationPartsRead(d, meta)
+}
+
+func resourceDocumentationPartsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
+
+	apiID := d.Get("rest_api_id").(string)
+
+	existing, err := listDocumentationPartIDs(conn, apiID)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, apigateway.ErrCodeNotFoundException) {
+		log.Printf("[WARN] API Gateway REST API (%s) not found, removing Documentation Parts (%s) from state", apiID, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway Documentation Parts (%s): %w", d.Id(), err)
+	}
+
+	var ids []string
+
+	for _, v := range d.Get("ids").(*schema.Set).List() {
+		if id := v.(string); existing[id] {
+			ids = append(ids, id)
+		}
+	}
+
+	if !d.IsNewResource() && len(ids) == 0 {
+		log.Printf("[WARN] API Gateway Documentation Parts (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("ids", ids)
+
+	return nil
+}
+
+func resourceDocumentationPartsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
+
+	apiID := d.Get("rest_api_id").(string)
+
+	if d.HasChanges("body", "mode") {
+		o, _ := d.GetChange("ids")
+
+		output, err := importDocumentationParts(conn, d)
+
+		if err != nil {
+			return fmt.Errorf("error importing API Gateway Documentation Parts (%s): %w", d.Id(), err)
+		}
+
+		// Parts that are no longer in the definition are deleted.
+		imported := flex.FlattenStringSet(output.Ids)
+		for _, id := range o.(*schema.Set).Difference(imported).List() {
+			if err := deleteDocumentationPart(conn, apiID, id.(string)); err != nil {
+				return fmt.Errorf("error deleting API Gateway Documentation Part (%s/%s): %w", apiID, id, err)
+			}
+		}
+
+		d.Set("ids", aws.StringValueSlice(output.Ids))
+		d.Set("warnings", aws.StringValueSlice(output.Warnings))
+	}
+
+	return resourceDocumentationPartsRead(d, meta)
+}
+
+func resourceDocumentationPartsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
+
+	apiID := d.Get("rest_api_id").(string)
+
+	for _, v := range d.Get("ids").(*schema.Set).List() {
+		if err := deleteDocumentationPart(conn, apiID, v.(string)); err != nil {
+			return fmt.Errorf("error deleting API Gateway Documentation Part (%s/%s): %w", apiID, v, err)
+		}
+	}
+
+	return nil
+}
+
+func importDocumentationParts(conn *apigateway.APIGateway, d *schema.ResourceData) (*apigateway.ImportDocumentationPartsOutput, error) {
+	input := &apigateway.ImportDocumentationPartsInput{
+		Body:           []byte(d.Get("body").(string)),
+		FailOnWarnings: aws.Bool(d.Get("fail_on_warnings").(bool)),
+		Mode:           aws.String(d.Get("mode").(string)),
+		RestApiId:      aws.String(d.Get("rest_api_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Importing API Gateway Documentation Parts: %s", d.Get("rest_api_id").(string))
+	output, err := conn.ImportDocumentationParts(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range output.Warnings {
+		log.Printf("[WARN] API Gateway Documentation Parts (%s) import: %s", d.Get("rest_api_id").(string), aws.StringValue(v))
+	}
+
+	return output, nil
+}
+
+func listDocumentationPartIDs(conn *apigateway.APIGateway, apiID string) (map[string]bool, error) {
+	input := &apigateway.GetDocumentationPartsInput{
+		Limit:     aws.Int64(500),
+		RestApiId: aws.String(apiID),
+	}
+	ids := make(map[string]bool)
+
+	for {
+		output, err := conn.GetDocumentationParts(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range output.Items {
+			if v != nil {
+				ids[aws.StringValue(v.Id)] = true
+			}
+		}
+
+		if aws.StringValue(output.Position) == "" {
+			break
+		}
+
+		input.Position = output.Position
+	}
+
+	return ids, nil
+}
+
+func deleteDocumentationPart(conn *apigateway.APIGateway, apiID, id string) error {
+	_, err := conn.DeleteDocumentationPart(&apigateway.DeleteDocumentationPartInput{
+		DocumentationPartId: aws.String(id),
+		RestApiId:           aws.String(apiID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	return err
+}
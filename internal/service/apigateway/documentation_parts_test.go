@@ -0,0 +1,140 @@
+package apigateway_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccAPIGatewayDocumentationParts_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_documentation_parts.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigateway.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDocumentationPartsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentationPartsConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentationPartsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "fail_on_warnings", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "mode", apigateway.PutModeMerge),
+					resource.TestCheckResourceAttrPair(resourceName, "rest_api_id", "aws_api_gateway_rest_api.test", "id"),
+				),
+			},
+			{
+				Config: testAccDocumentationPartsConfig(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentationPartsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDocumentationPartsExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
+
+		output, err := conn.GetDocumentationParts(&apigateway.GetDocumentationPartsInput{
+			RestApiId: aws.String(rs.Primary.Attributes["rest_api_id"]),
+		})
+		if err != nil {
+			return err
+		}
+
+		if got, want := fmt.Sprint(len(output.Items)), rs.Primary.Attributes["ids.#"]; got != want {
+			return fmt.Errorf("API Gateway Documentation Parts (%s): got %s parts, want %s", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDocumentationPartsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_api_gateway_documentation_parts" {
+			continue
+		}
+
+		output, err := conn.GetDocumentationParts(&apigateway.GetDocumentationPartsInput{
+			RestApiId: aws.String(rs.Primary.Attributes["rest_api_id"]),
+		})
+		if err != nil {
+			if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeNotFoundException) {
+				continue
+			}
+			return err
+		}
+
+		if len(output.Items) > 0 {
+			return fmt.Errorf("API Gateway Documentation Parts (%s) still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccDocumentationPartsConfig(rName string, rootParts int) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name = %[1]q
+}
+
+locals {
+  root_part = [{
+    location = {
+      type = "RESOURCE"
+      path = "/"
+    }
+    properties = {
+      description = "Root resource"
+    }
+  }]
+}
+
+resource "aws_api_gateway_documentation_parts" "test" {
+  rest_api_id = aws_api_gateway_rest_api.test.id
+
+  body = jsonencode({
+    openapi = "3.0.1"
+    info = {
+      title   = %[1]q
+      version = "1.0"
+    }
+    paths = {}
+    x-amazon-apigateway-documentation = {
+      version = "1.0"
+      documentationParts = concat([{
+        location = {
+          type = "API"
+        }
+        properties = {
+          description = "Terraform Acceptance Test"
+        }
+      }], slice(local.root_part, 0, %[2]d))
+    }
+  })
+}
+`, rName, rootParts)
+}
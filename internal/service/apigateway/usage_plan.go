@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -52,6 +53,31 @@ func ResourceUsagePlan() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+
+						"throttle_settings": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"burst_limit": {
+										Type:     schema.TypeInt,
+										Default:  0,
+										Optional: true,
+									},
+
+									"rate_limit": {
+										Type:     schema.TypeFloat,
+										Default:  0,
+										Optional: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -122,7 +148,7 @@ func ResourceUsagePlan() *schema.Resource {
 }
 
 func resourceUsagePlanCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayConn
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	log.Print("[DEBUG] Creating API Gateway Usage Plan")
@@ -193,7 +219,7 @@ func resourceUsagePlanCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceUsagePlanRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayConn
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -214,7 +240,7 @@ func resourceUsagePlanRead(d *schema.ResourceData, meta interface{}) error {
 	tags := KeyValueTags(up.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -256,7 +282,7 @@ func resourceUsagePlanRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceUsagePlanUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayConn
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
 	log.Print("[DEBUG] Updating API Gateway Usage Plan")
 
 	operations := make([]*apigateway.PatchOperation, 0)
@@ -314,11 +340,31 @@ func resourceUsagePlanUpdate(d *schema.ResourceData, meta interface{}) error {
 		if len(ns) > 0 {
 			for _, v := range ns {
 				m := v.(map[string]interface{})
+				id := fmt.Sprintf("%s:%s", m["api_id"].(string), m["stage"].(string))
 				operations = append(operations, &apigateway.PatchOperation{
 					Op:    aws.String(apigateway.OpAdd),
 					Path:  aws.String("/apiStages"),
-					Value: aws.String(fmt.Sprintf("%s:%s", m["api_id"].(string), m["stage"].(string))),
+					Value: aws.String(id),
 				})
+
+				// Method level throttling can only be set once the stage is associated.
+				if t, ok := m["throttle_settings"].(*schema.Set); ok && t.Len() > 0 {
+					for _, tRaw := range t.List() {
+						th := tRaw.(map[string]interface{})
+						path := usagePlanApiStageThrottlePatchPath(id, th["path"].(string))
+
+						operations = append(operations, &apigateway.PatchOperation{
+							Op:    aws.String(apigateway.OpReplace),
+							Path:  aws.String(path + "/rateLimit"),
+							Value: aws.String(strconv.FormatFloat(th["rate_limit"].(float64), 'f', -1, 64)),
+						})
+						operations = append(operations, &apigateway.PatchOperation{
+							Op:    aws.String(apigateway.OpReplace),
+							Path:  aws.String(path + "/burstLimit"),
+							Value: aws.String(strconv.Itoa(th["burst_limit"].(int))),
+						})
+					}
+				}
 			}
 		}
 	}
@@ -448,7 +494,7 @@ func resourceUsagePlanUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceUsagePlanDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayConn
+	conn := meta.(*conns.AWSClient).APIGatewayConn()
 
 	// Removing existing api stages associated
 	if apistages, ok := d.GetOk("api_stages"); ok {
@@ -504,12 +550,37 @@ func expandApiGatewayUsageApiStages(s *schema.Set) []*apigateway.ApiStage {
 			stage.Stage = aws.String(v)
 		}
 
+		if v, ok := mStage["throttle_settings"].(*schema.Set); ok && v.Len() > 0 {
+			stage.Throttle = expandApiGatewayUsageApiStageThrottleSettings(v)
+		}
+
 		stages = append(stages, stage)
 	}
 
 	return stages
 }
 
+func expandApiGatewayUsageApiStageThrottleSettings(s *schema.Set) map[string]*apigateway.ThrottleSettings {
+	settings := make(map[string]*apigateway.ThrottleSettings)
+
+	for _, tRaw := range s.List() {
+		m := tRaw.(map[string]interface{})
+		ts := &apigateway.ThrottleSettings{}
+
+		if v, ok := m["burst_limit"].(int); ok {
+			ts.BurstLimit = aws.Int64(int64(v))
+		}
+
+		if v, ok := m["rate_limit"].(float64); ok {
+			ts.RateLimit = aws.Float64(v)
+		}
+
+		settings[m["path"].(string)] = ts
+	}
+
+	return settings
+}
+
 func expandApiGatewayUsageQuotaSettings(l []interface{}) *apigateway.QuotaSettings {
 	if len(l) == 0 {
 		return nil
@@ -562,6 +633,7 @@ func flattenApiGatewayUsageApiStages(s []*apigateway.ApiStage) []map[string]inte
 			stage := make(map[string]interface{})
 			stage["api_id"] = aws.StringValue(bd.ApiId)
 			stage["stage"] = aws.StringValue(bd.Stage)
+			stage["throttle_settings"] = flattenApiGatewayUsageApiStageThrottleSettings(bd.Throttle)
 
 			stages = append(stages, stage)
 		}
@@ -574,6 +646,38 @@ func flattenApiGatewayUsageApiStages(s []*apigateway.ApiStage) []map[string]inte
 	return nil
 }
 
+func flattenApiGatewayUsageApiStageThrottleSettings(settings map[string]*apigateway.ThrottleSettings) []interface{} {
+	tfList := make([]interface{}, 0, len(settings))
+
+	for path, ts := range settings {
+		if ts == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"path":        path,
+			"burst_limit": aws.Int64Value(ts.BurstLimit),
+			"rate_limit":  aws.Float64Value(ts.RateLimit),
+		})
+	}
+
+	return tfList
+}
+
+// usagePlanApiStageThrottlePatchPath returns the patch operation path for the
+// method level throttle settings of an API stage. The throttle path has the
+// form "{resourcePath}/{httpMethod}" and any '/' in the resource path must be
+// escaped as "~1".
+func usagePlanApiStageThrottlePatchPath(apiStageID, throttlePath string) string {
+	resourcePath, method := throttlePath, ""
+
+	if i := strings.LastIndex(throttlePath, "/"); i >= 0 {
+		resourcePath, method = throttlePath[:i], throttlePath[i+1:]
+	}
+
+	return fmt.Sprintf("/apiStages/%s/throttle/%s/%s", apiStageID, strings.ReplaceAll(resourcePath, "/", "~1"), method)
+}
+
 func flattenApiGatewayUsagePlanThrottling(s *apigateway.ThrottleSettings) []map[string]interface{} {
 	settings := make(map[string]interface{})
 
@@ -461,6 +461,52 @@ func TestAccAPIGatewayUsagePlan_APIStages_multiple(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayUsagePlan_APIStages_throttleSettings(t *testing.T) {
+	var conf apigateway.UsagePlan
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_usage_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigateway.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckUsagePlanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsagePlanAPIStagesThrottleConfig(rName, 2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsagePlanExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "api_stages.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "api_stages.0.throttle_settings.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "api_stages.0.throttle_settings.*", map[string]string{
+						"path":        "/test/GET",
+						"burst_limit": "2",
+						"rate_limit":  "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUsagePlanAPIStagesThrottleConfig(rName, 4, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsagePlanExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "api_stages.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "api_stages.0.throttle_settings.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "api_stages.0.throttle_settings.*", map[string]string{
+						"path":        "/test/GET",
+						"burst_limit": "4",
+						"rate_limit":  "3",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayUsagePlan_disappears(t *testing.T) {
 	var conf apigateway.UsagePlan
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -495,7 +541,7 @@ func testAccCheckUsagePlanExists(n string, res *apigateway.UsagePlan) resource.T
 			return fmt.Errorf("No API Gateway Usage Plan ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
 
 		req := &apigateway.GetUsagePlanInput{
 			UsagePlanId: aws.String(rs.Primary.ID),
@@ -516,7 +562,7 @@ func testAccCheckUsagePlanExists(n string, res *apigateway.UsagePlan) resource.T
 }
 
 func testAccCheckUsagePlanDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_api_gateway_usage_plan" {
@@ -760,3 +806,22 @@ resource "aws_api_gateway_usage_plan" "test" {
 }
 `, rName)
 }
+
+func testAccUsagePlanAPIStagesThrottleConfig(rName string, burstLimit int, rateLimit float64) string {
+	return testAccUsagePlanConfig(rName) + fmt.Sprintf(`
+resource "aws_api_gateway_usage_plan" "test" {
+  name = %[1]q
+
+  api_stages {
+    api_id = aws_api_gateway_rest_api.test.id
+    stage  = aws_api_gateway_deployment.test.stage_name
+
+    throttle_settings {
+      path        = "${aws_api_gateway_resource.test.path}/${aws_api_gateway_method.test.http_method}"
+      burst_limit = %[2]d
+      rate_limit  = %[3]g
+    }
+  }
+}
+`, rName, burstLimit, rateLimit)
+}
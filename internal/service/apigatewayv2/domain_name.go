@@ -1,14 +1,17 @@
 package apigatewayv2
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -19,12 +22,12 @@ import (
 
 func ResourceDomainName() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceDomainNameCreate,
-		Read:   resourceDomainNameRead,
-		Update: resourceDomainNameUpdate,
-		Delete: resourceDomainNameDelete,
+		CreateContext: resourceDomainNameCreate,
+		ReadContext:   resourceDomainNameRead,
+		UpdateContext: resourceDomainNameUpdate,
+		DeleteContext: resourceDomainNameDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -110,8 +113,8 @@ func ResourceDomainName() *schema.Resource {
 	}
 }
 
-func resourceDomainNameCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+func resourceDomainNameCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	domainName := d.Get("domain_name").(string)
@@ -127,20 +130,20 @@ func resourceDomainNameCreate(d *schema.ResourceData, meta interface{}) error {
 	output, err := conn.CreateDomainName(input)
 
 	if err != nil {
-		return fmt.Errorf("error creating API Gateway v2 domain name (%s): %w", domainName, err)
+		return diag.FromErr(fmt.Errorf("error creating API Gateway v2 domain name (%s): %w", domainName, err))
 	}
 
 	d.SetId(aws.StringValue(output.DomainName))
 
 	if _, err := WaitDomainNameAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return fmt.Errorf("error waiting for API Gateway v2 domain name (%s) to become available: %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error waiting for API Gateway v2 domain name (%s) to become available: %w", d.Id(), err))
 	}
 
-	return resourceDomainNameRead(d, meta)
+	return resourceDomainNameRead(ctx, d, meta)
 }
 
-func resourceDomainNameRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+func resourceDomainNameRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -153,7 +156,7 @@ func resourceDomainNameRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading API Gateway v2 domain name (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error reading API Gateway v2 domain name (%s): %w", d.Id(), err))
 	}
 
 	d.Set("api_mapping_selection_expression", output.ApiMappingSelectionExpression)
@@ -167,29 +170,40 @@ func resourceDomainNameRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("domain_name", output.DomainName)
 	err = d.Set("domain_name_configuration", flattenApiGatewayV2DomainNameConfiguration(output.DomainNameConfigurations[0]))
 	if err != nil {
-		return fmt.Errorf("error setting domain_name_configuration: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting domain_name_configuration: %w", err))
 	}
 	err = d.Set("mutual_tls_authentication", flattenApiGatewayV2MutualTlsAuthentication(output.MutualTlsAuthentication))
 	if err != nil {
-		return fmt.Errorf("error setting mutual_tls_authentication: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting mutual_tls_authentication: %w", err))
 	}
 
 	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+
+	if output.MutualTlsAuthentication != nil && len(output.MutualTlsAuthentication.TruststoreWarnings) > 0 {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("API Gateway v2 domain name (%s) mutual TLS truststore has warnings", d.Id()),
+				Detail: fmt.Sprintf("%s\n\nIf the truststore object in Amazon S3 has changed, set truststore_version to the new object version to update the domain name.",
+					strings.Join(aws.StringValueSlice(output.MutualTlsAuthentication.TruststoreWarnings), "\n")),
+			},
+		}
 	}
 
 	return nil
 }
 
-func resourceDomainNameUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+func resourceDomainNameUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn()
 
 	if d.HasChanges("domain_name_configuration", "mutual_tls_authentication") {
 		input := &apigatewayv2.UpdateDomainNameInput{
@@ -216,26 +230,26 @@ func resourceDomainNameUpdate(d *schema.ResourceData, meta interface{}) error {
 		_, err := conn.UpdateDomainName(input)
 
 		if err != nil {
-			return fmt.Errorf("error updating API Gateway v2 domain name (%s): %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating API Gateway v2 domain name (%s): %w", d.Id(), err))
 		}
 
 		if _, err := WaitDomainNameAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return fmt.Errorf("error waiting for API Gateway v2 domain name (%s) to become available: %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error waiting for API Gateway v2 domain name (%s) to become available: %w", d.Id(), err))
 		}
 	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
-			return fmt.Errorf("error updating API Gateway v2 domain name (%s) tags: %w", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error updating API Gateway v2 domain name (%s) tags: %w", d.Id(), err))
 		}
 	}
 
-	return resourceDomainNameRead(d, meta)
+	return resourceDomainNameRead(ctx, d, meta)
 }
 
-func resourceDomainNameDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
+func resourceDomainNameDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).APIGatewayV2Conn()
 
 	log.Printf("[DEBUG] Deleting API Gateway v2 domain name (%s)", d.Id())
 	_, err := conn.DeleteDomainName(&apigatewayv2.DeleteDomainNameInput{
@@ -247,7 +261,7 @@ func resourceDomainNameDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("error deleting API Gateway v2 domain name (%s): %w", d.Id(), err)
+		return diag.FromErr(fmt.Errorf("error deleting API Gateway v2 domain name (%s): %w", d.Id(), err))
 	}
 
 	return nil
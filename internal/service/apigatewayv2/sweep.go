@@ -41,7 +41,7 @@ func sweepAPIs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).APIGatewayV2Conn
+	conn := client.(*conns.AWSClient).APIGatewayV2Conn()
 	input := &apigatewayv2.GetApisInput{}
 	var sweeperErrs *multierror.Error
 
@@ -85,7 +85,7 @@ func sweepDomainNames(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).APIGatewayV2Conn
+	conn := client.(*conns.AWSClient).APIGatewayV2Conn()
 	input := &apigatewayv2.GetDomainNamesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -127,7 +127,7 @@ func sweepVPCLinks(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).APIGatewayV2Conn
+	conn := client.(*conns.AWSClient).APIGatewayV2Conn()
 	input := &apigatewayv2.GetVpcLinksInput{}
 	var sweeperErrs *multierror.Error
 
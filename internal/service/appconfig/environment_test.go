@@ -293,7 +293,7 @@ func TestAccAppConfigEnvironment_tags(t *testing.T) {
 }
 
 func testAccCheckAppConfigEnvironmentDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AppConfigConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppConfigConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appconfig_environment" {
@@ -346,7 +346,7 @@ func testAccCheckEnvironmentExists(resourceName string) resource.TestCheckFunc {
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AppConfigConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppConfigConn()
 
 		input := &appconfig.GetEnvironmentInput{
 			ApplicationId: aws.String(appID),
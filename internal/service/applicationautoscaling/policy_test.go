@@ -442,7 +442,7 @@ func testAccCheckPolicyExists(n string, policy *applicationautoscaling.ScalingPo
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationAutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationAutoScalingConn()
 		params := &applicationautoscaling.DescribeScalingPoliciesInput{
 			PolicyNames:       []*string{aws.String(rs.Primary.ID)},
 			ResourceId:        aws.String(rs.Primary.Attributes["resource_id"]),
@@ -464,7 +464,7 @@ func testAccCheckPolicyExists(n string, policy *applicationautoscaling.ScalingPo
 }
 
 func testAccCheckPolicyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationAutoScalingConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationAutoScalingConn()
 
 	for _, rs := range s.RootModule().Resources {
 		params := applicationautoscaling.DescribeScalingPoliciesInput{
@@ -487,7 +487,7 @@ func testAccCheckPolicyDestroy(s *terraform.State) error {
 
 func testAccCheckPolicyDisappears(policy *applicationautoscaling.ScalingPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationAutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationAutoScalingConn()
 
 		input := &applicationautoscaling.DeleteScalingPolicyInput{
 			PolicyName:        policy.PolicyName,
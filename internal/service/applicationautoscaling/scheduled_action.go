@@ -104,7 +104,7 @@ func ResourceScheduledAction() *schema.Resource {
 }
 
 func resourceScheduledActionPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ApplicationAutoScalingConn
+	conn := meta.(*conns.AWSClient).ApplicationAutoScalingConn()
 
 	input := &applicationautoscaling.PutScheduledActionInput{
 		ScheduledActionName: aws.String(d.Get("name").(string)),
@@ -198,7 +198,7 @@ func appautoscalingScheduledActionPopulateInputForUpdate(input *applicationautos
 }
 
 func resourceScheduledActionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ApplicationAutoScalingConn
+	conn := meta.(*conns.AWSClient).ApplicationAutoScalingConn()
 
 	scheduledAction, err := FindScheduledAction(conn, d.Get("name").(string), d.Get("service_namespace").(string), d.Get("resource_id").(string))
 	if tfresource.NotFound(err) {
@@ -228,7 +228,7 @@ func resourceScheduledActionRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceScheduledActionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ApplicationAutoScalingConn
+	conn := meta.(*conns.AWSClient).ApplicationAutoScalingConn()
 
 	input := &applicationautoscaling.DeleteScheduledActionInput{
 		ScheduledActionName: aws.String(d.Get("name").(string)),
@@ -0,0 +1,221 @@
+package applicationinsights_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapplicationinsights "github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccApplicationInsightsApplication_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationinsights_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, applicationinsights.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "applicationinsights", regexp.MustCompile(`application/resource-group/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "cwe_monitor_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ops_center_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ops_item_sns_topic_arn", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_group_name", "aws_resourcegroups_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cwe_monitor_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationInsightsApplication_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationinsights_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, applicationinsights.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfapplicationinsights.ResourceApplication(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationInsightsApplication_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationinsights_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, applicationinsights.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccApplicationTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_applicationinsights_application" {
+			continue
+		}
+
+		_, err := tfapplicationinsights.FindApplicationByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("ApplicationInsights Application %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckApplicationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ApplicationInsights Application ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsConn()
+
+		_, err := tfapplicationinsights.FindApplicationByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccApplicationBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_resourcegroups_group" "test" {
+  name = %[1]q
+
+  resource_query {
+    query = jsonencode({
+      ResourceTypeFilters = [
+        "AWS::EC2::Instance"
+      ]
+      TagFilters = [
+        {
+          Key = "Stage"
+          Values = [
+            "Test"
+          ]
+        },
+      ]
+    })
+  }
+}
+`, rName)
+}
+
+func testAccApplicationConfig(rName string, cweMonitorEnabled bool) string {
+	return acctest.ConfigCompose(testAccApplicationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_applicationinsights_application" "test" {
+  resource_group_name = aws_resourcegroups_group.test.name
+  cwe_monitor_enabled = %[1]t
+}
+`, cweMonitorEnabled))
+}
+
+func testAccApplicationTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccApplicationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_applicationinsights_application" "test" {
+  resource_group_name = aws_resourcegroups_group.test.name
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccApplicationTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccApplicationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_applicationinsights_application" "test" {
+  resource_group_name = aws_resourcegroups_group.test.name
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
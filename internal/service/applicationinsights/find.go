@@ -0,0 +1,71 @@
+package applicationinsights
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// FindApplicationByName returns the application corresponding to the specified resource group name.
+// Returns NotFoundError if no application is found.
+func FindApplicationByName(conn *applicationinsights.ApplicationInsights, name string) (*applicationinsights.ApplicationInfo, error) {
+	input := &applicationinsights.DescribeApplicationInput{
+		ResourceGroupName: aws.String(name),
+	}
+
+	output, err := conn.DescribeApplication(input)
+
+	if tfawserr.ErrCodeEquals(err, applicationinsights.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ApplicationInfo == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.ApplicationInfo, nil
+}
+
+// FindLogPatternByThreePartKey returns the log pattern corresponding to the specified resource group,
+// pattern set and pattern names.
+// Returns NotFoundError if no log pattern is found.
+func FindLogPatternByThreePartKey(conn *applicationinsights.ApplicationInsights, resourceGroupName, patternSetName, patternName string) (*applicationinsights.LogPattern, error) {
+	input := &applicationinsights.DescribeLogPatternInput{
+		PatternName:       aws.String(patternName),
+		PatternSetName:    aws.String(patternSetName),
+		ResourceGroupName: aws.String(resourceGroupName),
+	}
+
+	output, err := conn.DescribeLogPattern(input)
+
+	if tfawserr.ErrCodeEquals(err, applicationinsights.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.LogPattern == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.LogPattern, nil
+}
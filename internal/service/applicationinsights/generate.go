@@ -0,0 +1,4 @@
+//go:generate go run -tags generate ../../generate/tags/main.go -ListTags=yes -ListTagsInIDElem=ResourceARN -ServiceTagsSlice=yes -UpdateTags=yes -TagInIDElem=ResourceARN
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package applicationinsights
@@ -0,0 +1,197 @@
+package applicationinsights
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// ResourceLogPattern manages a log pattern in a log pattern set of an application.
+// Log pattern sets have no API of their own: a set exists while it contains at least one pattern.
+func ResourceLogPattern() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLogPatternCreate,
+		Read:   resourceLogPatternRead,
+		Update: resourceLogPatternUpdate,
+		Delete: resourceLogPatternDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"pattern_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 50),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9._-]+$`), "must contain only alphanumeric characters, periods, hyphens and underscores"),
+				),
+			},
+			"pattern_set_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 30),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9._-]+$`), "must contain only alphanumeric characters, periods, hyphens and underscores"),
+				),
+			},
+			"rank": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"resource_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+	}
+}
+
+func resourceLogPatternCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ApplicationInsightsConn()
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	patternSetName := d.Get("pattern_set_name").(string)
+	patternName := d.Get("pattern_name").(string)
+	id := LogPatternCreateResourceID(resourceGroupName, patternSetName, patternName)
+
+	input := &applicationinsights.CreateLogPatternInput{
+		Pattern:           aws.String(d.Get("pattern").(string)),
+		PatternName:       aws.String(patternName),
+		PatternSetName:    aws.String(patternSetName),
+		Rank:              aws.Int64(int64(d.Get("rank").(int))),
+		ResourceGroupName: aws.String(resourceGroupName),
+	}
+
+	log.Printf("[DEBUG] Creating ApplicationInsights Log Pattern: %s", input)
+	_, err := conn.CreateLogPattern(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating ApplicationInsights Log Pattern (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceLogPatternRead(d, meta)
+}
+
+func resourceLogPatternRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ApplicationInsightsConn()
+
+	resourceGroupName, patternSetName, patternName, err := LogPatternParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	logPattern, err := FindLogPatternByThreePartKey(conn, resourceGroupName, patternSetName, patternName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ApplicationInsights Log Pattern (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ApplicationInsights Log Pattern (%s): %w", d.Id(), err)
+	}
+
+	d.Set("pattern", logPattern.Pattern)
+	d.Set("pattern_name", logPattern.PatternName)
+	d.Set("pattern_set_name", logPattern.PatternSetName)
+	d.Set("rank", logPattern.Rank)
+	d.Set("resource_group_name", resourceGroupName)
+
+	return nil
+}
+
+func resourceLogPatternUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ApplicationInsightsConn()
+
+	resourceGroupName, patternSetName, patternName, err := LogPatternParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &applicationinsights.UpdateLogPatternInput{
+		Pattern:           aws.String(d.Get("pattern").(string)),
+		PatternName:       aws.String(patternName),
+		PatternSetName:    aws.String(patternSetName),
+		Rank:              aws.Int64(int64(d.Get("rank").(int))),
+		ResourceGroupName: aws.String(resourceGroupName),
+	}
+
+	log.Printf("[DEBUG] Updating ApplicationInsights Log Pattern: %s", input)
+	_, err = conn.UpdateLogPattern(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating ApplicationInsights Log Pattern (%s): %w", d.Id(), err)
+	}
+
+	return resourceLogPatternRead(d, meta)
+}
+
+func resourceLogPatternDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ApplicationInsightsConn()
+
+	resourceGroupName, patternSetName, patternName, err := LogPatternParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting ApplicationInsights Log Pattern: %s", d.Id())
+	_, err = conn.DeleteLogPattern(&applicationinsights.DeleteLogPatternInput{
+		PatternName:       aws.String(patternName),
+		PatternSetName:    aws.String(patternSetName),
+		ResourceGroupName: aws.String(resourceGroupName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, applicationinsights.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting ApplicationInsights Log Pattern (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const logPatternResourceIDSeparator = ":"
+
+func LogPatternCreateResourceID(resourceGroupName, patternSetName, patternName string) string {
+	parts := []string{resourceGroupName, patternSetName, patternName}
+	id := strings.Join(parts, logPatternResourceIDSeparator)
+
+	return id
+}
+
+func LogPatternParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, logPatternResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected RESOURCE_GROUP_NAME%[2]sPATTERN_SET_NAME%[2]sPATTERN_NAME", id, logPatternResourceIDSeparator)
+}
@@ -0,0 +1,146 @@
+package applicationinsights_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapplicationinsights "github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccApplicationInsightsLogPattern_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationinsights_log_pattern.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, applicationinsights.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLogPatternDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogPatternConfig(rName, "[ERROR]", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogPatternExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "[ERROR]"),
+					resource.TestCheckResourceAttr(resourceName, "pattern_name", "errors"),
+					resource.TestCheckResourceAttr(resourceName, "pattern_set_name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "rank", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_group_name", "aws_applicationinsights_application.test", "resource_group_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLogPatternConfig(rName, "[FATAL]", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogPatternExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "[FATAL]"),
+					resource.TestCheckResourceAttr(resourceName, "rank", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationInsightsLogPattern_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationinsights_log_pattern.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, applicationinsights.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLogPatternDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogPatternConfig(rName, "[ERROR]", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogPatternExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfapplicationinsights.ResourceLogPattern(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLogPatternDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_applicationinsights_log_pattern" {
+			continue
+		}
+
+		resourceGroupName, patternSetName, patternName, err := tfapplicationinsights.LogPatternParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfapplicationinsights.FindLogPatternByThreePartKey(conn, resourceGroupName, patternSetName, patternName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("ApplicationInsights Log Pattern %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckLogPatternExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ApplicationInsights Log Pattern ID is set")
+		}
+
+		resourceGroupName, patternSetName, patternName, err := tfapplicationinsights.LogPatternParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsConn()
+
+		_, err = tfapplicationinsights.FindLogPatternByThreePartKey(conn, resourceGroupName, patternSetName, patternName)
+
+		return err
+	}
+}
+
+func testAccLogPatternConfig(rName, pattern string, rank int) string {
+	return acctest.ConfigCompose(testAccApplicationBaseConfig(rName), fmt.Sprintf(`
+resource "aws_applicationinsights_application" "test" {
+  resource_group_name = aws_resourcegroups_group.test.name
+}
+
+resource "aws_applicationinsights_log_pattern" "test" {
+  resource_group_name = aws_applicationinsights_application.test.resource_group_name
+  pattern_set_name    = "test"
+  pattern_name        = "errors"
+  pattern             = %[1]q
+  rank                = %[2]d
+}
+`, pattern, rank))
+}
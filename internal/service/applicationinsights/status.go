@@ -0,0 +1,25 @@
+package applicationinsights
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// statusApplication fetches the Application and its LifeCycle
+func statusApplication(conn *applicationinsights.ApplicationInsights, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		application, err := FindApplicationByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return application, aws.StringValue(application.LifeCycle), nil
+	}
+}
@@ -0,0 +1,75 @@
+//go:build sweep
+// +build sweep
+
+package applicationinsights
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_applicationinsights_application", &resource.Sweeper{
+		Name: "aws_applicationinsights_application",
+		F:    sweepApplications,
+	})
+}
+
+func sweepApplications(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).ApplicationInsightsConn()
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &applicationinsights.ListApplicationsInput{}
+
+	err = conn.ListApplicationsPages(input, func(page *applicationinsights.ListApplicationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.ApplicationInfoList {
+			if item == nil {
+				continue
+			}
+
+			id := aws.StringValue(item.ResourceGroupName)
+
+			log.Printf("[INFO] Deleting ApplicationInsights Application (%s)", id)
+			r := ResourceApplication()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing ApplicationInsights Applications: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping ApplicationInsights Applications for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping ApplicationInsights Applications sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
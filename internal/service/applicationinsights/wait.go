@@ -0,0 +1,55 @@
+package applicationinsights
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/applicationinsights"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// The application life cycle values are not modeled as an enum in the SDK.
+	applicationLifeCycleActive        = "ACTIVE"
+	applicationLifeCycleCreating      = "CREATING"
+	applicationLifeCycleDeleting      = "DELETING"
+	applicationLifeCycleNotConfigured = "NOT_CONFIGURED"
+
+	ApplicationCreatedTimeout = 2 * time.Minute
+	ApplicationDeletedTimeout = 2 * time.Minute
+)
+
+// waitApplicationCreated waits for an Application to finish creating
+func waitApplicationCreated(conn *applicationinsights.ApplicationInsights, name string) (*applicationinsights.ApplicationInfo, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{applicationLifeCycleCreating},
+		Target:  []string{applicationLifeCycleActive, applicationLifeCycleNotConfigured},
+		Refresh: statusApplication(conn, name),
+		Timeout: ApplicationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*applicationinsights.ApplicationInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitApplicationDeleted waits for an Application to be deleted
+func waitApplicationDeleted(conn *applicationinsights.ApplicationInsights, name string) (*applicationinsights.ApplicationInfo, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{applicationLifeCycleActive, applicationLifeCycleDeleting, applicationLifeCycleNotConfigured},
+		Target:  []string{},
+		Refresh: statusApplication(conn, name),
+		Timeout: ApplicationDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*applicationinsights.ApplicationInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -48,7 +48,7 @@ func sweepFleet(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).AppStreamConn
+	conn := client.(*conns.AWSClient).AppStreamConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -98,7 +98,7 @@ func sweepImageBuilder(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).AppStreamConn
+	conn := client.(*conns.AWSClient).AppStreamConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -149,7 +149,7 @@ func sweepStack(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).AppStreamConn
+	conn := client.(*conns.AWSClient).AppStreamConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -141,7 +141,7 @@ func ResourceDataSource() *schema.Resource {
 }
 
 func resourceDataSourceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 	region := meta.(*conns.AWSClient).Region
 
 	input := &appsync.CreateDataSourceInput{
@@ -185,7 +185,7 @@ func resourceDataSourceCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDataSourceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	apiID, name, err := DecodeID(d.Id())
 
@@ -236,7 +236,7 @@ func resourceDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDataSourceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 	region := meta.(*conns.AWSClient).Region
 
 	apiID, name, err := DecodeID(d.Id())
@@ -283,7 +283,7 @@ func resourceDataSourceUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDataSourceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	apiID, name, err := DecodeID(d.Id())
 
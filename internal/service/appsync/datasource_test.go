@@ -401,7 +401,7 @@ func TestAccAppSyncDataSource_Type_none(t *testing.T) {
 }
 
 func testAccCheckDestroyDataSource(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appsync_datasource" {
 			continue
@@ -445,7 +445,7 @@ func testAccCheckExistsDataSource(name string) resource.TestCheckFunc {
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn()
 
 		input := &appsync.GetDataSourceInput{
 			ApiId: aws.String(apiID),
@@ -7,11 +7,13 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/appsync"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/importer"
 )
 
 func ResourceFunction() *schema.Resource {
@@ -22,7 +24,7 @@ func ResourceFunction() *schema.Resource {
 		Delete: resourceFunctionDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceFunctionImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -79,7 +81,7 @@ func ResourceFunction() *schema.Resource {
 }
 
 func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	apiID := d.Get("api_id").(string)
 
@@ -110,7 +112,7 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	apiID, functionID, err := DecodeFunctionID(d.Id())
 	if err != nil {
@@ -146,7 +148,7 @@ func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	apiID, functionID, err := DecodeFunctionID(d.Id())
 	if err != nil {
@@ -184,7 +186,7 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceFunctionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	apiID, functionID, err := DecodeFunctionID(d.Id())
 	if err != nil {
@@ -214,3 +216,33 @@ func DecodeFunctionID(id string) (string, string, error) {
 	}
 	return idParts[0], idParts[1], nil
 }
+
+func resourceFunctionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	fromARN := func(resARN arn.ARN) (string, error) {
+		resourceParts := strings.Split(resARN.Resource, "/")
+
+		if len(resourceParts) != 4 || resourceParts[0] != "apis" || resourceParts[1] == "" || resourceParts[2] != "functions" || resourceParts[3] == "" {
+			return "", fmt.Errorf("expected resource apis/API_ID/functions/FUNCTION_ID in ARN, got: %s", resARN.Resource)
+		}
+
+		return fmt.Sprintf("%s-%s", resourceParts[1], resourceParts[3]), nil
+	}
+
+	fromID := func(id string) (string, error) {
+		if _, _, err := DecodeFunctionID(id); err != nil {
+			return "", err
+		}
+
+		return id, nil
+	}
+
+	id, err := importer.ARNOrID(d.Id(), appsync.ServiceName, fromARN, fromID, "arn:PARTITION:appsync:REGION:ACCOUNTID:apis/API_ID/functions/FUNCTION_ID", "API_ID-FUNCTION_ID")
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
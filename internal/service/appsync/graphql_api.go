@@ -212,7 +212,7 @@ func ResourceGraphQLAPI() *schema.Resource {
 }
 
 func resourceGraphQLAPICreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -260,7 +260,7 @@ func resourceGraphQLAPICreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGraphQLAPIRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -307,7 +307,7 @@ func resourceGraphQLAPIRead(d *schema.ResourceData, meta interface{}) error {
 	tags := KeyValueTags(resp.GraphqlApi.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -323,7 +323,7 @@ func resourceGraphQLAPIRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGraphQLAPIUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -374,7 +374,7 @@ func resourceGraphQLAPIUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGraphQLAPIDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	input := &appsync.DeleteGraphqlApiInput{
 		ApiId: aws.String(d.Id()),
@@ -593,7 +593,7 @@ func flattenAppsyncGraphqlApiCognitoUserPoolConfig(userPoolConfig *appsync.Cogni
 }
 
 func resourceSchemaPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AppSyncConn
+	conn := meta.(*conns.AWSClient).AppSyncConn()
 
 	if v, ok := d.GetOk("schema"); ok {
 		input := &appsync.StartSchemaCreationInput{
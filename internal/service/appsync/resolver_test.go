@@ -287,7 +287,7 @@ func TestAccAppSyncResolver_caching(t *testing.T) {
 }
 
 func testAccCheckResolverDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appsync_resolver" {
 			continue
@@ -334,7 +334,7 @@ func testAccCheckResolverExists(name string, resolver *appsync.Resolver) resourc
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncConn()
 
 		input := &appsync.GetResolverInput{
 			ApiId:     aws.String(apiID),
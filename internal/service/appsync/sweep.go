@@ -26,7 +26,7 @@ func sweepGraphQLAPIs(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).AppSyncConn
+	conn := client.(*conns.AWSClient).AppSyncConn()
 
 	input := &appsync.ListGraphqlApisInput{}
 
@@ -137,8 +137,8 @@ func TestAccAthenaDatabase_forceDestroyAlwaysSucceeds(t *testing.T) {
 // StartQueryExecution requires OutputLocation but terraform destroy deleted S3 bucket as well.
 // So temporary S3 bucket as OutputLocation is created to confirm whether the database is actually deleted.
 func testAccCheckDatabaseDestroy(s *terraform.State) error {
-	athenaconn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn
-	s3conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+	athenaconn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
+	s3conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_athena_database" {
 			continue
@@ -239,7 +239,7 @@ func testAccDatabaseCreateTables(dbName string) resource.TestCheckFunc {
 			return err
 		}
 
-		athenaconn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn
+		athenaconn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
 
 		input := &athena.StartQueryExecutionInput{
 			QueryExecutionContext: &athena.QueryExecutionContext{
@@ -269,7 +269,7 @@ func testAccDatabaseDestroyTables(dbName string) resource.TestCheckFunc {
 			return err
 		}
 
-		athenaconn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn
+		athenaconn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
 
 		input := &athena.StartQueryExecutionInput{
 			QueryExecutionContext: &athena.QueryExecutionContext{
@@ -298,7 +298,7 @@ func testAccCheckDatabaseDropFails(dbName string) resource.TestCheckFunc {
 			return err
 		}
 
-		athenaconn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn
+		athenaconn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
 
 		input := &athena.StartQueryExecutionInput{
 			QueryExecutionContext: &athena.QueryExecutionContext{
@@ -0,0 +1,35 @@
+package athena
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindPreparedStatementByTwoPartKey(conn *athena.Athena, workGroupName, statementName string) (*athena.PreparedStatement, error) {
+	input := &athena.GetPreparedStatementInput{
+		StatementName: aws.String(statementName),
+		WorkGroup:     aws.String(workGroupName),
+	}
+
+	output, err := conn.GetPreparedStatement(input)
+
+	if tfawserr.ErrCodeEquals(err, athena.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PreparedStatement == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PreparedStatement, nil
+}
@@ -0,0 +1,25 @@
+package athena
+
+import (
+	"fmt"
+	"strings"
+)
+
+const preparedStatementIDSeparator = "/"
+
+func PreparedStatementCreateID(workGroupName, statementName string) string {
+	parts := []string{workGroupName, statementName}
+	id := strings.Join(parts, preparedStatementIDSeparator)
+
+	return id
+}
+
+func PreparedStatementParseID(id string) (string, string, error) {
+	parts := strings.Split(id, preparedStatementIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected WORKGROUP%[2]sSTATEMENTNAME", id, preparedStatementIDSeparator)
+}
@@ -0,0 +1,167 @@
+package athena
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/athena"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourcePreparedStatement() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePreparedStatementCreate,
+		Read:   resourcePreparedStatementRead,
+		Update: resourcePreparedStatementUpdate,
+		Delete: resourcePreparedStatementDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_@:]*$`), "must start with a letter or underscore and contain only alphanumeric characters, underscores, at signs and colons"),
+				),
+			},
+			"query_statement": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 262144),
+			},
+			"workgroup": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourcePreparedStatementCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AthenaConn()
+
+	workGroupName := d.Get("workgroup").(string)
+	statementName := d.Get("name").(string)
+	id := PreparedStatementCreateID(workGroupName, statementName)
+	input := &athena.CreatePreparedStatementInput{
+		QueryStatement: aws.String(d.Get("query_statement").(string)),
+		StatementName:  aws.String(statementName),
+		WorkGroup:      aws.String(workGroupName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Athena Prepared Statement: %s", input)
+	_, err := conn.CreatePreparedStatement(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Athena Prepared Statement (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourcePreparedStatementRead(d, meta)
+}
+
+func resourcePreparedStatementRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AthenaConn()
+
+	workGroupName, statementName, err := PreparedStatementParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	preparedStatement, err := FindPreparedStatementByTwoPartKey(conn, workGroupName, statementName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Athena Prepared Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Athena Prepared Statement (%s): %w", d.Id(), err)
+	}
+
+	d.Set("description", preparedStatement.Description)
+	d.Set("name", preparedStatement.StatementName)
+	d.Set("query_statement", preparedStatement.QueryStatement)
+	d.Set("workgroup", preparedStatement.WorkGroupName)
+
+	return nil
+}
+
+func resourcePreparedStatementUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AthenaConn()
+
+	workGroupName, statementName, err := PreparedStatementParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &athena.UpdatePreparedStatementInput{
+		QueryStatement: aws.String(d.Get("query_statement").(string)),
+		StatementName:  aws.String(statementName),
+		WorkGroup:      aws.String(workGroupName),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Updating Athena Prepared Statement: %s", input)
+	_, err = conn.UpdatePreparedStatement(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Athena Prepared Statement (%s): %w", d.Id(), err)
+	}
+
+	return resourcePreparedStatementRead(d, meta)
+}
+
+func resourcePreparedStatementDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AthenaConn()
+
+	workGroupName, statementName, err := PreparedStatementParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Athena Prepared Statement: %s", d.Id())
+	_, err = conn.DeletePreparedStatement(&athena.DeletePreparedStatementInput{
+		StatementName: aws.String(statementName),
+		WorkGroup:     aws.String(workGroupName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, athena.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Athena Prepared Statement (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package athena_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/athena"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfathena "github.com/hashicorp/terraform-provider-aws/internal/service/athena"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAthenaPreparedStatement_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	statementName := fmt.Sprintf("tf_acc_test_%s", sdkacctest.RandString(8))
+	resourceName := "aws_athena_prepared_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, athena.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPreparedStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAthenaPreparedStatementConfig(rName, statementName, "SELECT 1 WHERE 1 = ?"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPreparedStatementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", statementName),
+					resource.TestCheckResourceAttr(resourceName, "query_statement", "SELECT 1 WHERE 1 = ?"),
+					resource.TestCheckResourceAttrPair(resourceName, "workgroup", "aws_athena_workgroup.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAthenaPreparedStatementConfig(rName, statementName, "SELECT 2 WHERE 2 = ?"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPreparedStatementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "query_statement", "SELECT 2 WHERE 2 = ?"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaPreparedStatement_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	statementName := fmt.Sprintf("tf_acc_test_%s", sdkacctest.RandString(8))
+	resourceName := "aws_athena_prepared_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, athena.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPreparedStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAthenaPreparedStatementConfig(rName, statementName, "SELECT 1 WHERE 1 = ?"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPreparedStatementExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfathena.ResourcePreparedStatement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAthenaPreparedStatement_description(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	statementName := fmt.Sprintf("tf_acc_test_%s", sdkacctest.RandString(8))
+	resourceName := "aws_athena_prepared_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, athena.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPreparedStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAthenaPreparedStatementDescriptionConfig(rName, statementName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPreparedStatementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAthenaPreparedStatementDescriptionConfig(rName, statementName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPreparedStatementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPreparedStatementDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_athena_prepared_statement" {
+			continue
+		}
+
+		workGroupName, statementName, err := tfathena.PreparedStatementParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfathena.FindPreparedStatementByTwoPartKey(conn, workGroupName, statementName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Athena Prepared Statement %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckPreparedStatementExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Athena Prepared Statement ID is set")
+		}
+
+		workGroupName, statementName, err := tfathena.PreparedStatementParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AthenaConn()
+
+		_, err = tfathena.FindPreparedStatementByTwoPartKey(conn, workGroupName, statementName)
+
+		return err
+	}
+}
+
+func testAccAthenaPreparedStatementConfig(rName, statementName, queryStatement string) string {
+	return fmt.Sprintf(`
+resource "aws_athena_workgroup" "test" {
+  name = %[1]q
+}
+
+resource "aws_athena_prepared_statement" "test" {
+  name            = %[2]q
+  query_statement = %[3]q
+  workgroup       = aws_athena_workgroup.test.name
+}
+`, rName, statementName, queryStatement)
+}
+
+func testAccAthenaPreparedStatementDescriptionConfig(rName, statementName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_athena_workgroup" "test" {
+  name = %[1]q
+}
+
+resource "aws_athena_prepared_statement" "test" {
+  description     = %[3]q
+  name            = %[2]q
+  query_statement = "SELECT 1 WHERE 1 = ?"
+  workgroup       = aws_athena_workgroup.test.name
+}
+`, rName, statementName, description)
+}
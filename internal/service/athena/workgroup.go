@@ -51,6 +51,26 @@ func ResourceWorkGroup() *schema.Resource {
 							Optional: true,
 							Default:  true,
 						},
+						"engine_version": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"effective_engine_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"selected_engine_version": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "AUTO",
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
 						"publish_cloudwatch_metrics_enabled": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -130,7 +150,7 @@ func ResourceWorkGroup() *schema.Resource {
 }
 
 func resourceWorkGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AthenaConn
+	conn := meta.(*conns.AWSClient).AthenaConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -174,7 +194,7 @@ func resourceWorkGroupCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceWorkGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AthenaConn
+	conn := meta.(*conns.AWSClient).AthenaConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -227,7 +247,7 @@ func resourceWorkGroupRead(d *schema.ResourceData, meta interface{}) error {
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -239,7 +259,7 @@ func resourceWorkGroupRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceWorkGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AthenaConn
+	conn := meta.(*conns.AWSClient).AthenaConn()
 
 	input := &athena.DeleteWorkGroupInput{
 		WorkGroup: aws.String(d.Id()),
@@ -258,7 +278,7 @@ func resourceWorkGroupDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceWorkGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AthenaConn
+	conn := meta.(*conns.AWSClient).AthenaConn()
 
 	workGroupUpdate := false
 
@@ -316,6 +336,10 @@ func expandAthenaWorkGroupConfiguration(l []interface{}) *athena.WorkGroupConfig
 		configuration.EnforceWorkGroupConfiguration = aws.Bool(v.(bool))
 	}
 
+	if v, ok := m["engine_version"]; ok {
+		configuration.EngineVersion = expandAthenaWorkGroupEngineVersion(v.([]interface{}))
+	}
+
 	if v, ok := m["publish_cloudwatch_metrics_enabled"]; ok {
 		configuration.PublishCloudWatchMetricsEnabled = aws.Bool(v.(bool))
 	}
@@ -350,6 +374,10 @@ func expandAthenaWorkGroupConfigurationUpdates(l []interface{}) *athena.WorkGrou
 		configurationUpdates.EnforceWorkGroupConfiguration = aws.Bool(v.(bool))
 	}
 
+	if v, ok := m["engine_version"]; ok {
+		configurationUpdates.EngineVersion = expandAthenaWorkGroupEngineVersion(v.([]interface{}))
+	}
+
 	if v, ok := m["publish_cloudwatch_metrics_enabled"]; ok {
 		configurationUpdates.PublishCloudWatchMetricsEnabled = aws.Bool(v.(bool))
 	}
@@ -365,6 +393,22 @@ func expandAthenaWorkGroupConfigurationUpdates(l []interface{}) *athena.WorkGrou
 	return configurationUpdates
 }
 
+func expandAthenaWorkGroupEngineVersion(l []interface{}) *athena.EngineVersion {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	engineVersion := &athena.EngineVersion{}
+
+	if v, ok := m["selected_engine_version"]; ok && v.(string) != "" {
+		engineVersion.SelectedEngineVersion = aws.String(v.(string))
+	}
+
+	return engineVersion
+}
+
 func expandAthenaWorkGroupResultConfiguration(l []interface{}) *athena.ResultConfiguration {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -437,6 +481,7 @@ func flattenAthenaWorkGroupConfiguration(configuration *athena.WorkGroupConfigur
 	m := map[string]interface{}{
 		"bytes_scanned_cutoff_per_query":     aws.Int64Value(configuration.BytesScannedCutoffPerQuery),
 		"enforce_workgroup_configuration":    aws.BoolValue(configuration.EnforceWorkGroupConfiguration),
+		"engine_version":                     flattenAthenaWorkGroupEngineVersion(configuration.EngineVersion),
 		"publish_cloudwatch_metrics_enabled": aws.BoolValue(configuration.PublishCloudWatchMetricsEnabled),
 		"result_configuration":               flattenAthenaWorkGroupResultConfiguration(configuration.ResultConfiguration),
 		"requester_pays_enabled":             aws.BoolValue(configuration.RequesterPaysEnabled),
@@ -445,6 +490,19 @@ func flattenAthenaWorkGroupConfiguration(configuration *athena.WorkGroupConfigur
 	return []interface{}{m}
 }
 
+func flattenAthenaWorkGroupEngineVersion(engineVersion *athena.EngineVersion) []interface{} {
+	if engineVersion == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"effective_engine_version": aws.StringValue(engineVersion.EffectiveEngineVersion),
+		"selected_engine_version":  aws.StringValue(engineVersion.SelectedEngineVersion),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenAthenaWorkGroupResultConfiguration(resultConfiguration *athena.ResultConfiguration) []interface{} {
 	if resultConfiguration == nil {
 		return []interface{}{}
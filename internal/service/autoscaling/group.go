@@ -528,6 +528,19 @@ func ResourceGroup() *schema.Resource {
 							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"checkpoint_delay": {
+										Type:         nullable.TypeNullableInt,
+										Optional:     true,
+										ValidateFunc: nullable.ValidateTypeStringNullableIntAtLeast(0),
+									},
+									"checkpoint_percentages": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeInt,
+											ValidateFunc: validation.IntBetween(1, 100),
+										},
+									},
 									"instance_warmup": {
 										Type:         nullable.TypeNullableInt,
 										Optional:     true,
@@ -539,6 +552,11 @@ func ResourceGroup() *schema.Resource {
 										Default:      90,
 										ValidateFunc: validation.IntBetween(0, 100),
 									},
+									"skip_matching": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
 								},
 							},
 						},
@@ -568,14 +586,16 @@ func ResourceGroup() *schema.Resource {
 							ValidateFunc: validation.StringInSlice(autoscaling.WarmPoolState_Values(), false),
 						},
 						"min_size": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  0,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
 						},
 						"max_group_prepared_capacity": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  -1,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      -1,
+							ValidateFunc: validation.IntAtLeast(-1),
 						},
 					},
 				},
@@ -641,7 +661,7 @@ func generatePutLifecycleHookInputs(asgName string, cfgs []interface{}) []autosc
 }
 
 func resourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
 	asgName := create.Name(d.Get("name").(string), d.Get("name_prefix").(string))
 
@@ -818,7 +838,7 @@ func resourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
 
 // TODO: wrap all top-level error returns
 func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	g, err := getGroup(d.Id(), conn)
@@ -1010,7 +1030,7 @@ func waitUntilAutoscalingGroupLoadBalancerTargetGroupsAdded(conn *autoscaling.Au
 }
 
 func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 	shouldWaitForCapacity := false
 	shouldRefreshInstances := false
 
@@ -1345,7 +1365,7 @@ func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
 	// Read the Auto Scaling Group first. If it doesn't exist, we're done.
 	// We need the group in order to check if there are instances attached.
@@ -1429,7 +1449,7 @@ func resourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAutoScalingGroupWarmPoolDelete(g *autoscaling.Group, d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
 	if g.WarmPoolConfiguration == nil {
 		// No warm pool configured. Skipping deletion.
@@ -1538,7 +1558,7 @@ func getGroupWarmPool(asgName string, conn *autoscaling.AutoScaling) (*autoscali
 }
 
 func resourceGroupWarmPoolDrain(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
 	if d.Get("force_delete").(bool) || d.Get("force_delete_warm_pool").(bool) {
 		log.Printf("[DEBUG] Skipping Warm pool drain, force delete was set.")
@@ -1621,7 +1641,7 @@ func getGroup(asgName string, conn *autoscaling.AutoScaling) (*autoscaling.Group
 }
 
 func resourceGroupDrain(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).AutoScalingConn
+	conn := meta.(*conns.AWSClient).AutoScalingConn()
 
 	if d.Get("force_delete").(bool) {
 		log.Printf("[DEBUG] Skipping Auto Scaling Group drain, force_delete was set.")
@@ -1792,7 +1812,7 @@ func updateASGMetricsCollection(d *schema.ResourceData, conn *autoscaling.AutoSc
 // Nested like: lbName -> instanceId -> instanceState
 func getELBInstanceStates(g *autoscaling.Group, meta interface{}) (map[string]map[string]string, error) {
 	lbInstanceStates := make(map[string]map[string]string)
-	conn := meta.(*conns.AWSClient).ELBConn
+	conn := meta.(*conns.AWSClient).ELBConn()
 
 	for _, lbName := range g.LoadBalancerNames {
 		lbInstanceStates[aws.StringValue(lbName)] = make(map[string]string)
@@ -1821,7 +1841,7 @@ func getELBInstanceStates(g *autoscaling.Group, meta interface{}) (map[string]ma
 // Nested like: targetGroupARN -> instanceId -> instanceState
 func getTargetGroupInstanceStates(g *autoscaling.Group, meta interface{}) (map[string]map[string]string, error) {
 	targetInstanceStates := make(map[string]map[string]string)
-	conn := meta.(*conns.AWSClient).ELBV2Conn
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
 
 	for _, targetGroupARN := range g.TargetGroupARNs {
 		targetInstanceStates[aws.StringValue(targetGroupARN)] = make(map[string]string)
@@ -2199,6 +2219,16 @@ func expandAutoScalingGroupInstanceRefreshPreferences(l []interface{}) *autoscal
 
 	refreshPreferences := &autoscaling.RefreshPreferences{}
 
+	if v, ok := m["checkpoint_delay"]; ok {
+		if v, null, _ := nullable.Int(v.(string)).Value(); !null {
+			refreshPreferences.CheckpointDelay = aws.Int64(v)
+		}
+	}
+
+	if v, ok := m["checkpoint_percentages"].([]interface{}); ok && len(v) > 0 {
+		refreshPreferences.CheckpointPercentages = flex.ExpandInt64List(v)
+	}
+
 	if v, ok := m["instance_warmup"]; ok {
 		if v, null, _ := nullable.Int(v.(string)).Value(); !null {
 			refreshPreferences.InstanceWarmup = aws.Int64(v)
@@ -2209,6 +2239,10 @@ func expandAutoScalingGroupInstanceRefreshPreferences(l []interface{}) *autoscal
 		refreshPreferences.MinHealthyPercentage = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := m["skip_matching"].(bool); ok && v {
+		refreshPreferences.SkipMatching = aws.Bool(v)
+	}
+
 	return refreshPreferences
 }
 
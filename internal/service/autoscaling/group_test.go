@@ -980,8 +980,14 @@ func TestAccAutoScalingGroup_InstanceRefresh_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "instance_refresh.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.strategy", "Rolling"),
 					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.checkpoint_delay", "25"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.checkpoint_percentages.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.checkpoint_percentages.0", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.checkpoint_percentages.1", "20"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.checkpoint_percentages.2", "100"),
 					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.instance_warmup", "10"),
 					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.min_healthy_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.skip_matching", "true"),
 				),
 			},
 			{
@@ -1129,7 +1135,7 @@ func testAccCheckGroupExists(n string, group *autoscaling.Group) resource.TestCh
 			return fmt.Errorf("No Auto Scaling Group ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 
 		describeGroups, err := conn.DescribeAutoScalingGroups(
 			&autoscaling.DescribeAutoScalingGroupsInput{
@@ -1152,7 +1158,7 @@ func testAccCheckGroupExists(n string, group *autoscaling.Group) resource.TestCh
 }
 
 func testAccCheckGroupDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_autoscaling_group" {
@@ -1361,7 +1367,7 @@ func autoscalingTagDescriptionsToMap(ts *[]*autoscaling.TagDescription) map[stri
 // sure that all instances in it are healthy.
 func testAccCheckALBTargetGroupHealthy(res *elbv2.TargetGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Conn()
 
 		resp, err := conn.DescribeTargetHealth(&elbv2.DescribeTargetHealthInput{
 			TargetGroupArn: res.TargetGroupArn,
@@ -4479,8 +4485,11 @@ resource "aws_autoscaling_group" "test" {
   instance_refresh {
     strategy = "Rolling"
     preferences {
+      checkpoint_delay       = 25
+      checkpoint_percentages = [1, 20, 100]
       instance_warmup        = 10
       min_healthy_percentage = 50
+      skip_matching          = true
     }
   }
 }
@@ -4713,7 +4722,7 @@ resource "aws_autoscaling_group" "test" {
 
 func testAccCheckAutoScalingInstanceRefreshCount(group *autoscaling.Group, expected int) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 
 		input := autoscaling.DescribeInstanceRefreshesInput{
 			AutoScalingGroupName: group.AutoScalingGroupName,
@@ -4732,7 +4741,7 @@ func testAccCheckAutoScalingInstanceRefreshCount(group *autoscaling.Group, expec
 
 func testAccCheckAutoScalingInstanceRefreshStatus(group *autoscaling.Group, offset int, expected ...string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 
 		input := autoscaling.DescribeInstanceRefreshesInput{
 			AutoScalingGroupName: group.AutoScalingGroupName,
@@ -4882,6 +4891,30 @@ func TestCreateAutoScalingGroupInstanceRefreshInput(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "checkpoints and skip_matching",
+			input: []interface{}{map[string]interface{}{
+				"strategy": "Rolling",
+				"preferences": []interface{}{
+					map[string]interface{}{
+						"checkpoint_delay":       "300",
+						"checkpoint_percentages": []interface{}{25, 50, 100},
+						"min_healthy_percentage": 90,
+						"skip_matching":          true,
+					},
+				},
+			}},
+			expected: &autoscaling.StartInstanceRefreshInput{
+				AutoScalingGroupName: aws.String(asgName),
+				Strategy:             aws.String("Rolling"),
+				Preferences: &autoscaling.RefreshPreferences{
+					CheckpointDelay:       aws.Int64(300),
+					CheckpointPercentages: aws.Int64Slice([]int64{25, 50, 100}),
+					MinHealthyPercentage:  aws.Int64(90),
+					SkipMatching:          aws.Bool(true),
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -5046,7 +5079,7 @@ func testAccCheckLBTargetGroupExists(n string, res *elbv2.TargetGroup) resource.
 			return errors.New("No Target Group ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Conn()
 
 		targetGroup, err := tfelbv2.FindTargetGroupByARN(conn, rs.Primary.ID)
 
@@ -561,7 +561,7 @@ func testAccCheckLaunchConfigurationWithEncryption(conf *autoscaling.LaunchConfi
 }
 
 func testAccCheckLaunchConfigurationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_launch_configuration" {
@@ -640,7 +640,7 @@ func testAccCheckLaunchConfigurationExists(n string, res *autoscaling.LaunchConf
 			return fmt.Errorf("No Launch Configuration ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn()
 
 		describeOpts := autoscaling.DescribeLaunchConfigurationsInput{
 			LaunchConfigurationNames: []*string{aws.String(rs.Primary.ID)},
@@ -1005,7 +1005,7 @@ func testAccCheckAmiExists(n string, ami *ec2.Image) resource.TestCheckFunc {
 			return fmt.Errorf("No AMI ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		var resp *ec2.DescribeImagesOutput
 		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
@@ -1048,7 +1048,7 @@ func testAccCheckSecurityGroupExists(n string, group *ec2.SecurityGroup) resourc
 			return fmt.Errorf("No Security Group is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		sg, err := tfec2.FindSecurityGroupByID(conn, rs.Primary.ID)
 		if tfresource.NotFound(err) {
@@ -84,7 +84,7 @@ func TestAccBackupRegionSettings_basic(t *testing.T) {
 func testAccCheckRegionSettingsExists(settings *backup.DescribeRegionSettingsOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn()
 		resp, err := conn.DescribeRegionSettings(&backup.DescribeRegionSettingsInput{})
 		if err != nil {
 			return err
@@ -43,7 +43,7 @@ func sweepVaultNotifications(region string) error {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).BackupConn
+	conn := client.(*conns.AWSClient).BackupConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -90,7 +90,7 @@ func sweepVaultPolicies(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).BackupConn
+	conn := client.(*conns.AWSClient).BackupConn()
 	input := &backup.ListBackupVaultsInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
@@ -133,7 +133,7 @@ func sweepVaults(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).BackupConn
+	conn := client.(*conns.AWSClient).BackupConn()
 	input := &backup.ListBackupVaultsInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
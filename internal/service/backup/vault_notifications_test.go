@@ -65,7 +65,7 @@ func TestAccBackupVaultNotifications_Notification_disappears(t *testing.T) {
 }
 
 func testAccCheckVaultNotificationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_backup_vault_notifications" {
 			continue
@@ -94,7 +94,7 @@ func testAccCheckVaultNotificationExists(name string, vault *backup.GetBackupVau
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn()
 		params := &backup.GetBackupVaultNotificationsInput{
 			BackupVaultName: aws.String(rs.Primary.ID),
 		}
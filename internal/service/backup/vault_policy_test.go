@@ -97,7 +97,7 @@ func TestAccBackupVaultPolicy_Disappears_vault(t *testing.T) {
 }
 
 func testAccCheckVaultPolicyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_backup_vault_policy" {
@@ -131,7 +131,7 @@ func testAccCheckVaultPolicyExists(name string, vault *backup.GetBackupVaultAcce
 			return fmt.Errorf("No Backup Vault Policy ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn()
 
 		output, err := tfbackup.FindBackupVaultAccessPolicyByName(conn, rs.Primary.ID)
 
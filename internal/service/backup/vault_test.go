@@ -140,7 +140,7 @@ func TestAccBackupVault_disappears(t *testing.T) {
 }
 
 func testAccCheckVaultDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_backup_vault" {
 			continue
@@ -169,7 +169,7 @@ func testAccCheckVaultExists(name string, vault *backup.DescribeBackupVaultOutpu
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn()
 		params := &backup.DescribeBackupVaultInput{
 			BackupVaultName: aws.String(rs.Primary.ID),
 		}
@@ -185,7 +185,7 @@ func testAccCheckVaultExists(name string, vault *backup.DescribeBackupVaultOutpu
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BackupConn()
 
 	input := &backup.ListBackupVaultsInput{}
 
@@ -175,7 +175,7 @@ func ResourceJobDefinition() *schema.Resource {
 }
 
 func resourceJobDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BatchConn
+	conn := meta.(*conns.AWSClient).BatchConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	name := d.Get("name").(string)
@@ -227,7 +227,7 @@ func resourceJobDefinitionCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceJobDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BatchConn
+	conn := meta.(*conns.AWSClient).BatchConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -271,7 +271,7 @@ func resourceJobDefinitionRead(d *schema.ResourceData, meta interface{}) error {
 	tags := KeyValueTags(jobDefinition.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -294,7 +294,7 @@ func resourceJobDefinitionRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceJobDefinitionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BatchConn
+	conn := meta.(*conns.AWSClient).BatchConn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -308,7 +308,7 @@ func resourceJobDefinitionUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceJobDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).BatchConn
+	conn := meta.(*conns.AWSClient).BatchConn()
 
 	_, err := conn.DeregisterJobDefinition(&batch.DeregisterJobDefinitionInput{
 		JobDefinition: aws.String(d.Id()),
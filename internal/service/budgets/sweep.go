@@ -33,7 +33,7 @@ func sweepBudgetActionss(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).BudgetsConn
+	conn := client.(*conns.AWSClient).BudgetsConn()
 	accountID := client.(*conns.AWSClient).AccountID
 	input := &budgets.DescribeBudgetActionsForAccountInput{
 		AccountId: aws.String(accountID),
@@ -83,7 +83,7 @@ func sweepBudgets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).BudgetsConn
+	conn := client.(*conns.AWSClient).BudgetsConn()
 	accountID := client.(*conns.AWSClient).AccountID
 	input := &budgets.DescribeBudgetsInput{
 		AccountId: aws.String(accountID),
@@ -76,7 +76,7 @@ func ResourceEnvironmentEC2() *schema.Resource {
 }
 
 func resourceEnvironmentEC2Create(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Cloud9Conn
+	conn := meta.(*conns.AWSClient).Cloud9Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -157,7 +157,7 @@ func resourceEnvironmentEC2Create(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceEnvironmentEC2Read(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Cloud9Conn
+	conn := meta.(*conns.AWSClient).Cloud9Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -197,7 +197,7 @@ func resourceEnvironmentEC2Read(d *schema.ResourceData, meta interface{}) error
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -211,7 +211,7 @@ func resourceEnvironmentEC2Read(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceEnvironmentEC2Update(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Cloud9Conn
+	conn := meta.(*conns.AWSClient).Cloud9Conn()
 
 	input := cloud9.UpdateEnvironmentInput{
 		Description:   aws.String(d.Get("description").(string)),
@@ -241,7 +241,7 @@ func resourceEnvironmentEC2Update(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceEnvironmentEC2Delete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Cloud9Conn
+	conn := meta.(*conns.AWSClient).Cloud9Conn()
 
 	_, err := conn.DeleteEnvironment(&cloud9.DeleteEnvironmentInput{
 		EnvironmentId: aws.String(d.Id()),
@@ -469,7 +469,7 @@ func TestAccCloudControlResource_resourceSchema(t *testing.T) {
 }
 
 func testAccCheckResourceDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudControlConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudControlConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudcontrolapi_resource" {
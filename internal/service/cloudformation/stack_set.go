@@ -96,6 +96,49 @@ func ResourceStackSet() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]+$`), "must contain only alphanumeric and hyphen characters"),
 				),
 			},
+			"operation_preferences": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_tolerance_count": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntAtLeast(0),
+							ConflictsWith: []string{"operation_preferences.0.failure_tolerance_percentage"},
+						},
+						"failure_tolerance_percentage": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntBetween(0, 100),
+							ConflictsWith: []string{"operation_preferences.0.failure_tolerance_count"},
+						},
+						"max_concurrent_count": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntAtLeast(1),
+							ConflictsWith: []string{"operation_preferences.0.max_concurrent_percentage"},
+						},
+						"max_concurrent_percentage": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntBetween(1, 100),
+							ConflictsWith: []string{"operation_preferences.0.max_concurrent_count"},
+						},
+						"region_concurrency_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(cloudformation.RegionConcurrencyType_Values(), false),
+						},
+						"region_order": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"parameters": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -133,7 +176,7 @@ func ResourceStackSet() *schema.Resource {
 }
 
 func resourceStackSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFormationConn
+	conn := meta.(*conns.AWSClient).CloudFormationConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -196,7 +239,7 @@ func resourceStackSetCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceStackSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFormationConn
+	conn := meta.(*conns.AWSClient).CloudFormationConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -237,7 +280,7 @@ func resourceStackSetRead(d *schema.ResourceData, meta interface{}) error {
 	tags := KeyValueTags(stackSet.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -251,7 +294,7 @@ func resourceStackSetRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceStackSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFormationConn
+	conn := meta.(*conns.AWSClient).CloudFormationConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -278,6 +321,10 @@ func resourceStackSetUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.ExecutionRoleName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("operation_preferences"); ok {
+		input.OperationPreferences = expandOperationPreferences(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("parameters"); ok {
 		input.Parameters = expandParameters(v.(map[string]interface{}))
 	}
@@ -321,7 +368,7 @@ func resourceStackSetUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceStackSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFormationConn
+	conn := meta.(*conns.AWSClient).CloudFormationConn()
 
 	input := &cloudformation.DeleteStackSetInput{
 		StackSetName: aws.String(d.Id()),
@@ -368,3 +415,39 @@ func flattenStackSetAutoDeploymentResponse(autoDeployment *cloudformation.AutoDe
 
 	return []map[string]interface{}{m}
 }
+
+func expandOperationPreferences(l []interface{}) *cloudformation.StackSetOperationPreferences {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	operationPreferences := &cloudformation.StackSetOperationPreferences{}
+
+	if v, ok := m["failure_tolerance_count"].(int); ok && v != 0 {
+		operationPreferences.FailureToleranceCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["failure_tolerance_percentage"].(int); ok && v != 0 {
+		operationPreferences.FailureTolerancePercentage = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["max_concurrent_count"].(int); ok && v != 0 {
+		operationPreferences.MaxConcurrentCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["max_concurrent_percentage"].(int); ok && v != 0 {
+		operationPreferences.MaxConcurrentPercentage = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["region_concurrency_type"].(string); ok && v != "" {
+		operationPreferences.RegionConcurrencyType = aws.String(v)
+	}
+
+	if v, ok := m["region_order"].([]interface{}); ok && len(v) > 0 {
+		operationPreferences.RegionOrder = flex.ExpandStringList(v)
+	}
+
+	return operationPreferences
+}
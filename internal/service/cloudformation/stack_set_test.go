@@ -253,6 +253,50 @@ func TestAccCloudFormationStackSet_name(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStackSet_operationPreferences(t *testing.T) {
+	var stackSet1, stackSet2 cloudformation.StackSet
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckStackSet(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckStackSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackSetOperationPreferencesConfig(rName, "description1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetExists(resourceName, &stackSet1),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.failure_tolerance_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.max_concurrent_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.region_concurrency_type", "PARALLEL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"operation_preferences",
+					"template_url",
+				},
+			},
+			{
+				Config: testAccStackSetOperationPreferencesConfig(rName, "description2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetExists(resourceName, &stackSet2),
+					testAccCheckCloudFormationStackSetNotRecreated(&stackSet1, &stackSet2),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.max_concurrent_count", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudFormationStackSet_parameters(t *testing.T) {
 	var stackSet1, stackSet2 cloudformation.StackSet
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -587,7 +631,7 @@ func testAccCheckCloudFormationStackSetExists(resourceName string, v *cloudforma
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn()
 
 		output, err := tfcloudformation.FindStackSetByName(conn, rs.Primary.ID)
 
@@ -602,7 +646,7 @@ func testAccCheckCloudFormationStackSetExists(resourceName string, v *cloudforma
 }
 
 func testAccCheckStackSetDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudformation_stack_set" {
@@ -646,7 +690,7 @@ func testAccCheckCloudFormationStackSetRecreated(i, j *cloudformation.StackSet)
 }
 
 func testAccPreCheckStackSet(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn()
 
 	input := &cloudformation.ListStackSetsInput{}
 	_, err := conn.ListStackSets(input)
@@ -1004,6 +1048,49 @@ TEMPLATE
 `, rName, testAccStackSetTemplateBodyVPC(rName))
 }
 
+func testAccStackSetOperationPreferencesConfig(rName, description string, maxConcurrentCount int) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": [
+          "cloudformation.amazonaws.com"
+        ]
+      },
+      "Action": [
+        "sts:AssumeRole"
+      ]
+    }
+  ]
+}
+EOF
+
+  name = %[1]q
+}
+
+resource "aws_cloudformation_stack_set" "test" {
+  administration_role_arn = aws_iam_role.test.arn
+  description             = %[3]q
+  name                    = %[1]q
+
+  operation_preferences {
+    failure_tolerance_count = 0
+    max_concurrent_count    = %[4]d
+    region_concurrency_type = "PARALLEL"
+  }
+
+  template_body = <<TEMPLATE
+%[2]s
+TEMPLATE
+}
+`, rName, testAccStackSetTemplateBodyVPC(rName), description, maxConcurrentCount)
+}
+
 func testAccStackSetParameters1Config(rName, value1 string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test" {
@@ -149,7 +149,7 @@ func testAccCheckTypeExists(resourceName string) resource.TestCheckFunc {
 			return fmt.Errorf("No CloudFormation Type ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn()
 
 		_, err := tfcloudformation.FindTypeByARN(context.TODO(), conn, rs.Primary.ID)
 
@@ -162,7 +162,7 @@ func testAccCheckTypeExists(resourceName string) resource.TestCheckFunc {
 }
 
 func testAccCheckTypeDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFormationConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudformation_stack_set" {
@@ -801,7 +801,7 @@ func ResourceDistribution() *schema.Resource {
 }
 
 func resourceDistributionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -853,7 +853,7 @@ func resourceDistributionCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceDistributionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -899,7 +899,7 @@ func resourceDistributionRead(d *schema.ResourceData, meta interface{}) error {
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -911,7 +911,7 @@ func resourceDistributionRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDistributionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 	params := &cloudfront.UpdateDistributionInput{
 		Id:                 aws.String(d.Id()),
 		DistributionConfig: expandDistributionConfig(d),
@@ -962,7 +962,7 @@ func resourceDistributionUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceDistributionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	if d.Get("retain_on_delete").(bool) {
 		// Check if we need to disable first
@@ -1147,7 +1147,7 @@ func DistributionWaitUntilDeployed(id string, meta interface{}) error {
 // The refresh function for resourceAwsCloudFrontWebDistributionWaitUntilDeployed.
 func resourceWebDistributionStateRefreshFunc(id string, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		conn := meta.(*conns.AWSClient).CloudFrontConn
+		conn := meta.(*conns.AWSClient).CloudFrontConn()
 		params := &cloudfront.GetDistributionInput{
 			Id: aws.String(id),
 		}
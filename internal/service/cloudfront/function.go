@@ -73,7 +73,7 @@ func ResourceFunction() *schema.Resource {
 // resourceAwsCloudFrontFunction maps to:
 // CreateFunction in the API / SDK
 func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	functionName := d.Get("name").(string)
 	input := &cloudfront.CreateFunctionInput{
@@ -114,7 +114,7 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 // resourceFunctionRead maps to:
 // GetFunction in the API / SDK
 func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	stage := cloudfront.FunctionStageDevelopment
 	if d.Get("publish").(bool) {
@@ -157,7 +157,7 @@ func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 // resourceFunctionUpdate maps to:
 // UpdateFunctionCode in the API / SDK
 func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 	etag := d.Get("etag").(string)
 
 	if d.HasChanges("code", "comment", "runtime") {
@@ -201,7 +201,7 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 // resourceAwsCloudFrontFunction maps to:
 // DeleteFunction in the API / SDK
 func resourceFunctionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	log.Printf("[INFO] Deleting Cloudfront Function: %s", d.Id())
 	_, err := conn.DeleteFunction(&cloudfront.DeleteFunctionInput{
@@ -59,7 +59,7 @@ func ResourceMonitoringSubscription() *schema.Resource {
 }
 
 func resourceMonitoringSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	id := d.Get("distribution_id").(string)
 	input := &cloudfront.CreateMonitoringSubscriptionInput{
@@ -80,7 +80,7 @@ func resourceMonitoringSubscriptionCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceMonitoringSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	subscription, err := FindMonitoringSubscriptionByDistributionID(conn, d.Id())
 
@@ -111,7 +111,7 @@ func resourceMonitoringSubscriptionRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceMonitoringSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	log.Printf("[DEBUG] Deleting CloudFront Monitoring Subscription (%s)", d.Id())
 	_, err := conn.DeleteMonitoringSubscription(&cloudfront.DeleteMonitoringSubscriptionInput{
@@ -110,7 +110,7 @@ func DataSourceOriginRequestPolicy() *schema.Resource {
 }
 
 func dataSourceOriginRequestPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	if d.Get("id").(string) == "" {
 		if err := dataSourceOriginRequestPolicyFindByName(d, conn); err != nil {
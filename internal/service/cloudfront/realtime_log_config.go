@@ -90,7 +90,7 @@ func ResourceRealtimeLogConfig() *schema.Resource {
 }
 
 func resourceRealtimeLogConfigCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	name := d.Get("name").(string)
 	input := &cloudfront.CreateRealtimeLogConfigInput{
@@ -113,7 +113,7 @@ func resourceRealtimeLogConfigCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceRealtimeLogConfigRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	logConfig, err := FindRealtimeLogConfigByARN(conn, d.Id())
 
@@ -150,7 +150,7 @@ func resourceRealtimeLogConfigRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceRealtimeLogConfigUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	input := &cloudfront.UpdateRealtimeLogConfigInput{
 		ARN:          aws.String(d.Id()),
@@ -170,7 +170,7 @@ func resourceRealtimeLogConfigUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceRealtimeLogConfigDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudFrontConn
+	conn := meta.(*conns.AWSClient).CloudFrontConn()
 
 	log.Printf("[DEBUG] Deleting CloudFront Real-time Log Config (%s)", d.Id())
 	_, err := conn.DeleteRealtimeLogConfig(&cloudfront.DeleteRealtimeLogConfigInput{
@@ -48,7 +48,7 @@ func sweepDistributions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 
 	distributionSummaries := make([]*cloudfront.DistributionSummary, 0)
 
@@ -102,7 +102,7 @@ func sweepFunctions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	input := &cloudfront.ListFunctionsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -161,7 +161,7 @@ func sweepKeyGroup(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	var sweeperErrs *multierror.Error
 
 	input := &cloudfront.ListKeyGroupsInput{}
@@ -210,7 +210,7 @@ func sweepMonitoringSubscriptions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	var sweeperErrs *multierror.Error
 
 	distributionSummaries := make([]*cloudfront.DistributionSummary, 0)
@@ -258,7 +258,7 @@ func sweepRealtimeLogsConfig(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudFrontConn
+	conn := client.(*conns.AWSClient).CloudFrontConn()
 	input := &cloudfront.ListRealtimeLogConfigsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -32,7 +32,7 @@ func sweepCloudhsmv2Clusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudHSMV2Conn
+	conn := client.(*conns.AWSClient).CloudHSMV2Conn()
 	input := &cloudhsmv2.DescribeClustersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -78,7 +78,7 @@ func sweepCloudhsmv2HSMs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).CloudHSMV2Conn
+	conn := client.(*conns.AWSClient).CloudHSMV2Conn()
 	input := &cloudhsmv2.DescribeClustersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
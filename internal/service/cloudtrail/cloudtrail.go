@@ -1,12 +1,14 @@
 package cloudtrail
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -232,12 +234,15 @@ func ResourceCloudTrail() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffAdvancedEventSelectorResourcesType,
+		),
 	}
 }
 
 func resourceCloudTrailCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudTrailConn
+	conn := meta.(*conns.AWSClient).CloudTrailConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -335,7 +340,7 @@ func resourceCloudTrailCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudTrailConn
+	conn := meta.(*conns.AWSClient).CloudTrailConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -394,7 +399,7 @@ func resourceCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -443,7 +448,7 @@ func resourceCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudTrailConn
+	conn := meta.(*conns.AWSClient).CloudTrailConn()
 
 	input := cloudtrail.UpdateTrailInput{
 		Name: aws.String(d.Id()),
@@ -546,7 +551,7 @@ func resourceCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCloudTrailDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CloudTrailConn
+	conn := meta.(*conns.AWSClient).CloudTrailConn()
 
 	log.Printf("[DEBUG] Deleting CloudTrail: %q", d.Id())
 	_, err := conn.DeleteTrail(&cloudtrail.DeleteTrailInput{
@@ -812,6 +817,46 @@ func expandAdvancedEventSelectorFieldSelector(configured *schema.Set) []*cloudtr
 	return fieldSelectors
 }
 
+// customizeDiffAdvancedEventSelectorResourcesType validates the values of any
+// "resources.type" field selector, which accepts only supported data event resource types.
+func customizeDiffAdvancedEventSelectorResourcesType(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for i, raw := range diff.Get("advanced_event_selector").([]interface{}) {
+		data, ok := raw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for _, raw := range data["field_selector"].(*schema.Set).List() {
+			fieldSelector := raw.(map[string]interface{})
+
+			if fieldSelector["field"].(string) != fieldResourcesType {
+				continue
+			}
+
+		equals:
+			for _, raw := range fieldSelector["equals"].([]interface{}) {
+				v := raw.(string)
+
+				// Unknown values are validated once known.
+				if v == "" {
+					continue
+				}
+
+				for _, resourceType := range advancedEventSelectorResourceType_Values() {
+					if v == resourceType {
+						continue equals
+					}
+				}
+
+				return fmt.Errorf("advanced_event_selector.%d: invalid %s value %q, expected one of %v", i, fieldResourcesType, v, advancedEventSelectorResourceType_Values())
+			}
+		}
+	}
+
+	return nil
+}
+
 func flattenAdvancedEventSelector(configured []*cloudtrail.AdvancedEventSelector) []map[string]interface{} {
 	advancedEventSelectors := make([]map[string]interface{}, 0, len(configured))
 
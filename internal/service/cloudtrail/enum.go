@@ -14,6 +14,34 @@ func resourceType_Values() []string {
 	}
 }
 
+const (
+	advancedEventSelectorResourceTypeDynamoDBStream            = "AWS::DynamoDB::Stream"
+	advancedEventSelectorResourceTypeDynamoDBTable             = "AWS::DynamoDB::Table"
+	advancedEventSelectorResourceTypeEC2Snapshot               = "AWS::EC2::Snapshot"
+	advancedEventSelectorResourceTypeGlueTable                 = "AWS::Glue::Table"
+	advancedEventSelectorResourceTypeLambdaFunction            = "AWS::Lambda::Function"
+	advancedEventSelectorResourceTypeManagedBlockchainNode     = "AWS::ManagedBlockchain::Node"
+	advancedEventSelectorResourceTypeS3AccessPoint             = "AWS::S3::AccessPoint"
+	advancedEventSelectorResourceTypeS3Object                  = "AWS::S3::Object"
+	advancedEventSelectorResourceTypeS3ObjectLambdaAccessPoint = "AWS::S3ObjectLambda::AccessPoint"
+	advancedEventSelectorResourceTypeS3OutpostsObject          = "AWS::S3Outposts::Object"
+)
+
+func advancedEventSelectorResourceType_Values() []string {
+	return []string{
+		advancedEventSelectorResourceTypeDynamoDBStream,
+		advancedEventSelectorResourceTypeDynamoDBTable,
+		advancedEventSelectorResourceTypeEC2Snapshot,
+		advancedEventSelectorResourceTypeGlueTable,
+		advancedEventSelectorResourceTypeLambdaFunction,
+		advancedEventSelectorResourceTypeManagedBlockchainNode,
+		advancedEventSelectorResourceTypeS3AccessPoint,
+		advancedEventSelectorResourceTypeS3Object,
+		advancedEventSelectorResourceTypeS3ObjectLambdaAccessPoint,
+		advancedEventSelectorResourceTypeS3OutpostsObject,
+	}
+}
+
 const (
 	fieldEventCategory = "eventCategory"
 	fieldEventName     = "eventName"
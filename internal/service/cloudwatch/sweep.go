@@ -30,7 +30,7 @@ func sweepCompositeAlarms(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).CloudWatchConn
+	conn := client.(*conns.AWSClient).CloudWatchConn()
 	ctx := context.Background()
 
 	input := &cloudwatch.DescribeAlarmsInput{
@@ -72,7 +72,7 @@ func sweepAPIDestination(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).CloudWatchEventsConn
+	conn := client.(*conns.AWSClient).CloudWatchEventsConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -115,7 +115,7 @@ func sweepArchives(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).CloudWatchEventsConn
+	conn := client.(*conns.AWSClient).CloudWatchEventsConn()
 
 	input := &events.ListArchivesInput{}
 
@@ -163,7 +163,7 @@ func sweepBuses(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).CloudWatchEventsConn
+	conn := client.(*conns.AWSClient).CloudWatchEventsConn()
 	input := &events.ListEventBusesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -210,7 +210,7 @@ func sweepConnection(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).CloudWatchEventsConn
+	conn := client.(*conns.AWSClient).CloudWatchEventsConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -251,7 +251,7 @@ func sweepPermissions(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).CloudWatchEventsConn
+	conn := client.(*conns.AWSClient).CloudWatchEventsConn()
 
 	output, err := conn.DescribeEventBus(&events.DescribeEventBusInput{})
 	if err != nil {
@@ -295,7 +295,7 @@ func sweepRules(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).CloudWatchEventsConn
+	conn := client.(*conns.AWSClient).CloudWatchEventsConn()
 
 	var sweeperErrs *multierror.Error
 	var count int
@@ -344,7 +344,7 @@ func sweepTargets(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).CloudWatchEventsConn
+	conn := client.(*conns.AWSClient).CloudWatchEventsConn()
 
 	var sweeperErrs *multierror.Error
 	var rulesCount, targetsCount int
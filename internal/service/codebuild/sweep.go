@@ -29,7 +29,7 @@ func sweepReportGroups(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).CodeBuildConn
+	conn := client.(*conns.AWSClient).CodeBuildConn()
 	input := &codebuild.ListReportGroupsInput{}
 	var sweeperErrs *multierror.Error
 
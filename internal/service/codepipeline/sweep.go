@@ -29,7 +29,7 @@ func sweepPipelines(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).CodePipelineConn
+	conn := client.(*conns.AWSClient).CodePipelineConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
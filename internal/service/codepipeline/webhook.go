@@ -3,13 +3,16 @@ package codepipeline
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/codepipeline"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/importer"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -22,7 +25,7 @@ func ResourceWebhook() *schema.Resource {
 		Update: resourceWebhookUpdate,
 		Delete: resourceWebhookDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceWebhookImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -136,7 +139,7 @@ func extractCodePipelineWebhookAuthConfig(authType string, authConfig map[string
 }
 
 func resourceWebhookCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodePipelineConn
+	conn := meta.(*conns.AWSClient).CodePipelineConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	authType := d.Get("authentication").(string)
@@ -235,7 +238,7 @@ func flattenCodePipelineWebhookAuthenticationConfiguration(authConfig *codepipel
 }
 
 func resourceWebhookRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodePipelineConn
+	conn := meta.(*conns.AWSClient).CodePipelineConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -283,7 +286,7 @@ func resourceWebhookRead(d *schema.ResourceData, meta interface{}) error {
 	tags := KeyValueTags(webhook.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -295,7 +298,7 @@ func resourceWebhookRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceWebhookUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodePipelineConn
+	conn := meta.(*conns.AWSClient).CodePipelineConn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -309,7 +312,7 @@ func resourceWebhookUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceWebhookDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).CodePipelineConn
+	conn := meta.(*conns.AWSClient).CodePipelineConn()
 	name := d.Get("name").(string)
 
 	input := codepipeline.DeleteWebhookInput{
@@ -323,3 +326,45 @@ func resourceWebhookDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func resourceWebhookImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*conns.AWSClient)
+
+	fromARN := func(resARN arn.ARN) (string, error) {
+		resourceParts := strings.Split(resARN.Resource, ":")
+
+		if len(resourceParts) != 2 || resourceParts[0] != "webhook" || resourceParts[1] == "" {
+			return "", fmt.Errorf("expected resource webhook:NAME in ARN, got: %s", resARN.Resource)
+		}
+
+		return resARN.String(), nil
+	}
+
+	fromName := func(id string) (string, error) {
+		if strings.ContainsAny(id, ":/") {
+			return "", fmt.Errorf("invalid name: %s", id)
+		}
+
+		if client.AccountID == "" {
+			return "", fmt.Errorf("AWS account ID unknown, import using the ARN")
+		}
+
+		return arn.ARN{
+			Partition: client.Partition,
+			Service:   codepipeline.ServiceName,
+			Region:    client.Region,
+			AccountID: client.AccountID,
+			Resource:  "webhook:" + id,
+		}.String(), nil
+	}
+
+	id, err := importer.ARNOrID(d.Id(), codepipeline.ServiceName, fromARN, fromName, "arn:PARTITION:codepipeline:REGION:ACCOUNTID:webhook:NAME", "NAME")
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -47,6 +47,12 @@ func TestAccCodePipelineWebhook_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     rName,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -240,7 +246,7 @@ func testAccCheckWebhookExists(n string, webhook *codepipeline.ListWebhookItem)
 			return fmt.Errorf("No webhook ARN is set as ID")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CodePipelineConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodePipelineConn()
 
 		resp, err := tfcodepipeline.GetWebhook(conn, rs.Primary.ID)
 
@@ -118,7 +118,7 @@ func testAccCheckHostExists(n string, v *codestarconnections.GetHostOutput) reso
 			return errors.New("No CodeStar host ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsConn()
 
 		resp, err := conn.GetHost(&codestarconnections.GetHostInput{
 			HostArn: aws.String(rs.Primary.ID),
@@ -134,7 +134,7 @@ func testAccCheckHostExists(n string, v *codestarconnections.GetHostOutput) reso
 }
 
 func testAccCheckHostDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		switch rs.Type {
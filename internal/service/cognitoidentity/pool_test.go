@@ -335,7 +335,7 @@ func testAccCheckPoolExists(n string) resource.TestCheckFunc {
 			return errors.New("No Cognito Identity Pool ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIdentityConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIdentityConn()
 
 		_, err := conn.DescribeIdentityPool(&cognitoidentity.DescribeIdentityPoolInput{
 			IdentityPoolId: aws.String(rs.Primary.ID),
@@ -346,7 +346,7 @@ func testAccCheckPoolExists(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckPoolDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIdentityConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIdentityConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cognito_identity_pool" {
@@ -369,7 +369,7 @@ func testAccCheckPoolDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIdentityConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIdentityConn()
 
 	input := &cognitoidentity.ListIdentityPoolsInput{
 		MaxResults: aws.Int64(int64(1)),
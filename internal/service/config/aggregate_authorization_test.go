@@ -87,7 +87,7 @@ func TestAccConfigAggregateAuthorization_tags(t *testing.T) {
 }
 
 func testAccCheckAggregateAuthorizationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_aggregate_authorization" {
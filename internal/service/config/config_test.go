@@ -93,6 +93,10 @@ func TestAccConfig_serial(t *testing.T) {
 			"recreates":  testAccConfigRemediationConfiguration_recreates,
 			"updates":    testAccConfigRemediationConfiguration_updates,
 		},
+		"RetentionConfiguration": {
+			"basic":      testAccConfigRetentionConfiguration_basic,
+			"disappears": testAccConfigRetentionConfiguration_disappears,
+		},
 	}
 
 	for group, m := range testCases {
@@ -115,7 +115,7 @@ func testAccCheckConfigConfigurationRecorderStatusExists(n string, obj *configse
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 		out, err := conn.DescribeConfigurationRecorderStatus(&configservice.DescribeConfigurationRecorderStatusInput{
 			ConfigurationRecorderNames: []*string{aws.String(rs.Primary.Attributes["name"])},
 		})
@@ -150,7 +150,7 @@ func testAccCheckConfigConfigurationRecorderStatus(n string, desired bool, obj *
 }
 
 func testAccCheckConfigConfigurationRecorderStatusDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_configuration_recorder_status" {
@@ -418,7 +418,7 @@ func testAccConfigConformancePack_S3TemplateAndTemplateBody(t *testing.T) {
 }
 
 func testAccCheckConfigConformancePackDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_conformance_pack" {
@@ -450,7 +450,7 @@ func testAccCheckConfigConformancePackExists(resourceName string, detail *config
 			return fmt.Errorf("Not Found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 
 		pack, err := tfconfig.DescribeConformancePack(conn, rs.Primary.ID)
 
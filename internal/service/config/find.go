@@ -0,0 +1,38 @@
+package config
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindRetentionConfigurationByName(conn *configservice.ConfigService, name string) (*configservice.RetentionConfiguration, error) {
+	input := &configservice.DescribeRetentionConfigurationsInput{
+		RetentionConfigurationNames: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeRetentionConfigurations(input)
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchRetentionConfigurationException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.RetentionConfigurations) == 0 || output.RetentionConfigurations[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.RetentionConfigurations); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.RetentionConfigurations[0], nil
+}
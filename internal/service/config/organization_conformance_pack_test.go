@@ -414,7 +414,7 @@ func testAccConfigOrganizationConformancePack_updateTemplateBody(t *testing.T) {
 }
 
 func testAccCheckConfigOrganizationConformancePackDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_organization_conformance_pack" {
@@ -452,7 +452,7 @@ func testAccCheckConfigOrganizationConformancePackExists(resourceName string, oc
 			return fmt.Errorf("Not Found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 
 		pack, err := tfconfig.DescribeOrganizationConformancePack(conn, rs.Primary.ID)
 
@@ -446,7 +446,7 @@ func testAccCheckConfigOrganizationCustomRuleExists(resourceName string, ocr *co
 			return fmt.Errorf("Not Found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 
 		rule, err := tfconfig.DescribeOrganizationConfigRule(conn, rs.Primary.ID)
 
@@ -465,7 +465,7 @@ func testAccCheckConfigOrganizationCustomRuleExists(resourceName string, ocr *co
 }
 
 func testAccCheckConfigOrganizationCustomRuleDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_organization_custom_rule" {
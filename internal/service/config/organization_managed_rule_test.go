@@ -408,7 +408,7 @@ func testAccCheckConfigOrganizationManagedRuleExists(resourceName string, ocr *c
 			return fmt.Errorf("Not Found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 
 		rule, err := tfconfig.DescribeOrganizationConfigRule(conn, rs.Primary.ID)
 
@@ -427,7 +427,7 @@ func testAccCheckConfigOrganizationManagedRuleExists(resourceName string, ocr *c
 }
 
 func testAccCheckConfigOrganizationManagedRuleDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_config_organization_managed_rule" {
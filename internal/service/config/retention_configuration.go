@@ -0,0 +1,100 @@
+package config
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceRetentionConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRetentionConfigurationPut,
+		Read:   resourceRetentionConfigurationRead,
+		Update: resourceRetentionConfigurationPut,
+		Delete: resourceRetentionConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"retention_period_in_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(30, 2557),
+			},
+		},
+	}
+}
+
+func resourceRetentionConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ConfigConn()
+
+	input := &configservice.PutRetentionConfigurationInput{
+		RetentionPeriodInDays: aws.Int64(int64(d.Get("retention_period_in_days").(int))),
+	}
+
+	log.Printf("[DEBUG] Putting Config Retention Configuration: %s", input)
+	output, err := conn.PutRetentionConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting Config Retention Configuration: %w", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(aws.StringValue(output.RetentionConfiguration.Name))
+	}
+
+	return resourceRetentionConfigurationRead(d, meta)
+}
+
+func resourceRetentionConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ConfigConn()
+
+	retentionConfiguration, err := FindRetentionConfigurationByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Config Retention Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Config Retention Configuration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("name", retentionConfiguration.Name)
+	d.Set("retention_period_in_days", retentionConfiguration.RetentionPeriodInDays)
+
+	return nil
+}
+
+func resourceRetentionConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ConfigConn()
+
+	log.Printf("[DEBUG] Deleting Config Retention Configuration: %s", d.Id())
+	_, err := conn.DeleteRetentionConfiguration(&configservice.DeleteRetentionConfigurationInput{
+		RetentionConfigurationName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchRetentionConfigurationException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Config Retention Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconfig "github.com/hashicorp/terraform-provider-aws/internal/service/config"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccConfigRetentionConfiguration_basic(t *testing.T) {
+	var rc configservice.RetentionConfiguration
+	resourceName := "aws_config_retention_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, configservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigRetentionConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigRetentionConfigurationConfig(90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigRetentionConfigurationExists(resourceName, &rc),
+					resource.TestCheckResourceAttr(resourceName, "name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period_in_days", "90"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigRetentionConfigurationConfig(180),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigRetentionConfigurationExists(resourceName, &rc),
+					resource.TestCheckResourceAttr(resourceName, "name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period_in_days", "180"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigRetentionConfiguration_disappears(t *testing.T) {
+	var rc configservice.RetentionConfiguration
+	resourceName := "aws_config_retention_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, configservice.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigRetentionConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigRetentionConfigurationConfig(90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigRetentionConfigurationExists(resourceName, &rc),
+					acctest.CheckResourceDisappears(acctest.Provider, tfconfig.ResourceRetentionConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfigRetentionConfigurationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_config_retention_configuration" {
+			continue
+		}
+
+		_, err := tfconfig.FindRetentionConfigurationByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Config Retention Configuration (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckConfigRetentionConfigurationExists(n string, v *configservice.RetentionConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Config Retention Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigConn()
+
+		output, err := tfconfig.FindRetentionConfigurationByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccConfigRetentionConfigurationConfig(days int) string {
+	return fmt.Sprintf(`
+resource "aws_config_retention_configuration" "test" {
+  retention_period_in_days = %[1]d
+}
+`, days)
+}
@@ -52,6 +52,47 @@ func TestAccDLMLifecyclePolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccDLMLifecyclePolicy_event(t *testing.T) {
+	resourceName := "aws_dlm_lifecycle_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dlm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: dlmLifecyclePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: dlmLifecyclePolicyEventConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					checkDlmLifecyclePolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.policy_type", "EVENT_BASED_POLICY"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.action.0.name", "tf-acc-event"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.action.0.cross_region_copy.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "policy_details.0.action.0.cross_region_copy.*", map[string]string{
+						"encryption_configuration.#":           "1",
+						"encryption_configuration.0.encrypted": "false",
+						"retain_rule.#":                        "1",
+						"retain_rule.0.interval":               "15",
+						"retain_rule.0.interval_unit":          "MONTHS",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.event_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.event_source.0.type", "MANAGED_CWE"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.event_source.0.parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.event_source.0.parameters.0.event_type", "shareSnapshot"),
+					resource.TestCheckResourceAttr(resourceName, "policy_details.0.event_source.0.parameters.0.snapshot_owner.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccDLMLifecyclePolicy_full(t *testing.T) {
 	resourceName := "aws_dlm_lifecycle_policy.full"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -147,7 +188,7 @@ func TestAccDLMLifecyclePolicy_tags(t *testing.T) {
 }
 
 func dlmLifecyclePolicyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DLMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DLMConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dlm_lifecycle_policy" {
@@ -183,7 +224,7 @@ func checkDlmLifecyclePolicyExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DLMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DLMConn()
 
 		input := dlm.GetLifecyclePolicyInput{
 			PolicyId: aws.String(rs.Primary.ID),
@@ -200,7 +241,7 @@ func checkDlmLifecyclePolicyExists(name string) resource.TestCheckFunc {
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DLMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DLMConn()
 
 	input := &dlm.GetLifecyclePoliciesInput{}
 
@@ -264,6 +305,68 @@ resource "aws_dlm_lifecycle_policy" "basic" {
 `, rName)
 }
 
+func dlmLifecyclePolicyEventConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "dlm.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_dlm_lifecycle_policy" "test" {
+  description        = "tf-acc-event"
+  execution_role_arn = aws_iam_role.test.arn
+
+  policy_details {
+    policy_type = "EVENT_BASED_POLICY"
+
+    action {
+      name = "tf-acc-event"
+
+      cross_region_copy {
+        encryption_configuration {}
+
+        retain_rule {
+          interval      = 15
+          interval_unit = "MONTHS"
+        }
+
+        target = data.aws_region.current.name
+      }
+    }
+
+    event_source {
+      type = "MANAGED_CWE"
+
+      parameters {
+        description_regex = "^.*Created for policy: policy-1234567890abcdef0.*$"
+        event_type        = "shareSnapshot"
+        snapshot_owner    = [data.aws_caller_identity.current.account_id]
+      }
+    }
+  }
+}
+`, rName)
+}
+
 func dlmLifecyclePolicyFullConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "dlm_lifecycle_role" {
@@ -29,7 +29,7 @@ func sweepReplicationInstances(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).DMSConn
+	conn := client.(*conns.AWSClient).DMSConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
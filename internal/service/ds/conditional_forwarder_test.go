@@ -56,7 +56,7 @@ func TestAccDirectoryServiceConditionalForwarder_Condition_basic(t *testing.T) {
 }
 
 func testAccCheckConditionalForwarderDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DirectoryServiceConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DirectoryServiceConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_directory_service_conditional_forwarder" {
@@ -105,7 +105,7 @@ func testAccCheckConditionalForwarderExists(name string, dnsIps []string) resour
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DirectoryServiceConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DirectoryServiceConn()
 
 		res, err := conn.DescribeConditionalForwarders(&directoryservice.DescribeConditionalForwardersInput{
 			DirectoryId:       aws.String(directoryId),
@@ -369,7 +369,7 @@ func enableDirectoryServiceSso(conn *directoryservice.DirectoryService, d *schem
 }
 
 func resourceDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DirectoryServiceConn
+	conn := meta.(*conns.AWSClient).DirectoryServiceConn()
 
 	var directoryId string
 	var err error
@@ -421,7 +421,7 @@ func resourceDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DirectoryServiceConn
+	conn := meta.(*conns.AWSClient).DirectoryServiceConn()
 
 	if d.HasChange("enable_sso") {
 		if err := enableDirectoryServiceSso(conn, d); err != nil {
@@ -441,7 +441,7 @@ func resourceDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDirectoryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DirectoryServiceConn
+	conn := meta.(*conns.AWSClient).DirectoryServiceConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -499,7 +499,7 @@ func resourceDirectoryRead(d *schema.ResourceData, meta interface{}) error {
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -511,7 +511,7 @@ func resourceDirectoryRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DirectoryServiceConn
+	conn := meta.(*conns.AWSClient).DirectoryServiceConn()
 
 	input := &directoryservice.DeleteDirectoryInput{
 		DirectoryId: aws.String(d.Id()),
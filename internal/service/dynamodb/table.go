@@ -323,7 +323,7 @@ func ResourceTable() *schema.Resource {
 }
 
 func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -458,7 +458,7 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.Get("point_in_time_recovery.0.enabled").(bool) {
-		if err := updateDynamoDbPITR(d, conn); err != nil {
+		if err := updateDynamoDbPITR(d.Id(), d.Get("point_in_time_recovery.0.enabled").(bool), conn); err != nil {
 			return fmt.Errorf("error enabling DynamoDB Table (%s) point in time recovery: %w", d.Id(), err)
 		}
 	}
@@ -473,7 +473,7 @@ func resourceTableCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTableRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -590,7 +590,7 @@ func resourceTableRead(d *schema.ResourceData, meta interface{}) error {
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -602,7 +602,7 @@ func resourceTableRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTableUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 	billingMode := d.Get("billing_mode").(string)
 
 	// Global Secondary Index operations must occur in multiple phases
@@ -772,7 +772,7 @@ func resourceTableUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("point_in_time_recovery") {
-		if err := updateDynamoDbPITR(d, conn); err != nil {
+		if err := updateDynamoDbPITR(d.Id(), d.Get("point_in_time_recovery.0.enabled").(bool), conn); err != nil {
 			return fmt.Errorf("error updating DynamoDB Table (%s) point in time recovery: %w", d.Id(), err)
 		}
 	}
@@ -787,7 +787,7 @@ func resourceTableUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTableDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).DynamoDBConn
+	conn := meta.(*conns.AWSClient).DynamoDBConn()
 
 	log.Printf("[DEBUG] DynamoDB delete table: %s", d.Id())
 
@@ -911,11 +911,9 @@ func updateDynamoDbTimeToLive(tableName string, ttlList []interface{}, conn *dyn
 	return nil
 }
 
-func updateDynamoDbPITR(d *schema.ResourceData, conn *dynamodb.DynamoDB) error {
-	toEnable := d.Get("point_in_time_recovery.0.enabled").(bool)
-
+func updateDynamoDbPITR(tableName string, toEnable bool, conn *dynamodb.DynamoDB) error {
 	input := &dynamodb.UpdateContinuousBackupsInput{
-		TableName: aws.String(d.Id()),
+		TableName: aws.String(tableName),
 		PointInTimeRecoverySpecification: &dynamodb.PointInTimeRecoverySpecification{
 			PointInTimeRecoveryEnabled: aws.Bool(toEnable),
 		},
@@ -941,7 +939,7 @@ func updateDynamoDbPITR(d *schema.ResourceData, conn *dynamodb.DynamoDB) error {
 		return fmt.Errorf("error updating DynamoDB PITR status: %w", err)
 	}
 
-	if _, err := waitDynamoDBPITRUpdated(conn, d.Id(), toEnable); err != nil {
+	if _, err := waitDynamoDBPITRUpdated(conn, tableName, toEnable); err != nil {
 		return fmt.Errorf("error waiting for DynamoDB PITR update: %w", err)
 	}
 
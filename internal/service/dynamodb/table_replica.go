@@ -0,0 +1,275 @@
+package dynamodb
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTableReplica() *schema.Resource {
+	//lintignore:R011
+	return &schema.Resource{
+		Create: resourceTableReplicaCreate,
+		Read:   resourceTableReplicaRead,
+		Update: resourceTableReplicaUpdate,
+		Delete: resourceTableReplicaDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"global_table_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"point_in_time_recovery": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceTableReplicaCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*conns.AWSClient)
+	conn := client.DynamoDBConn()
+	defaultTagsConfig := client.DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	tableName, mainRegion, err := tableReplicaParseGlobalTableARN(d.Get("global_table_arn").(string))
+
+	if err != nil {
+		return err
+	}
+
+	if mainRegion == client.Region {
+		return fmt.Errorf("error creating DynamoDB Table (%s) replica: replica region (%s) must differ from the global table's region", tableName, client.Region)
+	}
+
+	replica := map[string]interface{}{
+		"region_name": client.Region,
+		"kms_key_arn": d.Get("kms_key_arn").(string),
+	}
+
+	if err := createDynamoDbReplicas(tableName, []interface{}{replica}, client.DynamoDBConnForRegion(mainRegion)); err != nil {
+		return err
+	}
+
+	d.SetId(TableReplicaCreateID(tableName, mainRegion))
+
+	table, err := waitDynamoDBTableActive(conn, tableName)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for DynamoDB Table (%s) replica (%s) to be active: %w", tableName, client.Region, err)
+	}
+
+	if d.Get("point_in_time_recovery").(bool) {
+		if err := updateDynamoDbPITR(tableName, true, conn); err != nil {
+			return fmt.Errorf("error enabling DynamoDB Table (%s) replica (%s) point in time recovery: %w", tableName, client.Region, err)
+		}
+	}
+
+	if len(tags) > 0 {
+		if err := UpdateTags(conn, aws.StringValue(table.TableArn), nil, tags); err != nil {
+			return fmt.Errorf("error adding DynamoDB Table (%s) replica (%s) tags: %w", tableName, client.Region, err)
+		}
+	}
+
+	return resourceTableReplicaRead(d, meta)
+}
+
+func resourceTableReplicaRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*conns.AWSClient)
+	conn := client.DynamoDBConn()
+	defaultTagsConfig := client.DefaultTagsConfig
+	ignoreTagsConfig := client.IgnoreTagsConfig
+
+	tableName, mainRegion, err := TableReplicaParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	mainTable, err := FindDynamoDBTableByName(client.DynamoDBConnForRegion(mainRegion), tableName)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] DynamoDB Table (%s) not found, removing replica (%s) from state", tableName, client.Region)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Table (%s): %w", tableName, err)
+	}
+
+	var replica *dynamodb.ReplicaDescription
+
+	if mainTable != nil {
+		for _, v := range mainTable.Replicas {
+			if aws.StringValue(v.RegionName) == client.Region {
+				replica = v
+				break
+			}
+		}
+	}
+
+	if replica == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading DynamoDB Table (%s) replica (%s): not found after creation", tableName, client.Region)
+		}
+		log.Printf("[WARN] DynamoDB Table (%s) replica (%s) not found, removing from state", tableName, client.Region)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("global_table_arn", mainTable.TableArn)
+	d.Set("kms_key_arn", replica.KMSMasterKeyId)
+
+	table, err := FindDynamoDBTableByName(conn, tableName)
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Table (%s) replica (%s): %w", tableName, client.Region, err)
+	}
+
+	if table == nil {
+		return fmt.Errorf("error reading DynamoDB Table (%s) replica (%s): empty output", tableName, client.Region)
+	}
+
+	d.Set("arn", table.TableArn)
+
+	pitr, err := FindDynamoDBPITRDescriptionByTableName(conn, tableName)
+
+	if err != nil {
+		return fmt.Errorf("error describing DynamoDB Table (%s) replica (%s) Continuous Backups: %w", tableName, client.Region, err)
+	}
+
+	d.Set("point_in_time_recovery", pitr != nil && aws.StringValue(pitr.PointInTimeRecoveryStatus) == dynamodb.PointInTimeRecoveryStatusEnabled)
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for DynamoDB Table (%s) replica (%s): %w", tableName, client.Region, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceTableReplicaUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*conns.AWSClient)
+	conn := client.DynamoDBConn()
+
+	tableName, _, err := TableReplicaParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("point_in_time_recovery") {
+		if err := updateDynamoDbPITR(tableName, d.Get("point_in_time_recovery").(bool), conn); err != nil {
+			return fmt.Errorf("error updating DynamoDB Table (%s) replica (%s) point in time recovery: %w", tableName, client.Region, err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating DynamoDB Table (%s) replica (%s) tags: %w", tableName, client.Region, err)
+		}
+	}
+
+	return resourceTableReplicaRead(d, meta)
+}
+
+func resourceTableReplicaDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*conns.AWSClient)
+
+	tableName, mainRegion, err := TableReplicaParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	replica := map[string]interface{}{
+		"region_name": client.Region,
+	}
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table (%s) replica (%s)", tableName, client.Region)
+	err = deleteDynamoDbReplicas(tableName, []interface{}{replica}, client.DynamoDBConnForRegion(mainRegion))
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
+const tableReplicaIDSeparator = ":"
+
+func TableReplicaCreateID(tableName, mainRegion string) string {
+	return strings.Join([]string{tableName, mainRegion}, tableReplicaIDSeparator)
+}
+
+func TableReplicaParseID(id string) (string, string, error) {
+	parts := strings.Split(id, tableReplicaIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected TABLE_NAME%sMAIN_REGION", id, tableReplicaIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// tableReplicaParseGlobalTableARN returns the table name and region of the global table's ARN.
+func tableReplicaParseGlobalTableARN(v string) (string, string, error) {
+	arn, err := arn.Parse(v)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing DynamoDB global table ARN (%s): %w", v, err)
+	}
+
+	tableName := strings.TrimPrefix(arn.Resource, "table/")
+
+	if tableName == "" || tableName == arn.Resource || strings.Contains(tableName, "/") {
+		return "", "", fmt.Errorf("unexpected format of DynamoDB global table ARN (%s), expected arn:PARTITION:dynamodb:REGION:ACCOUNT:table/TABLE_NAME", v)
+	}
+
+	return tableName, arn.Region, nil
+}
@@ -0,0 +1,305 @@
+package dynamodb_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+)
+
+func TestTableReplicaParseID(t *testing.T) {
+	testCases := []struct {
+		TestName          string
+		ID                string
+		ExpectedError     bool
+		ExpectedTableName string
+		ExpectedRegion    string
+	}{
+		{
+			TestName:      "empty",
+			ID:            "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "no region",
+			ID:            "tf-test-table",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty region",
+			ID:            "tf-test-table:",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "too many parts",
+			ID:            "tf-test-table:us-west-2:extra",
+			ExpectedError: true,
+		},
+		{
+			TestName:          "valid",
+			ID:                "tf-test-table:us-west-2",
+			ExpectedTableName: "tf-test-table",
+			ExpectedRegion:    "us-west-2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			tableName, region, err := tfdynamodb.TableReplicaParseID(testCase.ID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if tableName != testCase.ExpectedTableName {
+				t.Errorf("got table name %s, expected %s", tableName, testCase.ExpectedTableName)
+			}
+
+			if region != testCase.ExpectedRegion {
+				t.Errorf("got region %s, expected %s", region, testCase.ExpectedRegion)
+			}
+		})
+	}
+}
+
+func TestAccDynamoDBTableReplica_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_dynamodb_table_replica.test"
+	tableResourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckTableReplicaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableReplicaConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableReplicaExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "dynamodb", regexp.MustCompile(`table/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "global_table_arn", tableResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "point_in_time_recovery", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableReplica_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_dynamodb_table_replica.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckTableReplicaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableReplicaConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableReplicaExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdynamodb.ResourceTableReplica(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableReplica_pitrAndTags(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_dynamodb_table_replica.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckTableReplicaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableReplicaPITRAndTagsConfig(rName, true, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableReplicaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "point_in_time_recovery", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableReplicaPITRAndTagsConfig(rName, false, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableReplicaExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "point_in_time_recovery", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTableReplicaDestroy(s *terraform.State) error {
+	client := acctest.Provider.Meta().(*conns.AWSClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_table_replica" {
+			continue
+		}
+
+		tableName, mainRegion, err := tfdynamodb.TableReplicaParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		table, err := tfdynamodb.FindDynamoDBTableByName(client.DynamoDBConnForRegion(mainRegion), tableName)
+
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if table == nil {
+			continue
+		}
+
+		for _, replica := range table.Replicas {
+			if aws.StringValue(replica.RegionName) == client.Region {
+				return fmt.Errorf("DynamoDB Table (%s) replica (%s) still exists", tableName, client.Region)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckTableReplicaExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB Table Replica ID is set")
+		}
+
+		client := acctest.Provider.Meta().(*conns.AWSClient)
+
+		tableName, mainRegion, err := tfdynamodb.TableReplicaParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		table, err := tfdynamodb.FindDynamoDBTableByName(client.DynamoDBConnForRegion(mainRegion), tableName)
+
+		if err != nil {
+			return err
+		}
+
+		if table == nil {
+			return fmt.Errorf("DynamoDB Table (%s) not found", tableName)
+		}
+
+		for _, replica := range table.Replicas {
+			if aws.StringValue(replica.RegionName) == client.Region {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("DynamoDB Table (%s) replica (%s) not found", tableName, client.Region)
+	}
+}
+
+func testAccTableReplicaBaseConfig(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateRegionProvider(),
+		fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  provider = "awsalternate"
+
+  name             = %[1]q
+  hash_key         = "TestTableHashKey"
+  billing_mode     = "PAY_PER_REQUEST"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  lifecycle {
+    ignore_changes = [replica]
+  }
+}
+`, rName))
+}
+
+func testAccTableReplicaConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccTableReplicaBaseConfig(rName),
+		`
+resource "aws_dynamodb_table_replica" "test" {
+  global_table_arn = aws_dynamodb_table.test.arn
+}
+`)
+}
+
+func testAccTableReplicaPITRAndTagsConfig(rName string, pitr bool, tagValue string) string {
+	return acctest.ConfigCompose(
+		testAccTableReplicaBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_dynamodb_table_replica" "test" {
+  global_table_arn       = aws_dynamodb_table.test.arn
+  point_in_time_recovery = %[1]t
+
+  tags = {
+    key1 = %[2]q
+  }
+}
+`, pitr, tagValue))
+}
@@ -1407,7 +1407,7 @@ func TestAccDynamoDBTable_Replica_singleWithCMK(t *testing.T) {
 }
 
 func testAccCheckTableDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dynamodb_table" {
@@ -1448,7 +1448,7 @@ func testAccCheckInitialTableExists(n string, table *dynamodb.DescribeTableOutpu
 			return fmt.Errorf("No DynamoDB table name specified!")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn()
 
 		params := &dynamodb.DescribeTableInput{
 			TableName: aws.String(rs.Primary.ID),
@@ -254,7 +254,7 @@ func ResourceAMI() *schema.Resource {
 }
 
 func resourceAMICreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).EC2Conn
+	client := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -331,7 +331,7 @@ func resourceAMICreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAMIRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).EC2Conn
+	client := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -446,7 +446,7 @@ func resourceAMIRead(d *schema.ResourceData, meta interface{}) error {
 	tags := KeyValueTags(image.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -458,7 +458,7 @@ func resourceAMIRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAMIUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).EC2Conn
+	client := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -484,7 +484,7 @@ func resourceAMIUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAMIDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*conns.AWSClient).EC2Conn
+	client := meta.(*conns.AWSClient).EC2Conn()
 
 	req := &ec2.DeregisterImageInput{
 		ImageId: aws.String(d.Id()),
@@ -131,7 +131,7 @@ func ResourceCapacityReservation() *schema.Resource {
 }
 
 func resourceCapacityReservationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -183,7 +183,7 @@ func resourceCapacityReservationCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCapacityReservationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -232,7 +232,7 @@ func resourceCapacityReservationRead(d *schema.ResourceData, meta interface{}) e
 	tags := KeyValueTags(reservation.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -247,7 +247,7 @@ func resourceCapacityReservationRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceCapacityReservationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	opts := &ec2.ModifyCapacityReservationInput{
 		CapacityReservationId: aws.String(d.Id()),
@@ -282,7 +282,7 @@ func resourceCapacityReservationUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceCapacityReservationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	opts := &ec2.CancelCapacityReservationInput{
 		CapacityReservationId: aws.String(d.Id()),
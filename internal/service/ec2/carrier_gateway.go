@@ -50,7 +50,7 @@ func ResourceCarrierGateway() *schema.Resource {
 }
 
 func resourceCarrierGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -78,7 +78,7 @@ func resourceCarrierGatewayCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceCarrierGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -114,7 +114,7 @@ func resourceCarrierGatewayRead(d *schema.ResourceData, meta interface{}) error
 	tags := KeyValueTags(carrierGateway.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -126,7 +126,7 @@ func resourceCarrierGatewayRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceCarrierGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -140,7 +140,7 @@ func resourceCarrierGatewayUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceCarrierGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[INFO] Deleting EC2 Carrier Gateway (%s)", d.Id())
 	_, err := conn.DeleteCarrierGateway(&ec2.DeleteCarrierGatewayInput{
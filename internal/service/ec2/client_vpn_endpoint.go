@@ -145,7 +145,7 @@ func ResourceClientVPNEndpoint() *schema.Resource {
 }
 
 func resourceClientVPNEndpointCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -213,7 +213,7 @@ func resourceClientVPNEndpointCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceClientVPNEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -274,7 +274,7 @@ func resourceClientVPNEndpointRead(d *schema.ResourceData, meta interface{}) err
 	tags := KeyValueTags(result.ClientVpnEndpoints[0].Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -295,7 +295,7 @@ func resourceClientVPNEndpointRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceClientVPNEndpointDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	err := DeleteClientVPNEndpoint(conn, d.Id())
 	if err != nil {
@@ -306,7 +306,7 @@ func resourceClientVPNEndpointDelete(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceClientVPNEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	req := &ec2.ModifyClientVpnEndpointInput{
 		ClientVpnEndpointId: aws.String(d.Id()),
@@ -207,7 +207,7 @@ func TestAccEC2CustomerGateway_4ByteASN(t *testing.T) {
 }
 
 func testAccCheckCustomerGatewayDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_customer_gatewah" {
@@ -259,7 +259,7 @@ func testAccCheckCustomerGateway(gatewayResource string, cgw *ec2.CustomerGatewa
 			return fmt.Errorf("Not found: %s", gatewayResource)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		gatewayFilter := &ec2.Filter{
 			Name:   aws.String("customer-gateway-id"),
 			Values: []*string{aws.String(gateway.Primary.ID)},
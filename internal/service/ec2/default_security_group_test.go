@@ -185,7 +185,7 @@ func testAccCheckDefaultSecurityGroupExists(n string, group *ec2.SecurityGroup)
 			return fmt.Errorf("No EC2 Default Security Group ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		sg, err := tfec2.FindSecurityGroupByID(conn, rs.Primary.ID)
 		if err != nil {
@@ -209,7 +209,7 @@ func testAccCheckDefaultSecurityGroupClassicExists(n string, group *ec2.Security
 			return fmt.Errorf("No EC2 Default Security Group ID is set")
 		}
 
-		conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).EC2Conn()
 
 		sg, err := tfec2.FindSecurityGroupByID(conn, rs.Primary.ID)
 		if err != nil {
@@ -128,7 +128,7 @@ func ResourceEIP() *schema.Resource {
 }
 
 func resourceEIPCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -196,7 +196,7 @@ func resourceEIPCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceEIPRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -316,7 +316,7 @@ func resourceEIPRead(d *schema.ResourceData, meta interface{}) error {
 	tags := KeyValueTags(address.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -328,7 +328,7 @@ func resourceEIPRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceEIPUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	domain := resourceEIPDomain(d)
 
@@ -425,7 +425,7 @@ func resourceEIPUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceEIPDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if err := resourceEIPRead(d, meta); err != nil {
 		return err
@@ -493,7 +493,7 @@ func resourceEIPDomain(d *schema.ResourceData) string {
 }
 
 func disassociateEip(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	log.Printf("[DEBUG] Disassociating EIP: %s", d.Id())
 	var err error
 	switch resourceEIPDomain(d) {
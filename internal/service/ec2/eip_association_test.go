@@ -195,7 +195,7 @@ func testAccCheckEIPAssociationExists(name string, res *ec2.Address) resource.Te
 			return fmt.Errorf("No EIP Association ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		platforms := acctest.Provider.Meta().(*conns.AWSClient).SupportedPlatforms
 
 		request, err := tfec2.DescribeAddressesByID(rs.Primary.ID, platforms)
@@ -228,7 +228,7 @@ func testAccCheckEIPAssociationClassicExists(name string, res *ec2.Address) reso
 			return fmt.Errorf("No EIP Association ID is set")
 		}
 
-		conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).EC2Conn()
 		platforms := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).SupportedPlatforms
 
 		request, err := tfec2.DescribeAddressesByID(rs.Primary.ID, platforms)
@@ -282,7 +282,7 @@ func testAccCheckEIPAssociationDestroy(s *terraform.State) error {
 			return fmt.Errorf("No EIP Association ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		request := &ec2.DescribeAddressesInput{
 			Filters: []*ec2.Filter{
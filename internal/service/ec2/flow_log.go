@@ -134,7 +134,7 @@ func ResourceFlowLog() *schema.Resource {
 }
 
 func resourceLogFlowCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -216,7 +216,7 @@ func resourceLogFlowCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLogFlowRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -267,7 +267,7 @@ func resourceLogFlowRead(d *schema.ResourceData, meta interface{}) error {
 	tags := KeyValueTags(fl.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -279,7 +279,7 @@ func resourceLogFlowRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLogFlowUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -292,7 +292,7 @@ func resourceLogFlowUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLogFlowDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[INFO] Deleting Flow Log: %s", d.Id())
 	output, err := conn.DeleteFlowLogs(&ec2.DeleteFlowLogsInput{
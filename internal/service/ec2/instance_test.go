@@ -701,7 +701,7 @@ func TestAccEC2Instance_disableAPITermination(t *testing.T) {
 
 	checkDisableApiTermination := func(expected bool) resource.TestCheckFunc {
 		return func(*terraform.State) error {
-			conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+			conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 			r, err := conn.DescribeInstanceAttribute(&ec2.DescribeInstanceAttributeInput{
 				InstanceId: v.InstanceId,
 				Attribute:  aws.String("disableApiTermination"),
@@ -3774,7 +3774,7 @@ func testAccCheckInstanceDestroy(s *terraform.State) error {
 }
 
 func testAccCheckInstanceDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_instance" {
@@ -3821,7 +3821,7 @@ func testAccCheckInstanceExistsWithProvider(n string, i *ec2.Instance, providerF
 
 		provider := providerF()
 
-		conn := provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := provider.Meta().(*conns.AWSClient).EC2Conn()
 		instance, err := tfec2.InstanceFindByID(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -3838,7 +3838,7 @@ func testAccCheckInstanceExistsWithProvider(n string, i *ec2.Instance, providerF
 
 func testAccCheckStopInstance(instance *ec2.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		params := &ec2.StopInstancesInput{
 			InstanceIds: []*string{instance.InstanceId},
@@ -3901,7 +3901,7 @@ func TestInstanceCpuThreadsPerCoreSchema(t *testing.T) {
 
 func driftTags(instance *ec2.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		_, err := conn.CreateTags(&ec2.CreateTagsInput{
 			Resources: []*string{instance.InstanceId},
 			Tags: []*ec2.Tag{
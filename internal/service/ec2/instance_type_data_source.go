@@ -344,7 +344,7 @@ func DataSourceInstanceType() *schema.Resource {
 }
 
 func dataSourceInstanceTypeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	params := &ec2.DescribeInstanceTypesInput{}
 
@@ -199,7 +199,7 @@ func TestAccEC2KeyPair_disappears(t *testing.T) {
 }
 
 func testAccCheckKeyPairDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_key_pair" {
@@ -245,7 +245,7 @@ func testAccCheckKeyPairExists(n string, res *ec2.KeyPairInfo) resource.TestChec
 			return fmt.Errorf("No KeyPair name is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		resp, err := conn.DescribeKeyPairs(&ec2.DescribeKeyPairsInput{
 			KeyNames: []*string{aws.String(rs.Primary.ID)},
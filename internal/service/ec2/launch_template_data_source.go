@@ -418,7 +418,7 @@ func DataSourceLaunchTemplate() *schema.Resource {
 }
 
 func dataSourceLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	filters, filtersOk := d.GetOk("filter")
@@ -92,7 +92,7 @@ func ResourceManagedPrefixList() *schema.Resource {
 }
 
 func resourceManagedPrefixListCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -135,7 +135,7 @@ func resourceManagedPrefixListCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceManagedPrefixListRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -171,7 +171,7 @@ func resourceManagedPrefixListRead(d *schema.ResourceData, meta interface{}) err
 	tags := KeyValueTags(pl.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -185,7 +185,7 @@ func resourceManagedPrefixListRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceManagedPrefixListUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		input := &ec2.ModifyManagedPrefixListInput{
@@ -302,7 +302,7 @@ func resourceManagedPrefixListUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceManagedPrefixListDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[INFO] Deleting EC2 Managed Prefix List: %s", d.Id())
 	_, err := conn.DeleteManagedPrefixList(&ec2.DeleteManagedPrefixListInput{
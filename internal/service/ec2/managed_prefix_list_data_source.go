@@ -68,7 +68,7 @@ func DataSourceManagedPrefixList() *schema.Resource {
 }
 
 func dataSourceManagedPrefixListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	input := ec2.DescribeManagedPrefixListsInput{}
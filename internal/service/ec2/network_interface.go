@@ -149,7 +149,7 @@ func ResourceNetworkInterface() *schema.Resource {
 
 func resourceNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -229,7 +229,7 @@ func resourceNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -292,7 +292,7 @@ func resourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) erro
 	tags := KeyValueTags(eni.TagSet).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -331,7 +331,7 @@ func resourceNetworkInterfaceDetach(oa *schema.Set, meta interface{}, eniId stri
 			AttachmentId: aws.String(old_attachment["attachment_id"].(string)),
 			Force:        aws.Bool(true),
 		}
-		conn := meta.(*conns.AWSClient).EC2Conn
+		conn := meta.(*conns.AWSClient).EC2Conn()
 		_, detach_err := conn.DetachNetworkInterface(detach_request)
 		if detach_err != nil {
 			if !tfawserr.ErrMessageContains(detach_err, "InvalidAttachmentID.NotFound", "") {
@@ -356,7 +356,7 @@ func resourceNetworkInterfaceDetach(oa *schema.Set, meta interface{}, eniId stri
 }
 
 func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("attachment") {
 		oa, na := d.GetChange("attachment")
@@ -582,7 +582,7 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("[INFO] Deleting ENI: %s", d.Id())
 
@@ -52,7 +52,7 @@ func ResourceNetworkInterfaceAttachment() *schema.Resource {
 }
 
 func resourceNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	device_index := d.Get("device_index").(int)
 	instance_id := d.Get("instance_id").(string)
@@ -94,7 +94,7 @@ func resourceNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	interfaceId := d.Get("network_interface_id").(string)
 
@@ -134,7 +134,7 @@ func resourceNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceNetworkInterfaceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	interfaceId := d.Get("network_interface_id").(string)
 
@@ -42,7 +42,7 @@ func resourceNetworkInterfaceSGAttachmentCreate(d *schema.ResourceData, meta int
 	sgID := d.Get("security_group_id").(string)
 	interfaceID := d.Get("network_interface_id").(string)
 
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	iface, err := FindNetworkInterfaceByID(conn, interfaceID)
 
@@ -86,7 +86,7 @@ func resourceNetworkInterfaceSGAttachmentRead(d *schema.ResourceData, meta inter
 
 	log.Printf("[DEBUG] Checking association of security group %s to network interface ID %s", sgID, interfaceID)
 
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	var groupIdentifier *ec2.GroupIdentifier
 
@@ -152,7 +152,7 @@ func resourceNetworkInterfaceSGAttachmentDelete(d *schema.ResourceData, meta int
 
 	log.Printf("[DEBUG] Removing security group %s from interface ID %s", sgID, interfaceID)
 
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	iface, err := FindNetworkInterfaceByID(conn, interfaceID)
 
@@ -158,7 +158,7 @@ func testAccCheckNetworkInterfaceSGAttachmentExists(resourceName string) resourc
 			return fmt.Errorf("No resource ID set: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		networkInterfaceID := rs.Primary.Attributes["network_interface_id"]
 		securityGroupID := rs.Primary.Attributes["security_group_id"]
 
@@ -177,7 +177,7 @@ func testAccCheckNetworkInterfaceSGAttachmentExists(resourceName string) resourc
 }
 
 func testAccCheckNetworkInterfaceSGAttachmentDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_network_interface_sg_attachment" {
@@ -655,7 +655,7 @@ func TestAccEC2SecurityGroupRule_prefixListEgress(t *testing.T) {
 	// This function creates the expected IPPermission with the prefix list ID from
 	// the VPC Endpoint created in the test
 	setupSG := func(*terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		prefixListInput := &ec2.DescribePrefixListsInput{
 			Filters: []*ec2.Filter{
 				{Name: aws.String("prefix-list-name"), Values: []*string{endpoint.ServiceName}},
@@ -1055,7 +1055,7 @@ func TestAccEC2SecurityGroupRule_multiDescription(t *testing.T) {
 	// This function creates the expected IPPermission with the prefix list ID from
 	// the VPC Endpoint created in the test
 	setupPL := func(*terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		prefixListInput := &ec2.DescribePrefixListsInput{
 			Filters: []*ec2.Filter{
 				{Name: aws.String("prefix-list-name"), Values: []*string{endpoint.ServiceName}},
@@ -1178,7 +1178,7 @@ func TestAccEC2SecurityGroupRule_multiDescription(t *testing.T) {
 }
 
 func testAccCheckSecurityGroupRuleDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_security_group" {
@@ -1210,7 +1210,7 @@ func testAccCheckSecurityGroupRuleExists(n string, group *ec2.SecurityGroup) res
 			return fmt.Errorf("No Security Group is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		sg, err := tfec2.FindSecurityGroupByID(conn, rs.Primary.ID)
 		if err != nil {
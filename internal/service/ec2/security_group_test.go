@@ -1980,7 +1980,7 @@ func testAddRuleCycle(primary, secondary *ec2.SecurityGroup) resource.TestCheckF
 			return fmt.Errorf("Secondary SG not set for TestAccAWSSecurityGroup_forceRevokeRules_should_fail")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		// cycle from primary to secondary
 		perm1 := cycleIpPermForGroup(*secondary.GroupId)
@@ -2020,7 +2020,7 @@ func testRemoveRuleCycle(primary, secondary *ec2.SecurityGroup) resource.TestChe
 			return fmt.Errorf("Secondary SG not set for TestAccAWSSecurityGroup_forceRevokeRules_should_fail")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 		for _, sg := range []*ec2.SecurityGroup{primary, secondary} {
 			var err error
 			if sg.IpPermissions != nil {
@@ -2050,7 +2050,7 @@ func testRemoveRuleCycle(primary, secondary *ec2.SecurityGroup) resource.TestChe
 }
 
 func testAccCheckSecurityGroupDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_security_group" {
@@ -2072,7 +2072,7 @@ func testAccCheckSecurityGroupDestroy(s *terraform.State) error {
 }
 
 func testAccCheckSecurityGroupClassicDestroy(s *terraform.State) error {
-	conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_security_group" {
@@ -2104,7 +2104,7 @@ func testAccCheckSecurityGroupExists(n string, group *ec2.SecurityGroup) resourc
 			return fmt.Errorf("No Security Group is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		sg, err := tfec2.FindSecurityGroupByID(conn, rs.Primary.ID)
 		if tfresource.NotFound(err) {
@@ -2131,7 +2131,7 @@ func testAccCheckSecurityGroupClassicExists(n string, group *ec2.SecurityGroup)
 			return fmt.Errorf("No Security Group is set")
 		}
 
-		conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.ProviderEC2Classic.Meta().(*conns.AWSClient).EC2Conn()
 
 		sg, err := tfec2.FindSecurityGroupByID(conn, rs.Primary.ID)
 		if tfresource.NotFound(err) {
@@ -2394,7 +2394,7 @@ func testAccCheckSecurityGroupExistsWithoutDefault(n string) resource.TestCheckF
 			return fmt.Errorf("No Security Group is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		group, err := tfec2.FindSecurityGroupByID(conn, rs.Primary.ID)
 		if tfresource.NotFound(err) {
@@ -2518,7 +2518,7 @@ func TestAccEC2SecurityGroup_ruleLimitCIDRBlockExceededAppend(t *testing.T) {
 
 					id := aws.StringValue(group.GroupId)
 
-					conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+					conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 					match, err := tfec2.FindSecurityGroupByID(conn, id)
 					if tfresource.NotFound(err) {
@@ -2673,7 +2673,7 @@ func testAccCheckSecurityGroupRuleCount(group *ec2.SecurityGroup, expectedIngres
 }
 
 func testSecurityGroupRuleCount(id string, expectedIngressCount, expectedEgressCount int) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	group, err := tfec2.FindSecurityGroupByID(conn, id)
 	if tfresource.NotFound(err) {
@@ -279,7 +279,7 @@ func sweepCapacityReservations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	resp, err := conn.DescribeCapacityReservations(&ec2.DescribeCapacityReservationsInput{})
 
@@ -323,7 +323,7 @@ func sweepCarrierGateway(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeCarrierGatewaysInput{}
 	var sweeperErrs *multierror.Error
 
@@ -367,7 +367,7 @@ func sweepClientVPNEndpoints(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -409,7 +409,7 @@ func sweepClientVPNNetworkAssociations(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -474,7 +474,7 @@ func sweepEBSVolumes(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	err = conn.DescribeVolumesPages(&ec2.DescribeVolumesInput{}, func(page *ec2.DescribeVolumesOutput, lastPage bool) bool {
 		for _, volume := range page.Volumes {
@@ -517,7 +517,7 @@ func sweepEgressOnlyInternetGateways(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeEgressOnlyInternetGatewaysInput{}
 	err = conn.DescribeEgressOnlyInternetGatewaysPages(input, func(page *ec2.DescribeEgressOnlyInternetGatewaysOutput, lastPage bool) bool {
@@ -562,7 +562,7 @@ func sweepEIPs(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	// There is currently no paginator or Marker/NextToken
 	input := &ec2.DescribeAddressesInput{}
@@ -622,7 +622,7 @@ func sweepFlowLogs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeFlowLogsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -665,7 +665,7 @@ func sweepHosts(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeHostsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -710,7 +710,7 @@ func sweepInstances(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -764,7 +764,7 @@ func sweepInternetGateways(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	req := &ec2.DescribeInternetGatewaysInput{}
 	resp, err := conn.DescribeInternetGateways(req)
@@ -859,7 +859,7 @@ func sweepKeyPairs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	log.Printf("Destroying the tmp keys in (%s)", client.(*conns.AWSClient).Region)
 
@@ -892,7 +892,7 @@ func sweepLaunchTemplates(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeLaunchTemplatesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -938,7 +938,7 @@ func sweepNatGateways(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	req := &ec2.DescribeNatGatewaysInput{}
 	resp, err := conn.DescribeNatGateways(req)
@@ -974,7 +974,7 @@ func sweepNetworkACLs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	req := &ec2.DescribeNetworkAclsInput{}
 	resp, err := conn.DescribeNetworkAcls(req)
@@ -1056,7 +1056,7 @@ func sweepNetworkInterfaces(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	err = conn.DescribeNetworkInterfacesPages(&ec2.DescribeNetworkInterfacesInput{}, func(page *ec2.DescribeNetworkInterfacesOutput, lastPage bool) bool {
 		if page == nil {
@@ -1101,7 +1101,7 @@ func sweepPlacementGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribePlacementGroupsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -1140,7 +1140,7 @@ func sweepRouteTables(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -1257,7 +1257,7 @@ func sweepSecurityGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeSecurityGroupsInput{}
 
@@ -1350,7 +1350,7 @@ func sweepSpotFleetRequests(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -1401,7 +1401,7 @@ func sweepSubnets(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -1455,7 +1455,7 @@ func sweepTransitGatewayPeeringAttachments(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeTransitGatewayPeeringAttachmentsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -1513,7 +1513,7 @@ func sweepTransitGateways(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeTransitGatewaysInput{}
 
 	for {
@@ -1594,7 +1594,7 @@ func sweepTransitGatewayVPCAttachments(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeTransitGatewayAttachmentsInput{}
 
 	for {
@@ -1655,7 +1655,7 @@ func sweepVPCDHCPOptions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DescribeDhcpOptionsInput{}
 
@@ -1720,7 +1720,7 @@ func sweepVPCEndpointServices(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -1780,7 +1780,7 @@ func sweepVPCEndpoints(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 
 	var sweeperErrs *multierror.Error
 
@@ -1839,7 +1839,7 @@ func sweepVPCPeeringConnections(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeVpcPeeringConnectionsInput{}
 
 	err = conn.DescribeVpcPeeringConnectionsPages(input, func(page *ec2.DescribeVpcPeeringConnectionsOutput, lastPage bool) bool {
@@ -1904,7 +1904,7 @@ func sweepVPCs(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -1958,7 +1958,7 @@ func sweepVPNConnections(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	input := &ec2.DescribeVpnConnectionsInput{}
 
 	// DescribeVpnConnections does not currently have any form of pagination
@@ -2008,7 +2008,7 @@ func sweepVPNGateways(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).EC2Conn
+	conn := client.(*conns.AWSClient).EC2Conn()
 	var sweeperErrs *multierror.Error
 
 	req := &ec2.DescribeVpnGatewaysInput{}
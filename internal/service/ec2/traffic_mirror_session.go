@@ -74,7 +74,7 @@ func ResourceTrafficMirrorSession() *schema.Resource {
 }
 
 func resourceTrafficMirrorSessionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -114,7 +114,7 @@ func resourceTrafficMirrorSessionCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceTrafficMirrorSessionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	sessionId := d.Id()
 	input := &ec2.ModifyTrafficMirrorSessionInput{
@@ -188,7 +188,7 @@ func resourceTrafficMirrorSessionUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceTrafficMirrorSessionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -229,7 +229,7 @@ func resourceTrafficMirrorSessionRead(d *schema.ResourceData, meta interface{})
 	tags := KeyValueTags(session.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -251,7 +251,7 @@ func resourceTrafficMirrorSessionRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceTrafficMirrorSessionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	sessionId := d.Id()
 	input := &ec2.DeleteTrafficMirrorSessionInput{
@@ -121,7 +121,7 @@ func ResourceTransitGateway() *schema.Resource {
 }
 
 func resourceTransitGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -160,7 +160,7 @@ func resourceTransitGatewayCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceTransitGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -206,7 +206,7 @@ func resourceTransitGatewayRead(d *schema.ResourceData, meta interface{}) error
 	tags := KeyValueTags(transitGateway.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -220,7 +220,7 @@ func resourceTransitGatewayRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	modifyTransitGatewayInput := &ec2.ModifyTransitGatewayInput{}
 	transitGatewayModified := false
@@ -276,7 +276,7 @@ func resourceTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceTransitGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	input := &ec2.DeleteTransitGatewayInput{
 		TransitGatewayId: aws.String(d.Id()),
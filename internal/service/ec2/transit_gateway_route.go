@@ -56,7 +56,7 @@ func ResourceTransitGatewayRoute() *schema.Resource {
 }
 
 func resourceTransitGatewayRouteCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	destination := d.Get("destination_cidr_block").(string)
 	transitGatewayRouteTableID := d.Get("transit_gateway_route_table_id").(string)
@@ -80,7 +80,7 @@ func resourceTransitGatewayRouteCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceTransitGatewayRouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	transitGatewayRouteTableID, destination, err := DecodeTransitGatewayRouteID(d.Id())
 	if err != nil {
@@ -152,7 +152,7 @@ func resourceTransitGatewayRouteRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceTransitGatewayRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	transitGatewayRouteTableID, destination, err := DecodeTransitGatewayRouteID(d.Id())
 	if err != nil {
@@ -129,7 +129,7 @@ func testAccDHCPOptionsAssociationVPCImportIdFunc(resourceName string) resource.
 }
 
 func testAccCheckDHCPOptionsAssociationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_dhcp_options_association" {
@@ -119,7 +119,7 @@ func DataSourceVPCEndpoint() *schema.Resource {
 }
 
 func dataSourceVPCEndpointRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	req := &ec2.DescribeVpcEndpointsInput{}
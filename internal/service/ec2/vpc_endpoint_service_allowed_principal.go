@@ -34,7 +34,7 @@ func ResourceVPCEndpointServiceAllowedPrincipal() *schema.Resource {
 }
 
 func resourceVPCEndpointServiceAllowedPrincipalCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	svcId := d.Get("vpc_endpoint_service_id").(string)
 	arn := d.Get("principal_arn").(string)
@@ -58,7 +58,7 @@ func resourceVPCEndpointServiceAllowedPrincipalCreate(d *schema.ResourceData, me
 }
 
 func resourceVPCEndpointServiceAllowedPrincipalRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	svcId := d.Get("vpc_endpoint_service_id").(string)
 	arn := d.Get("principal_arn").(string)
@@ -91,7 +91,7 @@ func resourceVPCEndpointServiceAllowedPrincipalRead(d *schema.ResourceData, meta
 }
 
 func resourceVPCEndpointServiceAllowedPrincipalDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	svcId := d.Get("vpc_endpoint_service_id").(string)
 	arn := d.Get("principal_arn").(string)
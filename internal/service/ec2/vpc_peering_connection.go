@@ -74,7 +74,7 @@ func ResourceVPCPeeringConnection() *schema.Resource {
 }
 
 func resourceVPCPeeringCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -121,7 +121,7 @@ func resourceVPCPeeringRead(d *schema.ResourceData, meta interface{}) error {
 	defaultTagsConfig := client.DefaultTagsConfig
 	ignoreTagsConfig := client.IgnoreTagsConfig
 
-	pcRaw, statusCode, err := vpcPeeringConnectionRefreshState(client.EC2Conn, d.Id())()
+	pcRaw, statusCode, err := vpcPeeringConnectionRefreshState(client.EC2Conn(), d.Id())()
 	// Allow a failed VPC Peering Connection to fallthrough,
 	// to allow rest of the logic below to do its work.
 	if err != nil && statusCode != ec2.VpcPeeringConnectionStateReasonCodeFailed {
@@ -176,7 +176,7 @@ func resourceVPCPeeringRead(d *schema.ResourceData, meta interface{}) error {
 	tags := KeyValueTags(pc.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -206,7 +206,7 @@ func resourceVPCPeeringConnectionAccept(conn *ec2.EC2, id string) (string, error
 }
 
 func resourceVPCPeeringUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	if d.HasChange("tags_all") && !d.IsNewResource() {
 		o, n := d.GetChange("tags_all")
@@ -270,7 +270,7 @@ func resourceVPCPeeringUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceVPCPeeringDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	req := &ec2.DeleteVpcPeeringConnectionInput{
 		VpcPeeringConnectionId: aws.String(d.Id()),
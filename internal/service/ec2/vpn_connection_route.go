@@ -39,7 +39,7 @@ func ResourceVPNConnectionRoute() *schema.Resource {
 }
 
 func resourceVPNConnectionRouteCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	cidrBlock := d.Get("destination_cidr_block").(string)
 	vpnConnectionId := d.Get("vpn_connection_id").(string)
@@ -79,7 +79,7 @@ func resourceVPNConnectionRouteCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceVPNConnectionRouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	cidrBlock, vpnConnectionId := VPNConnectionRouteParseID(d.Id())
 
@@ -96,7 +96,7 @@ func resourceVPNConnectionRouteRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceVPNConnectionRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+	conn := meta.(*conns.AWSClient).EC2Conn()
 
 	cidrBlock := d.Get("destination_cidr_block").(string)
 	vpnConnectionId := d.Get("vpn_connection_id").(string)
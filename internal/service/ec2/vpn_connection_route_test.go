@@ -40,7 +40,7 @@ func TestAccEC2VPNConnectionRoute_basic(t *testing.T) {
 }
 
 func testAccVPNConnectionRouteDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpn_connection_route" {
 			continue
@@ -121,7 +121,7 @@ func testAccVPNConnectionRoute(vpnConnectionRouteResource string) resource.TestC
 			},
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 		_, err := conn.DescribeVpnConnections(&ec2.DescribeVpnConnectionsInput{
 			Filters: routeFilters,
@@ -67,6 +67,50 @@ func TestAccECRReplicationConfiguration_basic(t *testing.T) {
 	})
 }
 
+func TestAccECRReplicationConfiguration_repositoryFilter(t *testing.T) {
+	resourceName := "aws_ecr_replication_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecr.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckReplicationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicationConfigurationRepositoryFilter(acctest.AlternateRegion(), "a-prefix"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.repository_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.repository_filter.0.filter", "a-prefix"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.repository_filter.0.filter_type", "PREFIX_MATCH"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccReplicationConfigurationRepositoryFilter(acctest.AlternateRegion(), "another-prefix"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.repository_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.repository_filter.0.filter", "another-prefix"),
+				),
+			},
+			{
+				Config: testAccReplicationConfiguration(acctest.AlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.0.repository_filter.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckReplicationConfigurationExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[name]
@@ -74,7 +118,7 @@ func testAccCheckReplicationConfigurationExists(name string) resource.TestCheckF
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn()
 		out, err := conn.DescribeRegistry(&ecr.DescribeRegistryInput{})
 		if err != nil {
 			return fmt.Errorf("ECR replication rules not found: %w", err)
@@ -89,7 +133,7 @@ func testAccCheckReplicationConfigurationExists(name string) resource.TestCheckF
 }
 
 func testAccCheckReplicationConfigurationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecr_replication_configuration" {
@@ -148,3 +192,25 @@ resource "aws_ecr_replication_configuration" "test" {
 }
 `, region1, region2)
 }
+
+func testAccReplicationConfigurationRepositoryFilter(region, filter string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_ecr_replication_configuration" "test" {
+  replication_configuration {
+    rule {
+      destination {
+        region      = %[1]q
+        registry_id = data.aws_caller_identity.current.account_id
+      }
+
+      repository_filter {
+        filter      = %[2]q
+        filter_type = "PREFIX_MATCH"
+      }
+    }
+  }
+}
+`, region, filter)
+}
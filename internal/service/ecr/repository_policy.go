@@ -47,7 +47,7 @@ func ResourceRepositoryPolicy() *schema.Resource {
 }
 
 func resourceRepositoryPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECRConn
+	conn := meta.(*conns.AWSClient).ECRConn()
 
 	input := ecr.SetRepositoryPolicyInput{
 		RepositoryName: aws.String(d.Get("repository").(string)),
@@ -85,7 +85,7 @@ func resourceRepositoryPolicyPut(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceRepositoryPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECRConn
+	conn := meta.(*conns.AWSClient).ECRConn()
 
 	input := &ecr.GetRepositoryPolicyInput{
 		RepositoryName: aws.String(d.Id()),
@@ -147,7 +147,7 @@ func resourceRepositoryPolicyRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceRepositoryPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECRConn
+	conn := meta.(*conns.AWSClient).ECRConn()
 
 	_, err := conn.DeleteRepositoryPolicy(&ecr.DeleteRepositoryPolicyInput{
 		RepositoryName: aws.String(d.Id()),
@@ -406,7 +406,7 @@ func resourceServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.
 }
 
 func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECSConn
+	conn := meta.(*conns.AWSClient).ECSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -592,7 +592,7 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECSConn
+	conn := meta.(*conns.AWSClient).ECSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -732,7 +732,7 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 	tags := KeyValueTags(service.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -1010,7 +1010,7 @@ func flattenServiceRegistries(srs []*ecs.ServiceRegistry) []map[string]interface
 }
 
 func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECSConn
+	conn := meta.(*conns.AWSClient).ECSConn()
 	updateService := false
 
 	input := ecs.UpdateServiceInput{
@@ -1174,7 +1174,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ECSConn
+	conn := meta.(*conns.AWSClient).ECSConn()
 
 	// Check if it's not already gone
 	output, err := conn.DescribeServices(&ecs.DescribeServicesInput{
@@ -1155,7 +1155,7 @@ func TestAccECSService_executeCommand(t *testing.T) {
 }
 
 func testAccCheckServiceDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecs_service" {
@@ -1197,7 +1197,7 @@ func testAccCheckServiceExists(name string, service *ecs.Service) resource.TestC
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn()
 
 		input := &ecs.DescribeServicesInput{
 			Cluster:  aws.String(rs.Primary.Attributes["cluster"]),
@@ -161,7 +161,7 @@ func TestAccElasticSearchDomainSamlOptions_SAML_disabled(t *testing.T) {
 }
 
 func testAccCheckESDomainSAMLOptionsDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticsearch_domain_saml_options" {
@@ -205,7 +205,7 @@ func testAccCheckESDomainSAMLOptions(esResource string, samlOptionsResource stri
 			return fmt.Errorf("Not found: %s", samlOptionsResource)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn()
 		_, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(options.Primary.Attributes["domain_name"]),
 		})
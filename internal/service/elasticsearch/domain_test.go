@@ -305,7 +305,7 @@ func TestAccElasticSearchDomain_duplicate(t *testing.T) {
 		ErrorCheck: acctest.ErrorCheck(t, elasticsearch.EndpointsID),
 		Providers:  acctest.Providers,
 		CheckDestroy: func(s *terraform.State) error {
-			conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn
+			conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn()
 			_, err := conn.DeleteElasticsearchDomain(&elasticsearch.DeleteElasticsearchDomainInput{
 				DomainName: aws.String(resourceId),
 			})
@@ -315,7 +315,7 @@ func TestAccElasticSearchDomain_duplicate(t *testing.T) {
 			{
 				PreConfig: func() {
 					// Create duplicate
-					conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn
+					conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn()
 					_, err := conn.CreateElasticsearchDomain(&elasticsearch.CreateElasticsearchDomainInput{
 						DomainName: aws.String(resourceId),
 						EBSOptions: &elasticsearch.EBSOptions{
@@ -1275,7 +1275,7 @@ func testAccCheckESDomainExists(n string, domain *elasticsearch.ElasticsearchDom
 			return fmt.Errorf("No ES Domain ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn()
 		opts := &elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(rs.Primary.Attributes["domain_name"]),
 		}
@@ -1293,7 +1293,7 @@ func testAccCheckESDomainExists(n string, domain *elasticsearch.ElasticsearchDom
 
 func testAccCheckESDomainNotRecreated(i, j *elasticsearch.ElasticsearchDomainStatus) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn()
 
 		iConfig, err := conn.DescribeElasticsearchDomainConfig(&elasticsearch.DescribeElasticsearchDomainConfigInput{
 			DomainName: i.DomainName,
@@ -1322,7 +1322,7 @@ func testAccCheckESDomainDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticSearchConn()
 		opts := &elasticsearch.DescribeElasticsearchDomainInput{
 			DomainName: aws.String(rs.Primary.Attributes["domain_name"]),
 		}
@@ -1340,7 +1340,7 @@ func testAccCheckESDomainDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheckIamServiceLinkedRoleEs(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 	dnsSuffix := acctest.Provider.Meta().(*conns.AWSClient).DNSSuffix
 
 	input := &iam.ListRolesInput{
@@ -2427,7 +2427,7 @@ resource "aws_elasticsearch_domain" "test" {
 }
 
 func testAccPreCheckCognitoIdentityProvider(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPConn()
 
 	input := &cognitoidentityprovider.ListUserPoolsInput{
 		MaxResults: aws.Int64(int64(1)),
@@ -2445,7 +2445,7 @@ func testAccPreCheckCognitoIdentityProvider(t *testing.T) {
 }
 
 func testAccCheckELBDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elb" {
@@ -60,7 +60,7 @@ func TestAccELBAttachment_drift(t *testing.T) {
 	resourceName := "aws_elb.test"
 
 	deregInstance := func() {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBConn()
 
 		deRegisterInstancesOpts := elb.DeregisterInstancesFromLoadBalancerInput{
 			LoadBalancerName: conf.LoadBalancerName,
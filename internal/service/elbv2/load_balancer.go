@@ -264,7 +264,7 @@ func suppressIfLBType(t string) schema.SchemaDiffSuppressFunc {
 }
 
 func resourceLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ELBV2Conn
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -355,7 +355,7 @@ func resourceLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ELBV2Conn
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
 
 	lb, err := FindLoadBalancerByARN(conn, d.Id())
 
@@ -383,7 +383,7 @@ func resourceLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ELBV2Conn
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -551,7 +551,7 @@ func resourceLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ELBV2Conn
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
 
 	log.Printf("[INFO] Deleting LB: %s", d.Id())
 
@@ -563,7 +563,7 @@ func resourceLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error deleting LB: %w", err)
 	}
 
-	ec2conn := meta.(*conns.AWSClient).EC2Conn
+	ec2conn := meta.(*conns.AWSClient).EC2Conn()
 
 	err := cleanupLBNetworkInterfaces(ec2conn, d.Id())
 	if err != nil {
@@ -739,7 +739,7 @@ func SuffixFromARN(arn *string) string {
 
 // flattenResource takes a *elbv2.LoadBalancer and populates all respective resource fields.
 func flattenResource(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBalancer) error {
-	conn := meta.(*conns.AWSClient).ELBV2Conn
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -772,7 +772,7 @@ func flattenResource(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBal
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
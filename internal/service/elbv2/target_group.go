@@ -136,8 +136,15 @@ func ResourceTargetGroup() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{
 					"round_robin",
 					"least_outstanding_requests",
+					"weighted_random",
 				}, false),
 			},
+			"load_balancing_anomaly_mitigation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"on", "off"}, false),
+			},
 			"name": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -261,6 +268,60 @@ func ResourceTargetGroup() *schema.Resource {
 					},
 				},
 			},
+			"target_group_health": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_failover": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"minimum_healthy_targets_count": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "1",
+										ValidateFunc: validTargetGroupHealthMinimumHealthyTargetsCount,
+									},
+									"minimum_healthy_targets_percentage": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "off",
+										ValidateFunc: validTargetGroupHealthMinimumHealthyTargetsPercentage,
+									},
+								},
+							},
+						},
+						"unhealthy_state_routing": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"minimum_healthy_targets_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"minimum_healthy_targets_percentage": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "off",
+										ValidateFunc: validTargetGroupHealthMinimumHealthyTargetsPercentage,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"target_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -286,7 +347,7 @@ func suppressIfTargetType(t string) schema.SchemaDiffSuppressFunc {
 }
 
 func resourceTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ELBV2Conn
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -423,6 +484,13 @@ func resourceTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
 			})
 		}
 
+		if v, ok := d.GetOk("load_balancing_anomaly_mitigation"); ok {
+			attrs = append(attrs, &elbv2.TargetGroupAttribute{
+				Key:   aws.String("load_balancing.algorithm.anomaly_mitigation"),
+				Value: aws.String(v.(string)),
+			})
+		}
+
 		if v, ok := d.GetOk("preserve_client_ip"); ok {
 			attrs = append(attrs, &elbv2.TargetGroupAttribute{
 				Key:   aws.String("preserve_client_ip.enabled"),
@@ -444,6 +512,10 @@ func resourceTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
 			})
 		}
 
+		if v, ok := d.GetOk("target_group_health"); ok && len(v.([]interface{})) > 0 {
+			attrs = append(attrs, expandTargetGroupHealthAttributes(v.([]interface{}))...)
+		}
+
 		if v, ok := d.Get("protocol").(string); ok && v != elbv2.ProtocolEnumGeneve {
 			if v, ok := d.GetOk("stickiness"); ok && len(v.([]interface{})) > 0 {
 				stickinessBlocks := v.([]interface{})
@@ -514,7 +586,7 @@ func resourceTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ELBV2Conn
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
 
 	var targetGroup *elbv2.TargetGroup
 
@@ -568,7 +640,7 @@ func resourceTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTargetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ELBV2Conn
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -737,6 +809,17 @@ func resourceTargetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 				Value: aws.String(d.Get("load_balancing_algorithm_type").(string)),
 			})
 		}
+
+		if d.HasChange("load_balancing_anomaly_mitigation") {
+			attrs = append(attrs, &elbv2.TargetGroupAttribute{
+				Key:   aws.String("load_balancing.algorithm.anomaly_mitigation"),
+				Value: aws.String(d.Get("load_balancing_anomaly_mitigation").(string)),
+			})
+		}
+
+		if d.HasChange("target_group_health") {
+			attrs = append(attrs, expandTargetGroupHealthAttributes(d.Get("target_group_health").([]interface{}))...)
+		}
 	case elbv2.TargetTypeEnumLambda:
 		if d.HasChange("lambda_multi_value_headers_enabled") {
 			attrs = append(attrs, &elbv2.TargetGroupAttribute{
@@ -762,7 +845,7 @@ func resourceTargetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceTargetGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ELBV2Conn
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
 
 	input := &elbv2.DeleteTargetGroupInput{
 		TargetGroupArn: aws.String(d.Id()),
@@ -855,7 +938,7 @@ func TargetGroupSuffixFromARN(arn *string) string {
 
 // flattenTargetGroupResource takes a *elbv2.TargetGroup and populates all respective resource fields.
 func flattenTargetGroupResource(d *schema.ResourceData, meta interface{}, targetGroup *elbv2.TargetGroup) error {
-	conn := meta.(*conns.AWSClient).ELBV2Conn
+	conn := meta.(*conns.AWSClient).ELBV2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -911,6 +994,8 @@ func flattenTargetGroupResource(d *schema.ResourceData, meta interface{}, target
 		case "load_balancing.algorithm.type":
 			loadBalancingAlgorithm := aws.StringValue(attr.Value)
 			d.Set("load_balancing_algorithm_type", loadBalancingAlgorithm)
+		case "load_balancing.algorithm.anomaly_mitigation":
+			d.Set("load_balancing_anomaly_mitigation", attr.Value)
 		case "preserve_client_ip.enabled":
 			_, err := strconv.ParseBool(aws.StringValue(attr.Value))
 			if err != nil {
@@ -929,6 +1014,15 @@ func flattenTargetGroupResource(d *schema.ResourceData, meta interface{}, target
 		return fmt.Errorf("error setting stickiness: %w", err)
 	}
 
+	targetGroupHealthAttr, err := flattenTargetGroupHealth(attrResp.Attributes)
+	if err != nil {
+		return fmt.Errorf("error flattening target_group_health: %w", err)
+	}
+
+	if err := d.Set("target_group_health", targetGroupHealthAttr); err != nil {
+		return fmt.Errorf("error setting target_group_health: %w", err)
+	}
+
 	tags, err := ListTags(conn, d.Id())
 
 	if err != nil {
@@ -938,7 +1032,7 @@ func flattenTargetGroupResource(d *schema.ResourceData, meta interface{}, target
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -990,6 +1084,83 @@ func flattenTargetGroupStickiness(attributes []*elbv2.TargetGroupAttribute) ([]i
 	return []interface{}{m}, nil
 }
 
+func expandTargetGroupHealthAttributes(l []interface{}) []*elbv2.TargetGroupAttribute {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	var attrs []*elbv2.TargetGroupAttribute
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["dns_failover"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		dnsFailover := v[0].(map[string]interface{})
+
+		attrs = append(attrs,
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String("target_group_health.dns_failover.minimum_healthy_targets.count"),
+				Value: aws.String(dnsFailover["minimum_healthy_targets_count"].(string)),
+			},
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String("target_group_health.dns_failover.minimum_healthy_targets.percentage"),
+				Value: aws.String(dnsFailover["minimum_healthy_targets_percentage"].(string)),
+			})
+	}
+
+	if v, ok := m["unhealthy_state_routing"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		unhealthyStateRouting := v[0].(map[string]interface{})
+
+		attrs = append(attrs,
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String("target_group_health.unhealthy_state_routing.minimum_healthy_targets.count"),
+				Value: aws.String(strconv.Itoa(unhealthyStateRouting["minimum_healthy_targets_count"].(int))),
+			},
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String("target_group_health.unhealthy_state_routing.minimum_healthy_targets.percentage"),
+				Value: aws.String(unhealthyStateRouting["minimum_healthy_targets_percentage"].(string)),
+			})
+	}
+
+	return attrs
+}
+
+func flattenTargetGroupHealth(attributes []*elbv2.TargetGroupAttribute) ([]interface{}, error) {
+	dnsFailover := make(map[string]interface{})
+	unhealthyStateRouting := make(map[string]interface{})
+
+	for _, attr := range attributes {
+		switch aws.StringValue(attr.Key) {
+		case "target_group_health.dns_failover.minimum_healthy_targets.count":
+			dnsFailover["minimum_healthy_targets_count"] = aws.StringValue(attr.Value)
+		case "target_group_health.dns_failover.minimum_healthy_targets.percentage":
+			dnsFailover["minimum_healthy_targets_percentage"] = aws.StringValue(attr.Value)
+		case "target_group_health.unhealthy_state_routing.minimum_healthy_targets.count":
+			count, err := strconv.Atoi(aws.StringValue(attr.Value))
+			if err != nil {
+				return nil, fmt.Errorf("error converting target_group_health.unhealthy_state_routing.minimum_healthy_targets.count to int: %s", aws.StringValue(attr.Value))
+			}
+			unhealthyStateRouting["minimum_healthy_targets_count"] = count
+		case "target_group_health.unhealthy_state_routing.minimum_healthy_targets.percentage":
+			unhealthyStateRouting["minimum_healthy_targets_percentage"] = aws.StringValue(attr.Value)
+		}
+	}
+
+	if len(dnsFailover) == 0 && len(unhealthyStateRouting) == 0 {
+		return []interface{}{}, nil
+	}
+
+	m := make(map[string]interface{})
+
+	if len(dnsFailover) > 0 {
+		m["dns_failover"] = []interface{}{dnsFailover}
+	}
+
+	if len(unhealthyStateRouting) > 0 {
+		m["unhealthy_state_routing"] = []interface{}{unhealthyStateRouting}
+	}
+
+	return []interface{}{m}, nil
+}
+
 func resourceTargetGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 	protocol := diff.Get("protocol").(string)
 
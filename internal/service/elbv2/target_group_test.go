@@ -1947,6 +1947,78 @@ func TestAccELBV2TargetGroup_A_updateLoadBalancingAlgorithmType(t *testing.T) {
 	})
 }
 
+func TestAccELBV2TargetGroup_A_loadBalancingAnomalyMitigation(t *testing.T) {
+	var conf elbv2.TargetGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_alb_target_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elbv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckATargetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccATargetGroupConfig_loadBalancingAnomalyMitigation(rName, "on"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckATargetGroupExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "load_balancing_algorithm_type", "weighted_random"),
+					resource.TestCheckResourceAttr(resourceName, "load_balancing_anomaly_mitigation", "on"),
+				),
+			},
+			{
+				Config: testAccATargetGroupConfig_loadBalancingAnomalyMitigation(rName, "off"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckATargetGroupExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "load_balancing_algorithm_type", "weighted_random"),
+					resource.TestCheckResourceAttr(resourceName, "load_balancing_anomaly_mitigation", "off"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccELBV2TargetGroup_A_targetGroupHealth(t *testing.T) {
+	var conf elbv2.TargetGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_alb_target_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elbv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckATargetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccATargetGroupConfig_targetGroupHealth(rName, "2", "off", 1, "50"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckATargetGroupExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.dns_failover.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.dns_failover.0.minimum_healthy_targets_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.dns_failover.0.minimum_healthy_targets_percentage", "off"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.unhealthy_state_routing.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.unhealthy_state_routing.0.minimum_healthy_targets_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.unhealthy_state_routing.0.minimum_healthy_targets_percentage", "50"),
+				),
+			},
+			{
+				Config: testAccATargetGroupConfig_targetGroupHealth(rName, "off", "25", 3, "off"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckATargetGroupExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.dns_failover.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.dns_failover.0.minimum_healthy_targets_count", "off"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.dns_failover.0.minimum_healthy_targets_percentage", "25"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.unhealthy_state_routing.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.unhealthy_state_routing.0.minimum_healthy_targets_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_health.0.unhealthy_state_routing.0.minimum_healthy_targets_percentage", "off"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccELBV2TargetGroup_A_updateStickinessEnabled(t *testing.T) {
 	var conf elbv2.TargetGroup
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -3040,7 +3112,7 @@ resource "aws_lb_target_group" "test" {
 }
 
 func testAccCheckTargetGroupDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lb_target_group" && rs.Type != "aws_alb_target_group" {
@@ -3078,7 +3150,7 @@ func testAccCheckTargetGroupExists(n string, res *elbv2.TargetGroup) resource.Te
 			return errors.New("No Target Group ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Conn()
 
 		targetGroup, err := tfelbv2.FindTargetGroupByARN(conn, rs.Primary.ID)
 
@@ -3255,6 +3327,57 @@ resource "aws_vpc" "test" {
 }`, rName, algoTypeParam)
 }
 
+func testAccATargetGroupConfig_loadBalancingAnomalyMitigation(rName, anomalyMitigation string) string {
+	return fmt.Sprintf(`
+resource "aws_alb_target_group" "test" {
+  name     = %[1]q
+  port     = 443
+  protocol = "HTTPS"
+  vpc_id   = aws_vpc.test.id
+
+  load_balancing_algorithm_type     = "weighted_random"
+  load_balancing_anomaly_mitigation = %[2]q
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}`, rName, anomalyMitigation)
+}
+
+func testAccATargetGroupConfig_targetGroupHealth(rName, dnsFailoverCount, dnsFailoverPercentage string, unhealthyStateRoutingCount int, unhealthyStateRoutingPercentage string) string {
+	return fmt.Sprintf(`
+resource "aws_alb_target_group" "test" {
+  name     = %[1]q
+  port     = 443
+  protocol = "HTTPS"
+  vpc_id   = aws_vpc.test.id
+
+  target_group_health {
+    dns_failover {
+      minimum_healthy_targets_count      = %[2]q
+      minimum_healthy_targets_percentage = %[3]q
+    }
+
+    unhealthy_state_routing {
+      minimum_healthy_targets_count      = %[4]d
+      minimum_healthy_targets_percentage = %[5]q
+    }
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}`, rName, dnsFailoverCount, dnsFailoverPercentage, unhealthyStateRoutingCount, unhealthyStateRoutingPercentage)
+}
+
 func testAccATargetGroupConfig_missing_port(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_alb_target_group" "test" {
@@ -3600,7 +3723,7 @@ resource "aws_vpc" "test" {
 }
 
 func testAccCheckATargetGroupDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_alb_target_group" {
@@ -3640,7 +3763,7 @@ func testAccCheckATargetGroupExists(n string, res *elbv2.TargetGroup) resource.T
 			return errors.New("No Target Group ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ELBV2Conn()
 
 		describe, err := conn.DescribeTargetGroups(&elbv2.DescribeTargetGroupsInput{
 			TargetGroupArns: []*string{aws.String(rs.Primary.ID)},
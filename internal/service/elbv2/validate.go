@@ -3,6 +3,7 @@ package elbv2
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -88,3 +89,31 @@ func validTargetGroupNamePrefix(v interface{}, k string) (ws []string, errors []
 	}
 	return
 }
+
+func validTargetGroupHealthMinimumHealthyTargetsCount(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "off" {
+		return
+	}
+
+	if count, err := strconv.Atoi(value); err != nil || count < 1 {
+		errors = append(errors, fmt.Errorf("%q must be an integer greater than 0 or %q", k, "off"))
+	}
+
+	return
+}
+
+func validTargetGroupHealthMinimumHealthyTargetsPercentage(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "off" {
+		return
+	}
+
+	if percentage, err := strconv.Atoi(value); err != nil || percentage < 1 || percentage > 100 {
+		errors = append(errors, fmt.Errorf("%q must be an integer between 1 and 100 or %q", k, "off"))
+	}
+
+	return
+}
@@ -475,7 +475,7 @@ func TestAccEMRCluster_EC2Attributes_defaultManagedSecurityGroups(t *testing.T)
 			},
 			{
 				PreConfig: func() {
-					conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+					conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
 
 					err := testAccEmrDeleteManagedSecurityGroups(conn, &vpc)
 
@@ -1464,7 +1464,7 @@ func TestAccEMRCluster_InstanceFleetMaster_only(t *testing.T) {
 }
 
 func testAccCheckDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_emr_cluster" {
@@ -1503,7 +1503,7 @@ func testAccCheckClusterExists(n string, v *emr.Cluster) resource.TestCheckFunc
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("No cluster id set")
 		}
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn()
 		describe, err := conn.DescribeCluster(&emr.DescribeClusterInput{
 			ClusterId: aws.String(rs.Primary.ID),
 		})
@@ -1530,7 +1530,7 @@ func testAccCheckClusterExists(n string, v *emr.Cluster) resource.TestCheckFunc
 
 func testAccCheckClusterDisappears(cluster *emr.Cluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn()
 		id := aws.StringValue(cluster.Id)
 
 		terminateJobFlowsInput := &emr.TerminateJobFlowsInput{
@@ -39,7 +39,7 @@ func TestAccEMRSecurityConfiguration_basic(t *testing.T) {
 }
 
 func testAccCheckEmrSecurityConfigurationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_emr_security_configuration" {
 			continue
@@ -79,7 +79,7 @@ func testAccCheckEmrSecurityConfigurationExists(n string) resource.TestCheckFunc
 			return fmt.Errorf("No EMR Security Configuration ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn()
 		resp, err := conn.DescribeSecurityConfiguration(&emr.DescribeSecurityConfigurationInput{
 			Name: aws.String(rs.Primary.ID),
 		})
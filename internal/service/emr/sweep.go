@@ -26,7 +26,7 @@ func sweepClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).EMRConn
+	conn := client.(*conns.AWSClient).EMRConn()
 
 	input := &emr.ListClustersInput{
 		ClusterStates: []*string{
@@ -9,12 +9,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/importer"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -28,6 +30,8 @@ const (
 	firehoseDestinationTypeRedshift      = "redshift"
 	firehoseDestinationTypeSplunk        = "splunk"
 	firehoseDestinationTypeHttpEndpoint  = "http_endpoint"
+
+	firehoseServicePrincipal = "firehose.amazonaws.com"
 )
 
 func cloudWatchLoggingOptionsSchema() *schema.Schema {
@@ -797,19 +801,7 @@ func ResourceDeliveryStream() *schema.Resource {
 		Delete: resourceDeliveryStreamDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				idErr := fmt.Errorf("Expected ID in format of arn:PARTITION:firehose:REGION:ACCOUNTID:deliverystream/NAME and provided: %s", d.Id())
-				resARN, err := arn.Parse(d.Id())
-				if err != nil {
-					return nil, idErr
-				}
-				resourceParts := strings.Split(resARN.Resource, "/")
-				if len(resourceParts) != 2 {
-					return nil, idErr
-				}
-				d.Set("name", resourceParts[1])
-				return []*schema.ResourceData{d}, nil
-			},
+			State: resourceDeliveryStreamImport,
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
@@ -2465,7 +2457,7 @@ func resourceDeliveryStreamCreate(d *schema.ResourceData, meta interface{}) erro
 		return validateError
 	}
 
-	conn := meta.(*conns.AWSClient).FirehoseConn
+	conn := meta.(*conns.AWSClient).FirehoseConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -2522,6 +2514,10 @@ func resourceDeliveryStreamCreate(d *schema.ResourceData, meta interface{}) erro
 		createInput.Tags = Tags(tags.IgnoreAWS())
 	}
 
+	if err := waitDeliveryStreamRolesAssumable(meta.(*conns.AWSClient).IAMConn(), d); err != nil {
+		return fmt.Errorf("error creating Kinesis Firehose Delivery Stream (%s): %w", sn, err)
+	}
+
 	err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
 		_, err := conn.CreateDeliveryStream(createInput)
 		if err != nil {
@@ -2588,6 +2584,41 @@ func resourceDeliveryStreamCreate(d *schema.ResourceData, meta interface{}) erro
 	return resourceDeliveryStreamRead(d, meta)
 }
 
+// waitDeliveryStreamRolesAssumable waits for each new or changed IAM role
+// that Firehose assumes to trust the Firehose service principal.
+func waitDeliveryStreamRolesAssumable(conn *iam.IAM, d *schema.ResourceData) error {
+	seen := make(map[string]bool)
+
+	for _, k := range []string{
+		"kinesis_source_configuration",
+		"s3_configuration",
+		"extended_s3_configuration",
+		"redshift_configuration",
+		"elasticsearch_configuration",
+		"http_endpoint_configuration",
+	} {
+		k = k + ".0.role_arn"
+
+		if !d.HasChange(k) {
+			continue
+		}
+
+		roleARN := d.Get(k).(string)
+
+		if roleARN == "" || seen[roleARN] {
+			continue
+		}
+
+		seen[roleARN] = true
+
+		if err := tfiam.WaitRoleAssumable(conn, roleARN, firehoseServicePrincipal); err != nil {
+			return fmt.Errorf("error waiting for IAM Role (%s) to be assumable by Firehose: %w", roleARN, err)
+		}
+	}
+
+	return nil
+}
+
 func validSchema(d *schema.ResourceData) error {
 
 	_, s3Exists := d.GetOk("s3_configuration")
@@ -2626,7 +2657,7 @@ func resourceDeliveryStreamUpdate(d *schema.ResourceData, meta interface{}) erro
 		return validateError
 	}
 
-	conn := meta.(*conns.AWSClient).FirehoseConn
+	conn := meta.(*conns.AWSClient).FirehoseConn()
 
 	sn := d.Get("name").(string)
 	updateInput := &firehose.UpdateDestinationInput{
@@ -2670,6 +2701,10 @@ func resourceDeliveryStreamUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if err := waitDeliveryStreamRolesAssumable(meta.(*conns.AWSClient).IAMConn(), d); err != nil {
+		return fmt.Errorf("error updating Kinesis Firehose Delivery Stream (%s): %w", sn, err)
+	}
+
 	err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
 		_, err := conn.UpdateDestination(updateInput)
 		if err != nil {
@@ -2756,7 +2791,7 @@ func resourceDeliveryStreamUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceDeliveryStreamRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FirehoseConn
+	conn := meta.(*conns.AWSClient).FirehoseConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -2788,7 +2823,7 @@ func resourceDeliveryStreamRead(d *schema.ResourceData, meta interface{}) error
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -2800,7 +2835,7 @@ func resourceDeliveryStreamRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceDeliveryStreamDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FirehoseConn
+	conn := meta.(*conns.AWSClient).FirehoseConn()
 
 	sn := d.Get("name").(string)
 	log.Printf("[DEBUG] Deleting Kinesis Firehose Delivery Stream: (%s)", sn)
@@ -2864,3 +2899,52 @@ func expandFirehoseDeliveryStreamEncryptionConfigurationInput(tfList []interface
 
 	return apiObject
 }
+
+func resourceDeliveryStreamImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*conns.AWSClient)
+
+	var name string
+
+	fromARN := func(resARN arn.ARN) (string, error) {
+		resourceParts := strings.Split(resARN.Resource, "/")
+
+		if len(resourceParts) != 2 || resourceParts[0] != "deliverystream" || resourceParts[1] == "" {
+			return "", fmt.Errorf("expected resource deliverystream/NAME in ARN, got: %s", resARN.Resource)
+		}
+
+		name = resourceParts[1]
+
+		return resARN.String(), nil
+	}
+
+	fromName := func(id string) (string, error) {
+		if strings.ContainsAny(id, ":/") {
+			return "", fmt.Errorf("invalid name: %s", id)
+		}
+
+		if client.AccountID == "" {
+			return "", fmt.Errorf("AWS account ID unknown, import using the ARN")
+		}
+
+		name = id
+
+		return arn.ARN{
+			Partition: client.Partition,
+			Service:   firehose.ServiceName,
+			Region:    client.Region,
+			AccountID: client.AccountID,
+			Resource:  "deliverystream/" + id,
+		}.String(), nil
+	}
+
+	id, err := importer.ARNOrID(d.Id(), firehose.ServiceName, fromARN, fromName, "arn:PARTITION:firehose:REGION:ACCOUNTID:deliverystream/NAME", "NAME")
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+	d.Set("name", name)
+
+	return []*schema.ResourceData{d}, nil
+}
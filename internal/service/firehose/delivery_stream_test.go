@@ -50,18 +50,24 @@ func TestAccFirehoseDeliveryStream_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("terraform-kinesis-firehose-basictest-%d", rInt),
+				ImportStateVerify: true,
+			},
 			// Ensure we properly error on malformed import IDs
 			{
 				ResourceName:  resourceName,
 				ImportState:   true,
-				ImportStateId: "just-a-name",
-				ExpectError:   regexp.MustCompile(`Expected ID in format`),
+				ImportStateId: "not/a-name",
+				ExpectError:   regexp.MustCompile(`unexpected format of import ID`),
 			},
 			{
 				ResourceName:  resourceName,
 				ImportState:   true,
 				ImportStateId: "arn:aws:firehose:us-east-1:123456789012:missing-slash", //lintignore:AWSAT003,AWSAT005
-				ExpectError:   regexp.MustCompile(`Expected ID in format`),
+				ExpectError:   regexp.MustCompile(`unexpected format of import ID`),
 			},
 		},
 	})
@@ -509,7 +515,7 @@ func TestAccFirehoseDeliveryStream_ExtendedS3_externalUpdate(t *testing.T) {
 			},
 			{
 				PreConfig: func() {
-					conn := acctest.Provider.Meta().(*conns.AWSClient).FirehoseConn
+					conn := acctest.Provider.Meta().(*conns.AWSClient).FirehoseConn()
 					udi := firehose.UpdateDestinationInput{
 						DeliveryStreamName:             aws.String(rName),
 						DestinationId:                  aws.String("destinationId-000000000001"),
@@ -1434,7 +1440,7 @@ func testAccCheckKinesisFirehoseDeliveryStreamExists(n string, v *firehose.Deliv
 			return fmt.Errorf("No Kinesis Firehose Delivery Stream ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).FirehoseConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FirehoseConn()
 
 		sn := rs.Primary.Attributes["name"]
 		output, err := tffirehose.FindDeliveryStreamByName(conn, sn)
@@ -1638,7 +1644,7 @@ func testAccCheckKinesisFirehoseDeliveryStreamDestroy(s *terraform.State) error
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).FirehoseConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).FirehoseConn()
 
 		sn := rs.Primary.Attributes["name"]
 		_, err := tffirehose.FindDeliveryStreamByName(conn, sn)
@@ -1658,7 +1664,7 @@ func testAccCheckKinesisFirehoseDeliveryStreamDestroy(s *terraform.State) error
 }
 
 func testAccCheckFirehoseLambdaFunctionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_function" {
@@ -3339,7 +3345,7 @@ resource "aws_kinesis_firehose_delivery_stream" "test" {
 }
 
 func testAccPreCheckIamServiceLinkedRoleEs(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 	dnsSuffix := acctest.Provider.Meta().(*conns.AWSClient).DNSSuffix
 
 	input := &iam.ListRolesInput{
@@ -26,7 +26,7 @@ func sweepDeliveryStreams(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).FirehoseConn
+	conn := client.(*conns.AWSClient).FirehoseConn()
 	input := &firehose.ListDeliveryStreamsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -132,12 +132,14 @@ func ResourcePolicy() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(fms.SecurityServiceType_Values(), false),
 						},
 						"managed_service_data": {
 							Type:             schema.TypeString,
 							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
 							DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 						},
 					},
@@ -152,7 +154,7 @@ func ResourcePolicy() *schema.Resource {
 }
 
 func resourcePolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FMSConn
+	conn := meta.(*conns.AWSClient).FMSConn()
 
 	fmsPolicy := resourcePolicyExpandPolicy(d)
 
@@ -175,7 +177,7 @@ func resourcePolicyCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FMSConn
+	conn := meta.(*conns.AWSClient).FMSConn()
 
 	var resp *fms.GetPolicyOutput
 	var req = &fms.GetPolicyInput{
@@ -264,7 +266,7 @@ func resourcePolicyExpandPolicy(d *schema.ResourceData) *fms.Policy {
 }
 
 func resourcePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FMSConn
+	conn := meta.(*conns.AWSClient).FMSConn()
 
 	fmsPolicy := resourcePolicyExpandPolicy(d)
 
@@ -279,7 +281,7 @@ func resourcePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).FMSConn
+	conn := meta.(*conns.AWSClient).FMSConn()
 	log.Printf("[DEBUG] Delete FMS Policy: %s", d.Id())
 
 	_, err := conn.DeletePolicy(&fms.DeletePolicyInput{
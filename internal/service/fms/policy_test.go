@@ -2,6 +2,7 @@ package fms_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffms "github.com/hashicorp/terraform-provider-aws/internal/service/fms"
 )
 
 func testAccPolicy_basic(t *testing.T) {
@@ -179,8 +181,70 @@ func testAccPolicy_tags(t *testing.T) {
 	})
 }
 
+func TestPolicySecurityServicePolicyDataValidation(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		Type               string
+		ManagedServiceData string
+		ExpectError        *regexp.Regexp
+	}{
+		{
+			Name:               "valid",
+			Type:               fms.SecurityServiceTypeDnsFirewall,
+			ManagedServiceData: `{"type": "DNS_FIREWALL"}`,
+		},
+		{
+			Name:               "invalid type",
+			Type:               "WAF_CLASSIC",
+			ManagedServiceData: `{"type": "WAF"}`,
+			ExpectError:        regexp.MustCompile(`expected .*type to be one of`),
+		},
+		{
+			Name:               "invalid managed service data",
+			Type:               fms.SecurityServiceTypeDnsFirewall,
+			ManagedServiceData: `{"type": "DNS_FIREWALL"`,
+			ExpectError:        regexp.MustCompile(`contains an invalid JSON`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"exclude_resource_tags": false,
+				"name":                  "test",
+				"remediation_enabled":   false,
+				"resource_type":         "AWS::EC2::VPC",
+				"security_service_policy_data": []interface{}{
+					map[string]interface{}{
+						"type":                 testCase.Type,
+						"managed_service_data": testCase.ManagedServiceData,
+					},
+				},
+			})
+
+			diags := tffms.ResourcePolicy().Validate(config)
+
+			if testCase.ExpectError == nil {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+
+				return
+			}
+
+			for _, d := range diags {
+				if testCase.ExpectError.MatchString(d.Summary) {
+					return
+				}
+			}
+
+			t.Fatalf("expected error matching %q, got: %v", testCase.ExpectError, diags)
+		})
+	}
+}
+
 func testAccCheckPolicyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).FMSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).FMSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_fms_policy" {
@@ -89,7 +89,7 @@ func testAccCheckGlacierVaultLockExists(resourceName string, getVaultLockOutput
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GlacierConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlacierConn()
 
 		input := &glacier.GetVaultLockInput{
 			VaultName: aws.String(rs.Primary.ID),
@@ -111,7 +111,7 @@ func testAccCheckGlacierVaultLockExists(resourceName string, getVaultLockOutput
 }
 
 func testAccCheckGlacierVaultLockDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).GlacierConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GlacierConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_glacier_vault_lock" {
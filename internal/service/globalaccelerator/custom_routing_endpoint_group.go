@@ -0,0 +1,363 @@
+package globalaccelerator
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceCustomRoutingEndpointGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCustomRoutingEndpointGroupCreate,
+		Read:   resourceCustomRoutingEndpointGroupRead,
+		Update: resourceCustomRoutingEndpointGroupUpdate,
+		Delete: resourceCustomRoutingEndpointGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"destination_configuration": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+
+						"protocols": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(globalaccelerator.CustomRoutingProtocol_Values(), false),
+							},
+						},
+
+						"to_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+
+			"endpoint_configuration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
+			},
+
+			"endpoint_group_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+
+			"listener_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceCustomRoutingEndpointGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+	region := meta.(*conns.AWSClient).Region
+
+	input := &globalaccelerator.CreateCustomRoutingEndpointGroupInput{
+		DestinationConfigurations: expandGlobalAcceleratorCustomRoutingDestinationConfigurations(d.Get("destination_configuration").(*schema.Set).List()),
+		EndpointGroupRegion:       aws.String(region),
+		IdempotencyToken:          aws.String(resource.UniqueId()),
+		ListenerArn:               aws.String(d.Get("listener_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("endpoint_group_region"); ok {
+		input.EndpointGroupRegion = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Global Accelerator Custom Routing Endpoint Group: %s", input)
+	output, err := conn.CreateCustomRoutingEndpointGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Global Accelerator Custom Routing Endpoint Group: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.EndpointGroup.EndpointGroupArn))
+
+	acceleratorARN, err := ListenerOrEndpointGroupARNToAcceleratorARN(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitCustomRoutingAcceleratorDeployed(conn, acceleratorARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %w", acceleratorARN, err)
+	}
+
+	if v, ok := d.GetOk("endpoint_configuration"); ok && v.(*schema.Set).Len() > 0 {
+		input := &globalaccelerator.AddCustomRoutingEndpointsInput{
+			EndpointConfigurations: expandGlobalAcceleratorCustomRoutingEndpointConfigurations(v.(*schema.Set).List()),
+			EndpointGroupArn:       aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Adding Global Accelerator Custom Routing Endpoint Group (%s) endpoints: %s", d.Id(), input)
+		if _, err := conn.AddCustomRoutingEndpoints(input); err != nil {
+			return fmt.Errorf("error adding Global Accelerator Custom Routing Endpoint Group (%s) endpoints: %w", d.Id(), err)
+		}
+
+		if _, err := waitCustomRoutingAcceleratorDeployed(conn, acceleratorARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %w", acceleratorARN, err)
+		}
+	}
+
+	return resourceCustomRoutingEndpointGroupRead(d, meta)
+}
+
+func resourceCustomRoutingEndpointGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+
+	endpointGroup, err := FindCustomRoutingEndpointGroupByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Global Accelerator Custom Routing Endpoint Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Global Accelerator Custom Routing Endpoint Group (%s): %w", d.Id(), err)
+	}
+
+	listenerARN, err := EndpointGroupARNToListenerARN(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", endpointGroup.EndpointGroupArn)
+	if err := d.Set("destination_configuration", flattenGlobalAcceleratorCustomRoutingDestinationDescriptions(endpointGroup.DestinationDescriptions)); err != nil {
+		return fmt.Errorf("error setting destination_configuration: %w", err)
+	}
+	if err := d.Set("endpoint_configuration", flattenGlobalAcceleratorCustomRoutingEndpointDescriptions(endpointGroup.EndpointDescriptions)); err != nil {
+		return fmt.Errorf("error setting endpoint_configuration: %w", err)
+	}
+	d.Set("endpoint_group_region", endpointGroup.EndpointGroupRegion)
+	d.Set("listener_arn", listenerARN)
+
+	return nil
+}
+
+func resourceCustomRoutingEndpointGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+
+	if d.HasChange("endpoint_configuration") {
+		o, n := d.GetChange("endpoint_configuration")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		acceleratorARN, err := ListenerOrEndpointGroupARNToAcceleratorARN(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		if del := os.Difference(ns).List(); len(del) > 0 {
+			input := &globalaccelerator.RemoveCustomRoutingEndpointsInput{
+				EndpointGroupArn: aws.String(d.Id()),
+				EndpointIds:      expandGlobalAcceleratorCustomRoutingEndpointIDs(del),
+			}
+
+			log.Printf("[DEBUG] Removing Global Accelerator Custom Routing Endpoint Group (%s) endpoints: %s", d.Id(), input)
+			if _, err := conn.RemoveCustomRoutingEndpoints(input); err != nil {
+				return fmt.Errorf("error removing Global Accelerator Custom Routing Endpoint Group (%s) endpoints: %w", d.Id(), err)
+			}
+
+			if _, err := waitCustomRoutingAcceleratorDeployed(conn, acceleratorARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %w", acceleratorARN, err)
+			}
+		}
+
+		if add := ns.Difference(os).List(); len(add) > 0 {
+			input := &globalaccelerator.AddCustomRoutingEndpointsInput{
+				EndpointConfigurations: expandGlobalAcceleratorCustomRoutingEndpointConfigurations(add),
+				EndpointGroupArn:       aws.String(d.Id()),
+			}
+
+			log.Printf("[DEBUG] Adding Global Accelerator Custom Routing Endpoint Group (%s) endpoints: %s", d.Id(), input)
+			if _, err := conn.AddCustomRoutingEndpoints(input); err != nil {
+				return fmt.Errorf("error adding Global Accelerator Custom Routing Endpoint Group (%s) endpoints: %w", d.Id(), err)
+			}
+
+			if _, err := waitCustomRoutingAcceleratorDeployed(conn, acceleratorARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %w", acceleratorARN, err)
+			}
+		}
+	}
+
+	return resourceCustomRoutingEndpointGroupRead(d, meta)
+}
+
+func resourceCustomRoutingEndpointGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+
+	log.Printf("[DEBUG] Deleting Global Accelerator Custom Routing Endpoint Group (%s)", d.Id())
+	_, err := conn.DeleteCustomRoutingEndpointGroup(&globalaccelerator.DeleteCustomRoutingEndpointGroupInput{
+		EndpointGroupArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, globalaccelerator.ErrCodeEndpointGroupNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Global Accelerator Custom Routing Endpoint Group (%s): %w", d.Id(), err)
+	}
+
+	acceleratorARN, err := ListenerOrEndpointGroupARNToAcceleratorARN(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitCustomRoutingAcceleratorDeployed(conn, acceleratorARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Global Accelerator Custom Routing Accelerator (%s) deployment: %w", acceleratorARN, err)
+	}
+
+	return nil
+}
+
+func expandGlobalAcceleratorCustomRoutingDestinationConfigurations(tfList []interface{}) []*globalaccelerator.CustomRoutingDestinationConfiguration {
+	apiObjects := make([]*globalaccelerator.CustomRoutingDestinationConfiguration, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &globalaccelerator.CustomRoutingDestinationConfiguration{
+			FromPort:  aws.Int64(int64(tfMap["from_port"].(int))),
+			Protocols: flex.ExpandStringSet(tfMap["protocols"].(*schema.Set)),
+			ToPort:    aws.Int64(int64(tfMap["to_port"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandGlobalAcceleratorCustomRoutingEndpointConfigurations(tfList []interface{}) []*globalaccelerator.CustomRoutingEndpointConfiguration {
+	apiObjects := make([]*globalaccelerator.CustomRoutingEndpointConfiguration, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &globalaccelerator.CustomRoutingEndpointConfiguration{
+			EndpointId: aws.String(tfMap["endpoint_id"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandGlobalAcceleratorCustomRoutingEndpointIDs(tfList []interface{}) []*string {
+	apiObjects := make([]*string, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, aws.String(tfMap["endpoint_id"].(string)))
+	}
+
+	return apiObjects
+}
+
+func flattenGlobalAcceleratorCustomRoutingDestinationDescriptions(apiObjects []*globalaccelerator.CustomRoutingDestinationDescription) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"from_port": int(aws.Int64Value(apiObject.FromPort)),
+			"protocols": aws.StringValueSlice(apiObject.Protocols),
+			"to_port":   int(aws.Int64Value(apiObject.ToPort)),
+		})
+	}
+
+	return tfList
+}
+
+func flattenGlobalAcceleratorCustomRoutingEndpointDescriptions(apiObjects []*globalaccelerator.CustomRoutingEndpointDescription) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"endpoint_id": aws.StringValue(apiObject.EndpointId),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,228 @@
+package globalaccelerator_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglobalaccelerator "github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGlobalAcceleratorCustomRoutingEndpointGroup_basic(t *testing.T) {
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckGlobalAccelerator(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, globalaccelerator.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGlobalAcceleratorCustomRoutingEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorCustomRoutingEndpointGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorCustomRoutingEndpointGroupExists(resourceName),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "globalaccelerator", regexp.MustCompile(`accelerator/[^/]+/listener/[^/]+/endpoint-group/[^/]+`)),
+					resource.TestCheckResourceAttr(resourceName, "destination_configuration.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "destination_configuration.*", map[string]string{
+						"from_port":   "443",
+						"protocols.#": "1",
+						"to_port":     "8443",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_configuration.*.protocols.*", "TCP"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_group_region", acctest.Region()),
+					resource.TestCheckResourceAttrPair(resourceName, "listener_arn", "aws_globalaccelerator_custom_routing_listener.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGlobalAcceleratorCustomRoutingEndpointGroup_disappears(t *testing.T) {
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckGlobalAccelerator(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, globalaccelerator.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGlobalAcceleratorCustomRoutingEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorCustomRoutingEndpointGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorCustomRoutingEndpointGroupExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfglobalaccelerator.ResourceCustomRoutingEndpointGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGlobalAcceleratorCustomRoutingEndpointGroup_endpointConfiguration(t *testing.T) {
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckGlobalAccelerator(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, globalaccelerator.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGlobalAcceleratorCustomRoutingEndpointGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorCustomRoutingEndpointGroupConfigEndpointConfiguration(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorCustomRoutingEndpointGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "endpoint_configuration.*.endpoint_id", "aws_subnet.test.0", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGlobalAcceleratorCustomRoutingEndpointGroupConfigEndpointConfiguration(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorCustomRoutingEndpointGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "endpoint_configuration.*.endpoint_id", "aws_subnet.test.1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGlobalAcceleratorCustomRoutingEndpointGroupExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorConn()
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		_, err := tfglobalaccelerator.FindCustomRoutingEndpointGroupByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGlobalAcceleratorCustomRoutingEndpointGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_globalaccelerator_custom_routing_endpoint_group" {
+			continue
+		}
+
+		_, err := tfglobalaccelerator.FindCustomRoutingEndpointGroupByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Global Accelerator Custom Routing Endpoint Group %s still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccGlobalAcceleratorCustomRoutingEndpointGroupBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_custom_routing_accelerator" "test" {
+  name    = %[1]q
+  enabled = false
+}
+
+resource "aws_globalaccelerator_custom_routing_listener" "test" {
+  accelerator_arn = aws_globalaccelerator_custom_routing_accelerator.test.id
+
+  port_range {
+    from_port = 5000
+    to_port   = 10000
+  }
+}
+`, rName)
+}
+
+func testAccGlobalAcceleratorCustomRoutingEndpointGroupConfig(rName string) string {
+	return acctest.ConfigCompose(testAccGlobalAcceleratorCustomRoutingEndpointGroupBaseConfig(rName), `
+resource "aws_globalaccelerator_custom_routing_endpoint_group" "test" {
+  listener_arn = aws_globalaccelerator_custom_routing_listener.test.id
+
+  destination_configuration {
+    from_port = 443
+    to_port   = 8443
+    protocols = ["TCP"]
+  }
+}
+`)
+}
+
+func testAccGlobalAcceleratorCustomRoutingEndpointGroupConfigEndpointConfiguration(rName string, subnetIndex int) string {
+	return acctest.ConfigCompose(
+		testAccGlobalAcceleratorCustomRoutingEndpointGroupBaseConfig(rName),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, count.index)
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_globalaccelerator_custom_routing_endpoint_group" "test" {
+  listener_arn = aws_globalaccelerator_custom_routing_listener.test.id
+
+  destination_configuration {
+    from_port = 443
+    to_port   = 8443
+    protocols = ["TCP", "UDP"]
+  }
+
+  endpoint_configuration {
+    endpoint_id = aws_subnet.test[%[2]d].id
+  }
+}
+`, rName, subnetIndex))
+}
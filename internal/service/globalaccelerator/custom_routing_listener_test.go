@@ -0,0 +1,143 @@
+package globalaccelerator_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglobalaccelerator "github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGlobalAcceleratorCustomRoutingListener_basic(t *testing.T) {
+	resourceName := "aws_globalaccelerator_custom_routing_listener.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckGlobalAccelerator(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, globalaccelerator.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGlobalAcceleratorCustomRoutingListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorCustomRoutingListenerConfig(rName, 443, 444),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorCustomRoutingListenerExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "accelerator_arn", "aws_globalaccelerator_custom_routing_accelerator.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "port_range.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "port_range.*", map[string]string{
+						"from_port": "443",
+						"to_port":   "444",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGlobalAcceleratorCustomRoutingListenerConfig(rName, 5000, 10000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorCustomRoutingListenerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "port_range.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "port_range.*", map[string]string{
+						"from_port": "5000",
+						"to_port":   "10000",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlobalAcceleratorCustomRoutingListener_disappears(t *testing.T) {
+	resourceName := "aws_globalaccelerator_custom_routing_listener.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckGlobalAccelerator(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, globalaccelerator.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGlobalAcceleratorCustomRoutingListenerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalAcceleratorCustomRoutingListenerConfig(rName, 443, 444),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalAcceleratorCustomRoutingListenerExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfglobalaccelerator.ResourceCustomRoutingListener(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGlobalAcceleratorCustomRoutingListenerExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorConn()
+
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		_, err := tfglobalaccelerator.FindCustomRoutingListenerByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGlobalAcceleratorCustomRoutingListenerDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_globalaccelerator_custom_routing_listener" {
+			continue
+		}
+
+		_, err := tfglobalaccelerator.FindCustomRoutingListenerByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Global Accelerator Custom Routing Listener %s still exists", rs.Primary.ID)
+	}
+	return nil
+}
+
+func testAccGlobalAcceleratorCustomRoutingListenerConfig(rName string, fromPort, toPort int) string {
+	return fmt.Sprintf(`
+resource "aws_globalaccelerator_custom_routing_accelerator" "test" {
+  name    = %[1]q
+  enabled = false
+}
+
+resource "aws_globalaccelerator_custom_routing_listener" "test" {
+  accelerator_arn = aws_globalaccelerator_custom_routing_accelerator.test.id
+
+  port_range {
+    from_port = %[2]d
+    to_port   = %[3]d
+  }
+}
+`, rName, fromPort, toPort)
+}
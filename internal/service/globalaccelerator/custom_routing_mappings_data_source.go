@@ -0,0 +1,166 @@
+package globalaccelerator
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// DataSourceCustomRoutingMappings returns the port mappings of a single destination.
+// Listing every mapping of an accelerator can return many thousands of entries, so the lookup is
+// bounded to one destination IP address in one subnet endpoint.
+func DataSourceCustomRoutingMappings() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCustomRoutingMappingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"accelerator_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"destination_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"destination_port_mappings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accelerator_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"accelerator_socket_addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     socketAddressSchema(),
+						},
+						"destination_socket_address": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     socketAddressSchema(),
+						},
+						"destination_traffic_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoint_group_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoint_group_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func socketAddressSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCustomRoutingMappingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn()
+
+	endpointID := d.Get("endpoint_id").(string)
+	destinationAddress := d.Get("destination_address").(string)
+
+	portMappings, err := FindCustomRoutingPortMappingsByDestination(conn, endpointID, destinationAddress)
+
+	if tfresource.NotFound(err) {
+		portMappings = nil
+	} else if err != nil {
+		return fmt.Errorf("error reading Global Accelerator Custom Routing Mappings (%s/%s): %w", endpointID, destinationAddress, err)
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range portMappings {
+		if v, ok := d.GetOk("accelerator_arn"); ok && v.(string) != aws.StringValue(apiObject.AcceleratorArn) {
+			continue
+		}
+
+		tfList = append(tfList, flattenGlobalAcceleratorDestinationPortMapping(apiObject))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", endpointID, destinationAddress))
+
+	if err := d.Set("destination_port_mappings", tfList); err != nil {
+		return fmt.Errorf("error setting destination_port_mappings: %w", err)
+	}
+
+	return nil
+}
+
+func flattenGlobalAcceleratorDestinationPortMapping(apiObject *globalaccelerator.DestinationPortMapping) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"accelerator_arn":           aws.StringValue(apiObject.AcceleratorArn),
+		"destination_traffic_state": aws.StringValue(apiObject.DestinationTrafficState),
+		"endpoint_group_arn":        aws.StringValue(apiObject.EndpointGroupArn),
+		"endpoint_group_region":     aws.StringValue(apiObject.EndpointGroupRegion),
+		"ip_address_type":           aws.StringValue(apiObject.IpAddressType),
+	}
+
+	var addresses []interface{}
+
+	for _, v := range apiObject.AcceleratorSocketAddresses {
+		if v := flattenGlobalAcceleratorSocketAddress(v); v != nil {
+			addresses = append(addresses, v)
+		}
+	}
+
+	tfMap["accelerator_socket_addresses"] = addresses
+
+	if v := flattenGlobalAcceleratorSocketAddress(apiObject.DestinationSocketAddress); v != nil {
+		tfMap["destination_socket_address"] = []interface{}{v}
+	}
+
+	return tfMap
+}
+
+func flattenGlobalAcceleratorSocketAddress(apiObject *globalaccelerator.SocketAddress) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"ip_address": aws.StringValue(apiObject.IpAddress),
+		"port":       int(aws.Int64Value(apiObject.Port)),
+	}
+}
@@ -0,0 +1,86 @@
+package globalaccelerator_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccGlobalAcceleratorCustomRoutingMappingsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_globalaccelerator_custom_routing_mappings.test"
+	acceleratorResourceName := "aws_globalaccelerator_custom_routing_accelerator.test"
+	endpointGroupResourceName := "aws_globalaccelerator_custom_routing_endpoint_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckGlobalAccelerator(t) },
+		ErrorCheck: acctest.ErrorCheck(t, globalaccelerator.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingMappingsDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "destination_port_mappings.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "destination_port_mappings.0.accelerator_arn", acceleratorResourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "destination_port_mappings.0.accelerator_socket_addresses.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "destination_port_mappings.0.destination_socket_address.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "destination_port_mappings.0.destination_socket_address.0.ip_address", dataSourceName, "destination_address"),
+					resource.TestCheckResourceAttr(dataSourceName, "destination_port_mappings.0.destination_traffic_state", globalaccelerator.CustomRoutingDestinationTrafficStateDeny),
+					resource.TestCheckResourceAttrPair(dataSourceName, "destination_port_mappings.0.endpoint_group_arn", endpointGroupResourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "destination_port_mappings.0.endpoint_group_region", acctest.Region()),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomRoutingMappingsDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccGlobalAcceleratorCustomRoutingEndpointGroupBaseConfig(rName),
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, 0)
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_globalaccelerator_custom_routing_endpoint_group" "test" {
+  listener_arn = aws_globalaccelerator_custom_routing_listener.test.id
+
+  destination_configuration {
+    from_port = 443
+    to_port   = 444
+    protocols = ["TCP"]
+  }
+
+  endpoint_configuration {
+    endpoint_id = aws_subnet.test.id
+  }
+}
+
+data "aws_globalaccelerator_custom_routing_mappings" "test" {
+  accelerator_arn     = aws_globalaccelerator_custom_routing_accelerator.test.id
+  destination_address = cidrhost(aws_subnet.test.cidr_block, 10)
+  endpoint_id         = aws_subnet.test.id
+
+  depends_on = [aws_globalaccelerator_custom_routing_endpoint_group.test]
+}
+`, rName))
+}
@@ -20,6 +20,11 @@ func init() {
 		Name: "aws_globalaccelerator_accelerator",
 		F:    sweepAccelerators,
 	})
+
+	resource.AddTestSweepers("aws_globalaccelerator_custom_routing_accelerator", &resource.Sweeper{
+		Name: "aws_globalaccelerator_custom_routing_accelerator",
+		F:    sweepCustomRoutingAccelerators,
+	})
 }
 
 func sweepAccelerators(region string) error {
@@ -27,7 +32,7 @@ func sweepAccelerators(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GlobalAcceleratorConn
+	conn := client.(*conns.AWSClient).GlobalAcceleratorConn()
 
 	input := &globalaccelerator.ListAcceleratorsInput{}
 	var sweeperErrs *multierror.Error
@@ -76,7 +81,7 @@ func sweepAccelerators(region string) error {
 }
 
 func sweepGlobalAcceleratorEndpointGroups(client interface{}, listenerArn *string) *multierror.Error {
-	conn := client.(*conns.AWSClient).GlobalAcceleratorConn
+	conn := client.(*conns.AWSClient).GlobalAcceleratorConn()
 	var sweeperErrs *multierror.Error
 
 	log.Printf("[INFO] deleting Endpoint Groups for Listener %s", *listenerArn)
@@ -110,7 +115,7 @@ func sweepGlobalAcceleratorEndpointGroups(client interface{}, listenerArn *strin
 }
 
 func sweepGlobalAcceleratorListeners(client interface{}, acceleratorArn *string) *multierror.Error {
-	conn := client.(*conns.AWSClient).GlobalAcceleratorConn
+	conn := client.(*conns.AWSClient).GlobalAcceleratorConn()
 	var sweeperErrs *multierror.Error
 
 	log.Printf("[INFO] deleting Listeners for Accelerator %s", *acceleratorArn)
@@ -147,3 +152,129 @@ func sweepGlobalAcceleratorListeners(client interface{}, acceleratorArn *string)
 
 	return sweeperErrs
 }
+
+func sweepCustomRoutingAccelerators(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("Error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).GlobalAcceleratorConn()
+
+	input := &globalaccelerator.ListCustomRoutingAcceleratorsInput{}
+	var sweeperErrs *multierror.Error
+
+	for {
+		output, err := conn.ListCustomRoutingAccelerators(input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping Global Accelerator Custom Routing Accelerator sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("Error retrieving Global Accelerator Custom Routing Accelerators: %s", err)
+		}
+
+		for _, accelerator := range output.Accelerators {
+			arn := aws.StringValue(accelerator.AcceleratorArn)
+
+			errs := sweepGlobalAcceleratorCustomRoutingListeners(client, accelerator.AcceleratorArn)
+			if errs != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, errs)
+			}
+
+			r := ResourceCustomRoutingAccelerator()
+			d := r.Data(nil)
+			d.SetId(arn)
+			err = r.Delete(d, client)
+
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting Global Accelerator Custom Routing Accelerator (%s): %s", arn, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func sweepGlobalAcceleratorCustomRoutingEndpointGroups(client interface{}, listenerArn *string) *multierror.Error {
+	conn := client.(*conns.AWSClient).GlobalAcceleratorConn()
+	var sweeperErrs *multierror.Error
+
+	log.Printf("[INFO] deleting Custom Routing Endpoint Groups for Listener %s", *listenerArn)
+	input := &globalaccelerator.ListCustomRoutingEndpointGroupsInput{
+		ListenerArn: listenerArn,
+	}
+	output, err := conn.ListCustomRoutingEndpointGroups(input)
+	if err != nil {
+		sweeperErr := fmt.Errorf("error listing Global Accelerator Custom Routing Endpoint Groups for Listener (%s): %s", *listenerArn, err)
+		log.Printf("[ERROR] %s", sweeperErr)
+		return multierror.Append(sweeperErrs, sweeperErr)
+	}
+
+	for _, endpoint := range output.EndpointGroups {
+		arn := aws.StringValue(endpoint.EndpointGroupArn)
+
+		r := ResourceCustomRoutingEndpointGroup()
+		d := r.Data(nil)
+		d.SetId(arn)
+		err = r.Delete(d, client)
+
+		if err != nil {
+			sweeperErr := fmt.Errorf("error deleting Global Accelerator custom routing endpoint group (%s): %s", arn, err)
+			log.Printf("[ERROR] %s", sweeperErr)
+			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+			continue
+		}
+	}
+
+	return sweeperErrs
+}
+
+func sweepGlobalAcceleratorCustomRoutingListeners(client interface{}, acceleratorArn *string) *multierror.Error {
+	conn := client.(*conns.AWSClient).GlobalAcceleratorConn()
+	var sweeperErrs *multierror.Error
+
+	log.Printf("[INFO] deleting Custom Routing Listeners for Accelerator %s", *acceleratorArn)
+	input := &globalaccelerator.ListCustomRoutingListenersInput{
+		AcceleratorArn: acceleratorArn,
+	}
+	output, err := conn.ListCustomRoutingListeners(input)
+	if err != nil {
+		sweeperErr := fmt.Errorf("error listing Global Accelerator Custom Routing Listeners for Accelerator (%s): %s", *acceleratorArn, err)
+		log.Printf("[ERROR] %s", sweeperErr)
+		return multierror.Append(sweeperErrs, sweeperErr)
+	}
+
+	for _, listener := range output.Listeners {
+		errs := sweepGlobalAcceleratorCustomRoutingEndpointGroups(client, listener.ListenerArn)
+		if errs != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, errs)
+		}
+
+		arn := aws.StringValue(listener.ListenerArn)
+
+		r := ResourceCustomRoutingListener()
+		d := r.Data(nil)
+		d.SetId(arn)
+		err = r.Delete(d, client)
+
+		if err != nil {
+			sweeperErr := fmt.Errorf("error deleting Global Accelerator custom routing listener (%s): %s", arn, err)
+			log.Printf("[ERROR] %s", sweeperErr)
+			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+			continue
+		}
+	}
+
+	return sweeperErrs
+}
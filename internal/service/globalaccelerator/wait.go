@@ -24,3 +24,21 @@ func waitAcceleratorDeployed(conn *globalaccelerator.GlobalAccelerator, arn stri
 
 	return nil, err
 }
+
+// waitCustomRoutingAcceleratorDeployed waits for a Custom Routing Accelerator to return Deployed
+func waitCustomRoutingAcceleratorDeployed(conn *globalaccelerator.GlobalAccelerator, arn string, timeout time.Duration) (*globalaccelerator.CustomRoutingAccelerator, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{globalaccelerator.CustomRoutingAcceleratorStatusInProgress},
+		Target:  []string{globalaccelerator.CustomRoutingAcceleratorStatusDeployed},
+		Refresh: statusCustomRoutingAccelerator(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*globalaccelerator.CustomRoutingAccelerator); ok {
+		return v, err
+	}
+
+	return nil, err
+}
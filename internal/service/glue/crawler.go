@@ -22,6 +22,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	crawlerServicePrincipal = "glue.amazonaws.com"
+)
+
 func ResourceCrawler() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCrawlerCreate,
@@ -278,7 +282,7 @@ func ResourceCrawler() *schema.Resource {
 }
 
 func resourceCrawlerCreate(d *schema.ResourceData, meta interface{}) error {
-	glueConn := meta.(*conns.AWSClient).GlueConn
+	glueConn := meta.(*conns.AWSClient).GlueConn()
 	name := d.Get("name").(string)
 
 	crawlerInput, err := createCrawlerInput(d, name, meta.(*conns.AWSClient).DefaultTagsConfig)
@@ -286,6 +290,10 @@ func resourceCrawlerCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if err := tfiam.WaitRoleAssumable(meta.(*conns.AWSClient).IAMConn(), d.Get("role").(string), crawlerServicePrincipal); err != nil {
+		return fmt.Errorf("error creating Glue crawler (%s): %w", name, err)
+	}
+
 	// Retry for IAM eventual consistency
 	err = resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
 		_, err = glueConn.CreateCrawler(crawlerInput)
@@ -598,7 +606,7 @@ func expandGlueMongoDBTarget(cfg map[string]interface{}) *glue.MongoDBTarget {
 }
 
 func resourceCrawlerUpdate(d *schema.ResourceData, meta interface{}) error {
-	glueConn := meta.(*conns.AWSClient).GlueConn
+	glueConn := meta.(*conns.AWSClient).GlueConn()
 	name := d.Get("name").(string)
 
 	if d.HasChangesExcept("tags", "tags_all") {
@@ -607,6 +615,12 @@ func resourceCrawlerUpdate(d *schema.ResourceData, meta interface{}) error {
 			return err
 		}
 
+		if d.HasChange("role") {
+			if err := tfiam.WaitRoleAssumable(meta.(*conns.AWSClient).IAMConn(), d.Get("role").(string), crawlerServicePrincipal); err != nil {
+				return fmt.Errorf("error updating Glue crawler (%s): %w", name, err)
+			}
+		}
+
 		// Retry for IAM eventual consistency
 		err = resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
 			_, err := glueConn.UpdateCrawler(updateCrawlerInput)
@@ -650,7 +664,7 @@ func resourceCrawlerUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCrawlerRead(d *schema.ResourceData, meta interface{}) error {
-	glueConn := meta.(*conns.AWSClient).GlueConn
+	glueConn := meta.(*conns.AWSClient).GlueConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -736,7 +750,7 @@ func resourceCrawlerRead(d *schema.ResourceData, meta interface{}) error {
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -829,7 +843,7 @@ func flattenGlueMongoDBTargets(mongoDBTargets []*glue.MongoDBTarget) []map[strin
 }
 
 func resourceCrawlerDelete(d *schema.ResourceData, meta interface{}) error {
-	glueConn := meta.(*conns.AWSClient).GlueConn
+	glueConn := meta.(*conns.AWSClient).GlueConn()
 
 	log.Printf("[DEBUG] deleting Glue crawler: %s", d.Id())
 	_, err := glueConn.DeleteCrawler(&glue.DeleteCrawlerInput{
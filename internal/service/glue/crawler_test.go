@@ -1411,7 +1411,7 @@ func testAccCheckCrawlerExists(resourceName string, crawler *glue.Crawler) resou
 			return fmt.Errorf("no ID is set")
 		}
 
-		glueConn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+		glueConn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn()
 		out, err := glueConn.GetCrawler(&glue.GetCrawlerInput{
 			Name: aws.String(rs.Primary.ID),
 		})
@@ -1436,7 +1436,7 @@ func testAccCheckCrawlerDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlueConn()
 		output, err := conn.GetCrawler(&glue.GetCrawlerInput{
 			Name: aws.String(rs.Primary.ID),
 		})
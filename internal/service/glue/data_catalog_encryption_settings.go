@@ -79,7 +79,7 @@ func ResourceDataCatalogEncryptionSettings() *schema.Resource {
 }
 
 func resourceDataCatalogEncryptionSettingsPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlueConn
+	conn := meta.(*conns.AWSClient).GlueConn()
 	catalogID := createCatalogID(d, meta.(*conns.AWSClient).AccountID)
 
 	input := &glue.PutDataCatalogEncryptionSettingsInput{
@@ -98,7 +98,7 @@ func resourceDataCatalogEncryptionSettingsPut(d *schema.ResourceData, meta inter
 }
 
 func resourceDataCatalogEncryptionSettingsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).GlueConn
+	conn := meta.(*conns.AWSClient).GlueConn()
 
 	input := &glue.GetDataCatalogEncryptionSettingsInput{
 		CatalogId: aws.String(d.Id()),
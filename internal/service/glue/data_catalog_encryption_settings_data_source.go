@@ -63,7 +63,7 @@ func DataSourceDataCatalogEncryptionSettings() *schema.Resource {
 }
 
 func dataSourceDataCatalogEncryptionSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).GlueConn
+	conn := meta.(*conns.AWSClient).GlueConn()
 	id := d.Get("catalog_id").(string)
 	input := &glue.GetDataCatalogEncryptionSettingsInput{
 		CatalogId: aws.String(id),
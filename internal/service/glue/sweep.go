@@ -84,7 +84,7 @@ func sweepCatalogDatabases(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GlueConn
+	conn := client.(*conns.AWSClient).GlueConn()
 
 	input := &glue.GetDatabasesInput{}
 	err = conn.GetDatabasesPages(input, func(page *glue.GetDatabasesOutput, lastPage bool) bool {
@@ -126,7 +126,7 @@ func sweepClassifiers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GlueConn
+	conn := client.(*conns.AWSClient).GlueConn()
 
 	input := &glue.GetClassifiersInput{}
 	err = conn.GetClassifiersPages(input, func(page *glue.GetClassifiersOutput, lastPage bool) bool {
@@ -174,7 +174,7 @@ func sweepConnections(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GlueConn
+	conn := client.(*conns.AWSClient).GlueConn()
 	catalogID := client.(*conns.AWSClient).AccountID
 
 	input := &glue.GetConnectionsInput{
@@ -216,7 +216,7 @@ func sweepCrawlers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GlueConn
+	conn := client.(*conns.AWSClient).GlueConn()
 
 	input := &glue.GetCrawlersInput{}
 	err = conn.GetCrawlersPages(input, func(page *glue.GetCrawlersOutput, lastPage bool) bool {
@@ -254,7 +254,7 @@ func sweepDevEndpoint(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GlueConn
+	conn := client.(*conns.AWSClient).GlueConn()
 
 	input := &glue.GetDevEndpointsInput{}
 	err = conn.GetDevEndpointsPages(input, func(page *glue.GetDevEndpointsOutput, lastPage bool) bool {
@@ -295,7 +295,7 @@ func sweepJobs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GlueConn
+	conn := client.(*conns.AWSClient).GlueConn()
 
 	input := &glue.GetJobsInput{}
 	err = conn.GetJobsPages(input, func(page *glue.GetJobsOutput, lastPage bool) bool {
@@ -330,7 +330,7 @@ func sweepMLTransforms(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).GlueConn
+	conn := client.(*conns.AWSClient).GlueConn()
 	var sweeperErrs *multierror.Error
 
 	input := &glue.GetMLTransformsInput{}
@@ -373,7 +373,7 @@ func sweepRegistry(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GlueConn
+	conn := client.(*conns.AWSClient).GlueConn()
 
 	listOutput, err := conn.ListRegistries(&glue.ListRegistriesInput{})
 	if err != nil {
@@ -403,7 +403,7 @@ func sweepSchema(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GlueConn
+	conn := client.(*conns.AWSClient).GlueConn()
 
 	listOutput, err := conn.ListSchemas(&glue.ListSchemasInput{})
 	if err != nil {
@@ -433,7 +433,7 @@ func sweepSecurityConfigurations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GlueConn
+	conn := client.(*conns.AWSClient).GlueConn()
 
 	input := &glue.GetSecurityConfigurationsInput{}
 
@@ -474,7 +474,7 @@ func sweepTriggers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GlueConn
+	conn := client.(*conns.AWSClient).GlueConn()
 
 	input := &glue.GetTriggersInput{}
 	err = conn.GetTriggersPages(input, func(page *glue.GetTriggersOutput, lastPage bool) bool {
@@ -512,7 +512,7 @@ func sweepWorkflow(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).GlueConn
+	conn := client.(*conns.AWSClient).GlueConn()
 
 	listOutput, err := conn.ListWorkflows(&glue.ListWorkflowsInput{})
 	if err != nil {
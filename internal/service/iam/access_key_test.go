@@ -130,7 +130,7 @@ func TestAccIAMAccessKey_status(t *testing.T) {
 }
 
 func testAccCheckAccessKeyDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_access_key" {
@@ -172,7 +172,7 @@ func testAccCheckAccessKeyExists(n string, res *iam.AccessKeyMetadata) resource.
 			return fmt.Errorf("No Role name is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMConn()
 		name := rs.Primary.Attributes["user"]
 
 		resp, err := conn.ListAccessKeys(&iam.ListAccessKeysInput{
@@ -11,7 +11,9 @@ const (
 	ARNSeparator = "/"
 	ARNService   = "iam"
 
-	InstanceProfileResourcePrefix = "instance-profile"
+	InstanceProfileResourcePrefix  = "instance-profile"
+	RoleResourcePrefix             = "role"
+	VirtualMFADeviceResourcePrefix = "mfa"
 )
 
 // InstanceProfileARNToName converts Amazon Resource Name (ARN) to Name.
@@ -38,3 +40,58 @@ func InstanceProfileARNToName(inputARN string) (string, error) {
 
 	return resourceParts[len(resourceParts)-1], nil
 }
+
+// RoleARNToName converts Amazon Resource Name (ARN) to Name.
+func RoleARNToName(inputARN string) (string, error) {
+	parsedARN, err := arn.Parse(inputARN)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing ARN (%s): %w", inputARN, err)
+	}
+
+	if actual, expected := parsedARN.Service, ARNService; actual != expected {
+		return "", fmt.Errorf("expected service %s in ARN (%s), got: %s", expected, inputARN, actual)
+	}
+
+	resourceParts := strings.Split(parsedARN.Resource, ARNSeparator)
+
+	if actual, expected := len(resourceParts), 2; actual < expected {
+		return "", fmt.Errorf("expected at least %d resource parts in ARN (%s), got: %d", expected, inputARN, actual)
+	}
+
+	if actual, expected := resourceParts[0], RoleResourcePrefix; actual != expected {
+		return "", fmt.Errorf("expected resource prefix %s in ARN (%s), got: %s", expected, inputARN, actual)
+	}
+
+	return resourceParts[len(resourceParts)-1], nil
+}
+
+// VirtualMFADeviceARNToPathAndName converts Amazon Resource Name (ARN) to Path and Name.
+func VirtualMFADeviceARNToPathAndName(inputARN string) (string, string, error) {
+	parsedARN, err := arn.Parse(inputARN)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing ARN (%s): %w", inputARN, err)
+	}
+
+	if actual, expected := parsedARN.Service, ARNService; actual != expected {
+		return "", "", fmt.Errorf("expected service %s in ARN (%s), got: %s", expected, inputARN, actual)
+	}
+
+	resourceParts := strings.Split(parsedARN.Resource, ARNSeparator)
+
+	if actual, expected := len(resourceParts), 2; actual < expected {
+		return "", "", fmt.Errorf("expected at least %d resource parts in ARN (%s), got: %d", expected, inputARN, actual)
+	}
+
+	if actual, expected := resourceParts[0], VirtualMFADeviceResourcePrefix; actual != expected {
+		return "", "", fmt.Errorf("expected resource prefix %s in ARN (%s), got: %s", expected, inputARN, actual)
+	}
+
+	path := ARNSeparator + strings.Join(resourceParts[1:len(resourceParts)-1], ARNSeparator)
+	if len(resourceParts) > 2 {
+		path += ARNSeparator
+	}
+
+	return path, resourceParts[len(resourceParts)-1], nil
+}
@@ -73,3 +73,134 @@ func TestInstanceProfileARNToName(t *testing.T) {
 		})
 	}
 }
+
+func TestRoleARNToName(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputARN      string
+		ExpectedError *regexp.Regexp
+		ExpectedName  string
+	}{
+		{
+			TestName:      "empty ARN",
+			InputARN:      "",
+			ExpectedError: regexp.MustCompile(`error parsing ARN`),
+		},
+		{
+			TestName:      "invalid ARN service",
+			InputARN:      "arn:aws:ec2:us-east-1:123456789012:instance/i-12345678",
+			ExpectedError: regexp.MustCompile(`expected service iam`),
+		},
+		{
+			TestName:      "invalid ARN resource parts",
+			InputARN:      "arn:aws:iam::123456789012:name",
+			ExpectedError: regexp.MustCompile(`expected at least 2 resource parts`),
+		},
+		{
+			TestName:      "invalid ARN resource prefix",
+			InputARN:      "arn:aws:iam::123456789012:instance-profile/name",
+			ExpectedError: regexp.MustCompile(`expected resource prefix role`),
+		},
+		{
+			TestName:     "valid ARN",
+			InputARN:     "arn:aws:iam::123456789012:role/name",
+			ExpectedName: "name",
+		},
+		{
+			TestName:     "valid ARN with path",
+			InputARN:     "arn:aws:iam::123456789012:role/path/name",
+			ExpectedName: "name",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := tfiam.RoleARNToName(testCase.InputARN)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if got != testCase.ExpectedName {
+				t.Errorf("got %s, expected %s", got, testCase.ExpectedName)
+			}
+		})
+	}
+}
+
+func TestVirtualMFADeviceARNToPathAndName(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		InputARN      string
+		ExpectedError *regexp.Regexp
+		ExpectedPath  string
+		ExpectedName  string
+	}{
+		{
+			TestName:      "empty ARN",
+			InputARN:      "",
+			ExpectedError: regexp.MustCompile(`error parsing ARN`),
+		},
+		{
+			TestName:      "invalid ARN service",
+			InputARN:      "arn:aws:ec2:us-east-1:123456789012:instance/i-12345678",
+			ExpectedError: regexp.MustCompile(`expected service iam`),
+		},
+		{
+			TestName:      "invalid ARN resource parts",
+			InputARN:      "arn:aws:iam::123456789012:name",
+			ExpectedError: regexp.MustCompile(`expected at least 2 resource parts`),
+		},
+		{
+			TestName:      "invalid ARN resource prefix",
+			InputARN:      "arn:aws:iam::123456789012:role/name",
+			ExpectedError: regexp.MustCompile(`expected resource prefix mfa`),
+		},
+		{
+			TestName:     "valid ARN",
+			InputARN:     "arn:aws:iam::123456789012:mfa/name",
+			ExpectedPath: "/",
+			ExpectedName: "name",
+		},
+		{
+			TestName:     "valid ARN with path",
+			InputARN:     "arn:aws:iam::123456789012:mfa/path/to/name",
+			ExpectedPath: "/path/to/",
+			ExpectedName: "name",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotPath, gotName, err := tfiam.VirtualMFADeviceARNToPathAndName(testCase.InputARN)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if gotPath != testCase.ExpectedPath {
+				t.Errorf("got path %s, expected %s", gotPath, testCase.ExpectedPath)
+			}
+
+			if gotName != testCase.ExpectedName {
+				t.Errorf("got name %s, expected %s", gotName, testCase.ExpectedName)
+			}
+		})
+	}
+}
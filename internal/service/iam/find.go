@@ -171,3 +171,118 @@ func FindRoleByName(conn *iam.IAM, name string) (*iam.Role, error) {
 
 	return output.Role, nil
 }
+
+func FindServiceSpecificCredential(conn *iam.IAM, serviceName, userName, credID string) (*iam.ServiceSpecificCredentialMetadata, error) {
+	input := &iam.ListServiceSpecificCredentialsInput{
+		ServiceName: aws.String(serviceName),
+		UserName:    aws.String(userName),
+	}
+
+	output, err := conn.ListServiceSpecificCredentials(input)
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, cred := range output.ServiceSpecificCredentials {
+		if cred == nil {
+			continue
+		}
+
+		if aws.StringValue(cred.ServiceSpecificCredentialId) == credID {
+			return cred, nil
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
+func FindSigningCertificate(conn *iam.IAM, userName, certID string) (*iam.SigningCertificate, error) {
+	input := &iam.ListSigningCertificatesInput{
+		UserName: aws.String(userName),
+	}
+
+	var result *iam.SigningCertificate
+
+	err := conn.ListSigningCertificatesPages(input, func(page *iam.ListSigningCertificatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, cert := range page.Certificates {
+			if cert == nil {
+				continue
+			}
+
+			if aws.StringValue(cert.CertificateId) == certID {
+				result = cert
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+func FindVirtualMFADeviceBySerialNumber(conn *iam.IAM, serialNumber string) (*iam.VirtualMFADevice, error) {
+	input := &iam.ListVirtualMFADevicesInput{}
+
+	var result *iam.VirtualMFADevice
+
+	err := conn.ListVirtualMFADevicesPages(input, func(page *iam.ListVirtualMFADevicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, device := range page.VirtualMFADevices {
+			if device == nil {
+				continue
+			}
+
+			if aws.StringValue(device.SerialNumber) == serialNumber {
+				result = device
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
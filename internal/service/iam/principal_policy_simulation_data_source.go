@@ -0,0 +1,367 @@
+package iam
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourcePrincipalPolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePrincipalPolicySimulationRead,
+
+		Schema: map[string]*schema.Schema{
+			"action_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"additional_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+			"all_allowed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"caller_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iam.ContextKeyTypeEnum_Values(), false),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"permissions_boundary_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+			"policy_source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"resource_handling_option": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_owner_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"resource_policy_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"results": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decision_details": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"matched_statements": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source_policy_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"source_policy_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"missing_context_keys": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrincipalPolicySimulationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	policySourceARN := d.Get("policy_source_arn").(string)
+	input := &iam.SimulatePrincipalPolicyInput{
+		ActionNames:     flex.ExpandStringSet(d.Get("action_names").(*schema.Set)),
+		PolicySourceArn: aws.String(policySourceARN),
+	}
+
+	if v, ok := d.GetOk("additional_policies_json"); ok && len(v.([]interface{})) > 0 {
+		input.PolicyInputList = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("caller_arn"); ok {
+		input.CallerArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("context"); ok && v.(*schema.Set).Len() > 0 {
+		input.ContextEntries = expandContextEntries(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("permissions_boundary_policies_json"); ok && len(v.([]interface{})) > 0 {
+		input.PermissionsBoundaryPolicyInputList = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("resource_handling_option"); ok {
+		input.ResourceHandlingOption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_owner_account_id"); ok {
+		input.ResourceOwner = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_policy_json"); ok {
+		input.ResourcePolicy = aws.String(v.(string))
+	}
+
+	var results []*iam.EvaluationResult
+
+	err := conn.SimulatePrincipalPolicyPages(input, func(page *iam.SimulatePolicyResponse, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		results = append(results, page.EvaluationResults...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error simulating IAM principal policy (%s): %w", policySourceARN, err)
+	}
+
+	allAllowed := true
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+
+		if aws.StringValue(result.EvalDecision) != iam.PolicyEvaluationDecisionTypeAllowed {
+			allAllowed = false
+		}
+	}
+
+	d.SetId(policySourceARN)
+	d.Set("all_allowed", allAllowed)
+	if err := d.Set("results", flattenEvaluationResults(results)); err != nil {
+		return fmt.Errorf("error setting results: %w", err)
+	}
+
+	return nil
+}
+
+func expandContextEntry(tfMap map[string]interface{}) *iam.ContextEntry {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &iam.ContextEntry{}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.ContextKeyName = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.ContextKeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["values"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ContextKeyValues = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func expandContextEntries(tfList []interface{}) []*iam.ContextEntry {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iam.ContextEntry
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandContextEntry(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenEvaluationResult(apiObject *iam.EvaluationResult) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allowed": aws.StringValue(apiObject.EvalDecision) == iam.PolicyEvaluationDecisionTypeAllowed,
+	}
+
+	if v := apiObject.EvalActionName; v != nil {
+		tfMap["action_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EvalDecision; v != nil {
+		tfMap["decision"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EvalDecisionDetails; v != nil {
+		tfMap["decision_details"] = aws.StringValueMap(v)
+	}
+
+	if v := apiObject.MatchedStatements; v != nil {
+		tfMap["matched_statements"] = flattenStatements(v)
+	}
+
+	if v := apiObject.MissingContextValues; v != nil {
+		tfMap["missing_context_keys"] = aws.StringValueSlice(v)
+	}
+
+	if v := apiObject.EvalResourceName; v != nil {
+		tfMap["resource_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenEvaluationResults(apiObjects []*iam.EvaluationResult) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenEvaluationResult(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenStatement(apiObject *iam.Statement) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SourcePolicyId; v != nil {
+		tfMap["source_policy_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SourcePolicyType; v != nil {
+		tfMap["source_policy_type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenStatements(apiObjects []*iam.Statement) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenStatement(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,90 @@
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMPrincipalPolicySimulationDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	allowedDataSourceName := "data.aws_iam_principal_policy_simulation.allowed"
+	deniedDataSourceName := "data.aws_iam_principal_policy_simulation.denied"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrincipalPolicySimulationDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(allowedDataSourceName, "id", "aws_iam_user.test", "arn"),
+					resource.TestCheckResourceAttr(allowedDataSourceName, "all_allowed", "true"),
+					resource.TestCheckResourceAttr(allowedDataSourceName, "results.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(allowedDataSourceName, "results.*", map[string]string{
+						"action_name":          "s3:GetObject",
+						"allowed":              "true",
+						"decision":             "allowed",
+						"matched_statements.#": "1",
+					}),
+					resource.TestCheckResourceAttr(deniedDataSourceName, "all_allowed", "false"),
+					resource.TestCheckResourceAttr(deniedDataSourceName, "results.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(deniedDataSourceName, "results.*", map[string]string{
+						"action_name": "s3:GetObject",
+						"allowed":     "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(deniedDataSourceName, "results.*", map[string]string{
+						"action_name": "s3:PutObject",
+						"allowed":     "false",
+						"decision":    "implicitDeny",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccPrincipalPolicySimulationDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_user_policy" "test" {
+  name = %[1]q
+  user = aws_iam_user.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:GetObject"
+      Effect   = "Allow"
+      Resource = "arn:${data.aws_partition.current.partition}:s3:::%[1]s/*"
+    }]
+  })
+}
+
+data "aws_iam_principal_policy_simulation" "allowed" {
+  action_names      = ["s3:GetObject"]
+  policy_source_arn = aws_iam_user.test.arn
+  resource_arns     = ["arn:${data.aws_partition.current.partition}:s3:::%[1]s/example"]
+
+  depends_on = [aws_iam_user_policy.test]
+}
+
+data "aws_iam_principal_policy_simulation" "denied" {
+  action_names      = ["s3:GetObject", "s3:PutObject"]
+  policy_source_arn = aws_iam_user.test.arn
+  resource_arns     = ["arn:${data.aws_partition.current.partition}:s3:::%[1]s/example"]
+
+  depends_on = [aws_iam_user_policy.test]
+}
+`, rName)
+}
@@ -164,7 +164,7 @@ func resourceRoleImport(
 }
 
 func resourceRoleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -230,7 +230,7 @@ func resourceRoleCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRoleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -267,7 +267,7 @@ func resourceRoleRead(d *schema.ResourceData, meta interface{}) error {
 	tags := KeyValueTags(role.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -301,7 +301,7 @@ func resourceRoleRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRoleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	if d.HasChange("assume_role_policy") {
 		assumeRolePolicyInput := &iam.UpdateAssumeRolePolicyInput{
@@ -454,7 +454,7 @@ func resourceRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRoleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	hasInline := false
 	if v, ok := d.GetOk("inline_policy"); ok && v.(*schema.Set).Len() > 0 {
@@ -714,7 +714,7 @@ func expandIamInlinePolicies(roleName string, tfList []interface{}) []*iam.PutRo
 }
 
 func addIamInlinePolicies(policies []*iam.PutRolePolicyInput, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	var errs *multierror.Error
 	for _, policy := range policies {
@@ -733,7 +733,7 @@ func addIamInlinePolicies(policies []*iam.PutRolePolicyInput, meta interface{})
 }
 
 func addIamManagedPolicies(roleName string, policies []*string, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	var errs *multierror.Error
 	for _, arn := range policies {
@@ -748,7 +748,7 @@ func addIamManagedPolicies(roleName string, policies []*string, meta interface{}
 }
 
 func readIamInlinePolicies(roleName string, meta interface{}) ([]*iam.PutRolePolicyInput, error) {
-	conn := meta.(*conns.AWSClient).IAMConn
+	conn := meta.(*conns.AWSClient).IAMConn()
 
 	policyNames, err := readIamRolePolicyNames(conn, roleName)
 	if err != nil {
@@ -0,0 +1,101 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceRolePoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRolePoliciesExclusivePut,
+		Read:   resourceRolePoliciesExclusiveRead,
+		Update: resourceRolePoliciesExclusivePut,
+		Delete: schema.Noop,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceRolePoliciesExclusiveImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceRolePoliciesExclusivePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	roleName := d.Get("role_name").(string)
+	want := d.Get("policy_names").(*schema.Set)
+
+	have, err := readIamRolePolicyNames(conn, roleName)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s) inline policies: %w", roleName, err)
+	}
+
+	var remove []*string
+	for _, name := range have {
+		if !want.Contains(aws.StringValue(name)) {
+			remove = append(remove, name)
+		}
+	}
+
+	if len(remove) > 0 {
+		log.Printf("[DEBUG] Removing IAM Role (%s) inline policies not managed by Terraform: %s", roleName, aws.StringValueSlice(remove))
+		if err := deleteIamRolePolicies(conn, roleName, remove); err != nil {
+			return fmt.Errorf("error removing IAM Role (%s) inline policies: %w", roleName, err)
+		}
+	}
+
+	d.SetId(roleName)
+
+	return resourceRolePoliciesExclusiveRead(d, meta)
+}
+
+func resourceRolePoliciesExclusiveRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	_, err := FindRoleByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role Policies Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s): %w", d.Id(), err)
+	}
+
+	policyNames, err := readIamRolePolicyNames(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Role (%s) inline policies: %w", d.Id(), err)
+	}
+
+	d.Set("policy_names", aws.StringValueSlice(policyNames))
+	d.Set("role_name", d.Id())
+
+	return nil
+}
+
+func resourceRolePoliciesExclusiveImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("role_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,141 @@
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIAMRolePolicyAttachmentsExclusive_basic(t *testing.T) {
+	var role iam.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy_attachments_exclusive.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(roleResourceName, &role),
+					resource.TestCheckResourceAttrPair(resourceName, "role_name", roleResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_arns.*", "aws_iam_policy.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePolicyAttachmentsExclusive_outOfBandAddition(t *testing.T) {
+	var role iam.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy_attachments_exclusive.test"
+	roleResourceName := "aws_iam_role.test"
+	outOfBandPolicyName := fmt.Sprintf("%s-oob", rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveOutOfBandConfig(rName, outOfBandPolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(roleResourceName, &role),
+					testAccCheckRolePolicyAttachManagedPolicy(&role, outOfBandPolicyName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveOutOfBandConfig(rName, outOfBandPolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(roleResourceName, &role),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_arns.*", "aws_iam_policy.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRolePolicyAttachmentsExclusiveBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_policy" "test" {
+  name = %[1]q
+  path = "/tf-testing/"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "ec2:Describe*"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccRolePolicyAttachmentsExclusiveConfig(rName string) string {
+	return acctest.ConfigCompose(testAccRolePolicyAttachmentsExclusiveBaseConfig(rName), `
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = aws_iam_policy.test.arn
+}
+
+resource "aws_iam_role_policy_attachments_exclusive" "test" {
+  role_name   = aws_iam_role.test.name
+  policy_arns = [aws_iam_role_policy_attachment.test.policy_arn]
+}
+`)
+}
+
+func testAccRolePolicyAttachmentsExclusiveOutOfBandConfig(rName, outOfBandPolicyName string) string {
+	return acctest.ConfigCompose(testAccRolePolicyAttachmentsExclusiveConfig(rName), fmt.Sprintf(`
+resource "aws_iam_policy" "out_of_band" {
+  name = %[1]q
+  path = "/tf-testing/"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "s3:ListAllMyBuckets"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, outOfBandPolicyName))
+}
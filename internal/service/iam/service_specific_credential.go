@@ -0,0 +1,206 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceServiceSpecificCredential() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceSpecificCredentialCreate,
+		Read:   resourceServiceSpecificCredentialRead,
+		Update: resourceServiceSpecificCredentialUpdate,
+		Delete: resourceServiceSpecificCredentialDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"service_specific_credential_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      iam.StatusTypeActive,
+				ValidateFunc: validation.StringInSlice(iam.StatusType_Values(), false),
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func resourceServiceSpecificCredentialCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	input := &iam.CreateServiceSpecificCredentialInput{
+		ServiceName: aws.String(d.Get("service_name").(string)),
+		UserName:    aws.String(d.Get("user_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating IAM Service Specific Credential: %s", input)
+	output, err := conn.CreateServiceSpecificCredential(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IAM Service Specific Credential: %w", err)
+	}
+
+	cred := output.ServiceSpecificCredential
+
+	d.SetId(ServiceSpecificCredentialCreateResourceID(aws.StringValue(cred.ServiceName), aws.StringValue(cred.UserName), aws.StringValue(cred.ServiceSpecificCredentialId)))
+	// The password is only available in the response to the create call.
+	d.Set("service_password", cred.ServicePassword)
+
+	if v := d.Get("status").(string); v == iam.StatusTypeInactive {
+		input := &iam.UpdateServiceSpecificCredentialInput{
+			ServiceSpecificCredentialId: cred.ServiceSpecificCredentialId,
+			Status:                      aws.String(v),
+			UserName:                    cred.UserName,
+		}
+
+		if _, err := conn.UpdateServiceSpecificCredential(input); err != nil {
+			return fmt.Errorf("error setting IAM Service Specific Credential (%s) status: %w", d.Id(), err)
+		}
+	}
+
+	return resourceServiceSpecificCredentialRead(d, meta)
+}
+
+func resourceServiceSpecificCredentialRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	serviceName, userName, credID, err := ServiceSpecificCredentialParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	var cred *iam.ServiceSpecificCredentialMetadata
+
+	err = resource.Retry(PropagationTimeout, func() *resource.RetryError {
+		var err error
+
+		cred, err = FindServiceSpecificCredential(conn, serviceName, userName, credID)
+
+		if d.IsNewResource() && tfresource.NotFound(err) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		cred, err = FindServiceSpecificCredential(conn, serviceName, userName, credID)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Service Specific Credential (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Service Specific Credential (%s): %w", d.Id(), err)
+	}
+
+	d.Set("service_name", cred.ServiceName)
+	d.Set("service_specific_credential_id", cred.ServiceSpecificCredentialId)
+	d.Set("service_user_name", cred.ServiceUserName)
+	d.Set("status", cred.Status)
+	d.Set("user_name", cred.UserName)
+
+	return nil
+}
+
+func resourceServiceSpecificCredentialUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	if d.HasChange("status") {
+		input := &iam.UpdateServiceSpecificCredentialInput{
+			ServiceSpecificCredentialId: aws.String(d.Get("service_specific_credential_id").(string)),
+			Status:                      aws.String(d.Get("status").(string)),
+			UserName:                    aws.String(d.Get("user_name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating IAM Service Specific Credential: %s", input)
+		if _, err := conn.UpdateServiceSpecificCredential(input); err != nil {
+			return fmt.Errorf("error updating IAM Service Specific Credential (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceServiceSpecificCredentialRead(d, meta)
+}
+
+func resourceServiceSpecificCredentialDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	log.Printf("[DEBUG] Deleting IAM Service Specific Credential: %s", d.Id())
+	_, err := conn.DeleteServiceSpecificCredential(&iam.DeleteServiceSpecificCredentialInput{
+		ServiceSpecificCredentialId: aws.String(d.Get("service_specific_credential_id").(string)),
+		UserName:                    aws.String(d.Get("user_name").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IAM Service Specific Credential (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const serviceSpecificCredentialResourceIDSeparator = ":"
+
+func ServiceSpecificCredentialCreateResourceID(serviceName, userName, credID string) string {
+	parts := []string{serviceName, userName, credID}
+	id := strings.Join(parts, serviceSpecificCredentialResourceIDSeparator)
+
+	return id
+}
+
+func ServiceSpecificCredentialParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, serviceSpecificCredentialResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected SERVICE_NAME%[2]sUSER_NAME%[2]sSERVICE_SPECIFIC_CREDENTIAL_ID", id, serviceSpecificCredentialResourceIDSeparator)
+}
@@ -0,0 +1,195 @@
+package iam
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceSigningCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSigningCertificateCreate,
+		Read:   resourceSigningCertificateRead,
+		Update: resourceSigningCertificateUpdate,
+		Delete: resourceSigningCertificateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_body": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressNormalizeCertRemoval,
+			},
+			"certificate_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      iam.StatusTypeActive,
+				ValidateFunc: validation.StringInSlice(iam.StatusType_Values(), false),
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func resourceSigningCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	input := &iam.UploadSigningCertificateInput{
+		CertificateBody: aws.String(d.Get("certificate_body").(string)),
+		UserName:        aws.String(d.Get("user_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating IAM Signing Certificate: %s", input)
+	output, err := conn.UploadSigningCertificate(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IAM Signing Certificate: %w", err)
+	}
+
+	cert := output.Certificate
+
+	d.SetId(SigningCertificateCreateResourceID(aws.StringValue(cert.CertificateId), aws.StringValue(cert.UserName)))
+
+	if v := d.Get("status").(string); v == iam.StatusTypeInactive {
+		input := &iam.UpdateSigningCertificateInput{
+			CertificateId: cert.CertificateId,
+			Status:        aws.String(v),
+			UserName:      cert.UserName,
+		}
+
+		if _, err := conn.UpdateSigningCertificate(input); err != nil {
+			return fmt.Errorf("error setting IAM Signing Certificate (%s) status: %w", d.Id(), err)
+		}
+	}
+
+	return resourceSigningCertificateRead(d, meta)
+}
+
+func resourceSigningCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	certID, userName, err := SigningCertificateParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	var cert *iam.SigningCertificate
+
+	err = resource.Retry(PropagationTimeout, func() *resource.RetryError {
+		var err error
+
+		cert, err = FindSigningCertificate(conn, userName, certID)
+
+		if d.IsNewResource() && tfresource.NotFound(err) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		cert, err = FindSigningCertificate(conn, userName, certID)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Signing Certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Signing Certificate (%s): %w", d.Id(), err)
+	}
+
+	d.Set("certificate_body", cert.CertificateBody)
+	d.Set("certificate_id", cert.CertificateId)
+	d.Set("status", cert.Status)
+	d.Set("user_name", cert.UserName)
+
+	return nil
+}
+
+func resourceSigningCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	if d.HasChange("status") {
+		input := &iam.UpdateSigningCertificateInput{
+			CertificateId: aws.String(d.Get("certificate_id").(string)),
+			Status:        aws.String(d.Get("status").(string)),
+			UserName:      aws.String(d.Get("user_name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating IAM Signing Certificate: %s", input)
+		if _, err := conn.UpdateSigningCertificate(input); err != nil {
+			return fmt.Errorf("error updating IAM Signing Certificate (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceSigningCertificateRead(d, meta)
+}
+
+func resourceSigningCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn()
+
+	log.Printf("[DEBUG] Deleting IAM Signing Certificate: %s", d.Id())
+	_, err := conn.DeleteSigningCertificate(&iam.DeleteSigningCertificateInput{
+		CertificateId: aws.String(d.Get("certificate_id").(string)),
+		UserName:      aws.String(d.Get("user_name").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IAM Signing Certificate (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const signingCertificateResourceIDSeparator = ":"
+
+func SigningCertificateCreateResourceID(certID, userName string) string {
+	parts := []string{certID, userName}
+	id := strings.Join(parts, signingCertificateResourceIDSeparator)
+
+	return id
+}
+
+func SigningCertificateParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, signingCertificateResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CERTIFICATE_ID%[2]sUSER_NAME", id, signingCertificateResourceIDSeparator)
+}
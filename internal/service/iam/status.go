@@ -0,0 +1,145 @@
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	errCodeAccessDenied = "AccessDenied"
+
+	roleStatusAssumable = "Assumable"
+	roleStatusPending   = "Pending"
+)
+
+// statusRoleAssumable fetches the role and reports whether it can be assumed.
+// A role that cannot be found yet is reported as pending. As conditions and
+// wildcards are not evaluated, a trust policy that does not appear to allow
+// the specified service principal is only logged.
+func statusRoleAssumable(conn *iam.IAM, roleARN, service string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		// Some services also accept a role name, optionally prefixed with its path.
+		roleName := roleARN[strings.LastIndex(roleARN, ARNSeparator)+1:]
+
+		if arn.IsARN(roleARN) {
+			var err error
+
+			roleName, err = RoleARNToName(roleARN)
+
+			if err != nil {
+				return nil, "", err
+			}
+		}
+
+		role, err := FindRoleByName(conn, roleName)
+
+		if tfresource.NotFound(err) {
+			return nil, roleStatusPending, nil
+		}
+
+		// The caller may be permitted to pass the role without being able to read it.
+		if tfawserr.ErrCodeEquals(err, errCodeAccessDenied) {
+			log.Printf("[WARN] Unable to read IAM Role (%s) trust policy, assuming it is assumable by %s: %s", roleName, service, err)
+			return roleName, roleStatusAssumable, nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if ok, err := roleTrustPolicyAllowsService(aws.StringValue(role.AssumeRolePolicyDocument), service); err != nil {
+			log.Printf("[WARN] Unable to check IAM Role (%s) trust policy for %s: %s", roleName, service, err)
+		} else if !ok {
+			log.Printf("[WARN] IAM Role (%s) trust policy does not appear to allow %s to assume it", roleName, service)
+		}
+
+		return role, roleStatusAssumable, nil
+	}
+}
+
+// roleTrustPolicyAllowsService returns whether the (URL-encoded) trust policy
+// contains an Allow statement for sts:AssumeRole with the specified service principal.
+// Conditions are not evaluated.
+func roleTrustPolicyAllowsService(document, service string) (bool, error) {
+	document, err := url.QueryUnescape(document)
+
+	if err != nil {
+		return false, fmt.Errorf("error decoding IAM Role trust policy: %w", err)
+	}
+
+	var doc struct {
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		return false, fmt.Errorf("error parsing IAM Role trust policy: %w", err)
+	}
+
+	type trustStatement struct {
+		Action    interface{}
+		Effect    string
+		Principal interface{}
+	}
+
+	var statements []trustStatement
+
+	if err := json.Unmarshal(doc.Statement, &statements); err != nil {
+		var statement trustStatement
+
+		if err := json.Unmarshal(doc.Statement, &statement); err != nil {
+			return false, fmt.Errorf("error parsing IAM Role trust policy statements: %w", err)
+		}
+
+		statements = append(statements, statement)
+	}
+
+	for _, statement := range statements {
+		if !strings.EqualFold(statement.Effect, "Allow") {
+			continue
+		}
+
+		if !trustPolicyValueMatches(statement.Action, func(v string) bool {
+			return v == "*" || strings.EqualFold(v, "sts:*") || strings.EqualFold(v, "sts:AssumeRole")
+		}) {
+			continue
+		}
+
+		switch principal := statement.Principal.(type) {
+		case string:
+			if principal == "*" {
+				return true, nil
+			}
+		case map[string]interface{}:
+			if trustPolicyValueMatches(principal["Service"], func(v string) bool { return v == service }) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func trustPolicyValueMatches(v interface{}, f func(string) bool) bool {
+	switch v := v.(type) {
+	case string:
+		return f(v)
+	case []interface{}:
+		for _, v := range v {
+			if v, ok := v.(string); ok && f(v) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
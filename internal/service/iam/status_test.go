@@ -0,0 +1,83 @@
+package iam
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestRoleTrustPolicyAllowsService(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		Document      string
+		Service       string
+		ExpectedError bool
+		Expected      bool
+	}{
+		{
+			TestName:      "invalid JSON",
+			Document:      `{`,
+			Service:       "lambda.amazonaws.com",
+			ExpectedError: true,
+		},
+		{
+			TestName: "statement list",
+			Document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"lambda.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			Service:  "lambda.amazonaws.com",
+			Expected: true,
+		},
+		{
+			TestName: "single statement",
+			Document: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":{"Service":["glue.amazonaws.com","lambda.amazonaws.com"]},"Action":["sts:AssumeRole"]}}`,
+			Service:  "lambda.amazonaws.com",
+			Expected: true,
+		},
+		{
+			TestName: "URL encoded",
+			Document: url.QueryEscape(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"firehose.amazonaws.com"},"Action":"sts:AssumeRole"}]}`),
+			Service:  "firehose.amazonaws.com",
+			Expected: true,
+		},
+		{
+			TestName: "wildcard principal",
+			Document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"sts:*"}]}`,
+			Service:  "lambda.amazonaws.com",
+			Expected: true,
+		},
+		{
+			TestName: "other service",
+			Document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"glue.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			Service:  "lambda.amazonaws.com",
+			Expected: false,
+		},
+		{
+			TestName: "deny",
+			Document: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":{"Service":"lambda.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			Service:  "lambda.amazonaws.com",
+			Expected: false,
+		},
+		{
+			TestName: "other action",
+			Document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"lambda.amazonaws.com"},"Action":"sts:TagSession"}]}`,
+			Service:  "lambda.amazonaws.com",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := roleTrustPolicyAllowsService(testCase.Document, testCase.Service)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
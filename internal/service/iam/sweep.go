@@ -95,6 +95,11 @@ func init() {
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 	})
+
+	resource.AddTestSweepers("aws_iam_virtual_mfa_device", &resource.Sweeper{
+		Name: "aws_iam_virtual_mfa_device",
+		F:    sweepVirtualMFADevices,
+	})
 }
 
 func sweepGroups(region string) error {
@@ -104,7 +109,7 @@ func sweepGroups(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 	input := &iam.ListGroupsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -217,7 +222,7 @@ func sweepInstanceProfile(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -274,7 +279,7 @@ func sweepOpenIDConnectProvider(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -315,7 +320,7 @@ func sweepPolicies(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 	input := &iam.ListPoliciesInput{
 		Scope: aws.String(iam.PolicyScopeTypeLocal),
 	}
@@ -381,7 +386,7 @@ func sweepRoles(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 
 	roles := make([]string, 0)
 	err = conn.ListRolesPages(&iam.ListRolesInput{}, func(page *iam.ListRolesOutput, lastPage bool) bool {
@@ -440,7 +445,7 @@ func sweepSamlProvider(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 
 	var sweeperErrs *multierror.Error
 
@@ -479,7 +484,7 @@ func sweepServerCertificates(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 
 	err = conn.ListServerCertificatesPages(&iam.ListServerCertificatesInput{}, func(out *iam.ListServerCertificatesOutput, lastPage bool) bool {
 		for _, sc := range out.ServerCertificateMetadataList {
@@ -512,7 +517,7 @@ func sweepServiceLinkedRoles(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 
 	input := &iam.ListRolesInput{
 		PathPrefix: aws.String("/aws-service-role/"),
@@ -569,7 +574,7 @@ func sweepUsers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).IAMConn
+	conn := client.(*conns.AWSClient).IAMConn()
 	prefixes := []string{
 		"test-user",
 		"test_user",
@@ -781,3 +786,54 @@ func roleNameFilter(name string) bool {
 
 	return false
 }
+
+func sweepVirtualMFADevices(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).IAMConn()
+	input := &iam.ListVirtualMFADevicesInput{
+		AssignmentStatus: aws.String(iam.AssignmentStatusTypeUnassigned),
+	}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListVirtualMFADevicesPages(input, func(page *iam.ListVirtualMFADevicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, device := range page.VirtualMFADevices {
+			serialNumber := aws.StringValue(device.SerialNumber)
+
+			if _, name, err := VirtualMFADeviceARNToPathAndName(serialNumber); err != nil || !strings.HasPrefix(name, "tf-acc-test") {
+				continue
+			}
+
+			r := ResourceVirtualMFADevice()
+			d := r.Data(nil)
+			d.SetId(serialNumber)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping IAM Virtual MFA Device sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IAM Virtual MFA Devices (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping IAM Virtual MFA Devices (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -2,6 +2,9 @@ package iam
 
 import (
 	"time"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 const (
@@ -12,3 +15,22 @@ const (
 	// Reference: https://docs.aws.amazon.com/IAM/latest/UserGuide/troubleshoot_general.html#troubleshoot_general_eventual-consistency
 	PropagationTimeout = 2 * time.Minute
 )
+
+// WaitRoleAssumable waits for the specified IAM role (ARN or name) to be
+// readable before it is passed to the specified service principal,
+// e.g. lambda.amazonaws.com.
+// The target service may see the role later than IAM does, so callers should
+// still retry their own "unable to assume role" errors.
+func WaitRoleAssumable(conn *iam.IAM, roleARN, service string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{roleStatusPending},
+		Target:     []string{roleStatusAssumable},
+		Refresh:    statusRoleAssumable(conn, roleARN, service),
+		Timeout:    PropagationTimeout,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
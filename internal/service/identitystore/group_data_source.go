@@ -61,7 +61,7 @@ func DataSourceGroup() *schema.Resource {
 }
 
 func dataSourceGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IdentityStoreConn
+	conn := meta.(*conns.AWSClient).IdentityStoreConn()
 
 	input := &identitystore.ListGroupsInput{
 		IdentityStoreId: aws.String(d.Get("identity_store_id").(string)),
@@ -61,7 +61,7 @@ func DataSourceUser() *schema.Resource {
 }
 
 func dataSourceUserRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).IdentityStoreConn
+	conn := meta.(*conns.AWSClient).IdentityStoreConn()
 
 	input := &identitystore.ListUsersInput{
 		IdentityStoreId: aws.String(d.Get("identity_store_id").(string)),
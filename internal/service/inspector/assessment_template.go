@@ -60,7 +60,7 @@ func ResourceAssessmentTemplate() *schema.Resource {
 }
 
 func resourceAssessmentTemplateCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).InspectorConn
+	conn := meta.(*conns.AWSClient).InspectorConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -89,7 +89,7 @@ func resourceAssessmentTemplateCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAssessmentTemplateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).InspectorConn
+	conn := meta.(*conns.AWSClient).InspectorConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -127,7 +127,7 @@ func resourceAssessmentTemplateRead(d *schema.ResourceData, meta interface{}) er
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -139,7 +139,7 @@ func resourceAssessmentTemplateRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAssessmentTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).InspectorConn
+	conn := meta.(*conns.AWSClient).InspectorConn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -153,7 +153,7 @@ func resourceAssessmentTemplateUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAssessmentTemplateDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).InspectorConn
+	conn := meta.(*conns.AWSClient).InspectorConn()
 
 	_, err := conn.DeleteAssessmentTemplate(&inspector.DeleteAssessmentTemplateInput{
 		AssessmentTemplateArn: aws.String(d.Id()),
@@ -26,7 +26,7 @@ func DataSourceRulesPackages() *schema.Resource {
 }
 
 func dataSourceRulesPackagesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).InspectorConn
+	conn := meta.(*conns.AWSClient).InspectorConn()
 
 	log.Printf("[DEBUG] Reading Rules Packages.")
 
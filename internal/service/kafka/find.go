@@ -33,6 +33,31 @@ func FindClusterByARN(conn *kafka.Kafka, arn string) (*kafka.ClusterInfo, error)
 	return output.ClusterInfo, nil
 }
 
+func FindBootstrapBrokersByClusterARN(conn *kafka.Kafka, arn string) (*kafka.GetBootstrapBrokersOutput, error) {
+	input := &kafka.GetBootstrapBrokersInput{
+		ClusterArn: aws.String(arn),
+	}
+
+	output, err := conn.GetBootstrapBrokers(input)
+
+	if tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func FindClusterOperationByARN(conn *kafka.Kafka, arn string) (*kafka.ClusterOperationInfo, error) {
 	input := &kafka.DescribeClusterOperationInput{
 		ClusterOperationArn: aws.String(arn),
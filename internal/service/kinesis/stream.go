@@ -117,7 +117,7 @@ func resourceStreamImport(
 }
 
 func resourceStreamCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KinesisConn
+	conn := meta.(*conns.AWSClient).KinesisConn()
 	sn := d.Get("name").(string)
 	createOpts := &kinesis.CreateStreamInput{
 		ShardCount: aws.Int64(int64(d.Get("shard_count").(int))),
@@ -155,7 +155,7 @@ func resourceStreamCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceStreamUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KinesisConn
+	conn := meta.(*conns.AWSClient).KinesisConn()
 
 	sn := d.Get("name").(string)
 	if d.HasChange("tags_all") {
@@ -184,7 +184,7 @@ func resourceStreamUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceStreamRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KinesisConn
+	conn := meta.(*conns.AWSClient).KinesisConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -223,7 +223,7 @@ func resourceStreamRead(d *schema.ResourceData, meta interface{}) error {
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -235,7 +235,7 @@ func resourceStreamRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceStreamDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KinesisConn
+	conn := meta.(*conns.AWSClient).KinesisConn()
 	sn := d.Get("name").(string)
 
 	_, err := conn.DeleteStream(&kinesis.DeleteStreamInput{
@@ -406,7 +406,7 @@ func testAccCheckKinesisStreamExists(n string, stream *kinesis.StreamDescription
 			return fmt.Errorf("No Kinesis ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisConn()
 		describeOpts := &kinesis.DescribeStreamInput{
 			StreamName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -447,7 +447,7 @@ func testAccCheckKinesisStreamDestroy(s *terraform.State) error {
 		if rs.Type != "aws_kinesis_stream" {
 			continue
 		}
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisConn()
 		describeOpts := &kinesis.DescribeStreamInput{
 			StreamName: aws.String(rs.Primary.Attributes["name"]),
 		}
@@ -467,7 +467,7 @@ func testAccCheckKinesisStreamDestroy(s *terraform.State) error {
 
 func testAccStreamRegisterStreamConsumer(stream *kinesis.StreamDescription, rStr string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisConn()
 
 		if _, err := conn.RegisterStreamConsumer(&kinesis.RegisterStreamConsumerInput{
 			ConsumerName: aws.String(rStr),
@@ -3922,7 +3922,7 @@ func TestAccKinesisAnalyticsV2Application_SQLApplicationVPC_update(t *testing.T)
 }
 
 func testAccCheckApplicationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisAnalyticsV2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisAnalyticsV2Conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_kinesisanalyticsv2_application" {
@@ -3955,7 +3955,7 @@ func testAccCheckApplicationExists(n string, v *kinesisanalyticsv2.ApplicationDe
 			return fmt.Errorf("No Kinesis Analytics v2 Application ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisAnalyticsV2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisAnalyticsV2Conn()
 
 		application, err := tfkinesisanalyticsv2.FindApplicationDetailByName(conn, rs.Primary.Attributes["name"])
 
@@ -3970,7 +3970,7 @@ func testAccCheckApplicationExists(n string, v *kinesisanalyticsv2.ApplicationDe
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisAnalyticsV2Conn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisAnalyticsV2Conn()
 
 	input := &kinesisanalyticsv2.ListApplicationsInput{}
 
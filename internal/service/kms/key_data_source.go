@@ -81,7 +81,7 @@ func DataSourceKey() *schema.Resource {
 }
 
 func dataSourceKeyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).KMSConn
+	conn := meta.(*conns.AWSClient).KMSConn()
 	keyId := d.Get("key_id")
 	var grantTokens []*string
 	if v, ok := d.GetOk("grant_tokens"); ok {
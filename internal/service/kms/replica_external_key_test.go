@@ -0,0 +1,164 @@
+package kms_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKMSReplicaExternalKey_basic(t *testing.T) {
+	var providers []*schema.Provider
+	var key kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	primaryKeyResourceName := "aws_kms_external_key.test"
+	resourceName := "aws_kms_replica_external_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckReplicaExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				// ACCEPTANCE TESTING ONLY -- NEVER EXPOSE YOUR KEY MATERIAL
+				Config: testAccReplicaExternalKeyConfig(rName, "Wblj06fduthWggmsT0cLVoIMOkeLbc2kVfMud77i/JY="),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicaExternalKeyExists(resourceName, &key),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kms", regexp.MustCompile(`key/mrk-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "bypass_policy_lockout_safety_check", "false"),
+					resource.TestCheckResourceAttr(resourceName, "deletion_window_in_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_model", "KEY_MATERIAL_DOES_NOT_EXPIRE"),
+					resource.TestCheckResourceAttr(resourceName, "key_state", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "key_usage", "ENCRYPT_DECRYPT"),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_key_arn", primaryKeyResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "valid_to", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"bypass_policy_lockout_safety_check",
+					"deletion_window_in_days",
+					"key_material_base64",
+				},
+			},
+		},
+	})
+}
+
+func TestAccKMSReplicaExternalKey_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	var key kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_replica_external_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckReplicaExternalKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				// ACCEPTANCE TESTING ONLY -- NEVER EXPOSE YOUR KEY MATERIAL
+				Config: testAccReplicaExternalKeyConfig(rName, "Wblj06fduthWggmsT0cLVoIMOkeLbc2kVfMud77i/JY="),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicaExternalKeyExists(resourceName, &key),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkms.ResourceReplicaExternalKey(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckReplicaExternalKeyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_replica_external_key" {
+			continue
+		}
+
+		_, err := tfkms.FindKeyByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("KMS Replica External Key %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckReplicaExternalKeyExists(name string, key *kms.KeyMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KMS Replica External Key ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn()
+
+		outputRaw, err := tfresource.RetryWhenNotFound(tfkms.PropagationTimeout, func() (interface{}, error) {
+			return tfkms.FindKeyByID(conn, rs.Primary.ID)
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*key = *(outputRaw.(*kms.KeyMetadata))
+
+		return nil
+	}
+}
+
+func testAccReplicaExternalKeyConfig(rName, keyMaterialBase64 string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateRegionProvider(),
+		fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  provider = "awsalternate"
+
+  description             = %[1]q
+  deletion_window_in_days = 7
+  key_material_base64     = %[2]q
+  multi_region            = true
+}
+
+resource "aws_kms_replica_external_key" "test" {
+  primary_key_arn     = aws_kms_external_key.test.arn
+  key_material_base64 = %[2]q
+}
+`, rName, keyMaterialBase64))
+}
@@ -0,0 +1,328 @@
+package kms_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKMSReplicaKey_basic(t *testing.T) {
+	var providers []*schema.Provider
+	var key kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	primaryKeyResourceName := "aws_kms_key.test"
+	resourceName := "aws_kms_replica_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckReplicaKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicaKeyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicaKeyExists(resourceName, &key),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kms", regexp.MustCompile(`key/mrk-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "bypass_policy_lockout_safety_check", "false"),
+					resource.TestCheckResourceAttr(resourceName, "deletion_window_in_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "key_rotation_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "key_spec", "SYMMETRIC_DEFAULT"),
+					resource.TestCheckResourceAttr(resourceName, "key_usage", "ENCRYPT_DECRYPT"),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_key_arn", primaryKeyResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"bypass_policy_lockout_safety_check",
+					"deletion_window_in_days",
+				},
+			},
+		},
+	})
+}
+
+func TestAccKMSReplicaKey_disappears(t *testing.T) {
+	var providers []*schema.Provider
+	var key kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_replica_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckReplicaKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicaKeyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicaKeyExists(resourceName, &key),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkms.ResourceReplicaKey(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKMSReplicaKey_descriptionAndEnabled(t *testing.T) {
+	var providers []*schema.Provider
+	var key1, key2, key3 kms.KeyMetadata
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName3 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_replica_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckReplicaKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicaKeyDescriptionAndEnabledConfig(rName1, rName2, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicaKeyExists(resourceName, &key1),
+					resource.TestCheckResourceAttr(resourceName, "description", rName2),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"bypass_policy_lockout_safety_check",
+					"deletion_window_in_days",
+				},
+			},
+			{
+				Config: testAccReplicaKeyDescriptionAndEnabledConfig(rName1, rName3, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicaKeyExists(resourceName, &key2),
+					testAccCheckReplicaKeyNotRecreated(&key1, &key2),
+					resource.TestCheckResourceAttr(resourceName, "description", rName3),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccReplicaKeyDescriptionAndEnabledConfig(rName1, rName1, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicaKeyExists(resourceName, &key3),
+					testAccCheckReplicaKeyNotRecreated(&key2, &key3),
+					resource.TestCheckResourceAttr(resourceName, "description", rName1),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSReplicaKey_tags(t *testing.T) {
+	var providers []*schema.Provider
+	var key kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_replica_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, kms.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckReplicaKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplicaKeyTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicaKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"bypass_policy_lockout_safety_check",
+					"deletion_window_in_days",
+				},
+			},
+			{
+				Config: testAccReplicaKeyTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicaKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccReplicaKeyTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicaKeyExists(resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckReplicaKeyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_replica_key" {
+			continue
+		}
+
+		_, err := tfkms.FindKeyByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("KMS Replica Key %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckReplicaKeyExists(name string, key *kms.KeyMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No KMS Replica Key ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSConn()
+
+		outputRaw, err := tfresource.RetryWhenNotFound(tfkms.PropagationTimeout, func() (interface{}, error) {
+			return tfkms.FindKeyByID(conn, rs.Primary.ID)
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*key = *(outputRaw.(*kms.KeyMetadata))
+
+		return nil
+	}
+}
+
+func testAccCheckReplicaKeyNotRecreated(i, j *kms.KeyMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !aws.TimeValue(i.CreationDate).Equal(aws.TimeValue(j.CreationDate)) {
+			return fmt.Errorf("KMS Replica Key recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccReplicaKeyBaseConfig(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateRegionProvider(),
+		fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  provider = "awsalternate"
+
+  description             = %[1]q
+  deletion_window_in_days = 7
+  multi_region            = true
+}
+`, rName))
+}
+
+func testAccReplicaKeyConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccReplicaKeyBaseConfig(rName),
+		`
+resource "aws_kms_replica_key" "test" {
+  primary_key_arn = aws_kms_key.test.arn
+}
+`)
+}
+
+func testAccReplicaKeyDescriptionAndEnabledConfig(rName, description string, enabled bool) string {
+	return acctest.ConfigCompose(
+		testAccReplicaKeyBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_kms_replica_key" "test" {
+  primary_key_arn         = aws_kms_key.test.arn
+  deletion_window_in_days = 7
+  description             = %[1]q
+  enabled                 = %[2]t
+}
+`, description, enabled))
+}
+
+func testAccReplicaKeyTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(
+		testAccReplicaKeyBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_kms_replica_key" "test" {
+  primary_key_arn         = aws_kms_key.test.arn
+  deletion_window_in_days = 7
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccReplicaKeyTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(
+		testAccReplicaKeyBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_kms_replica_key" "test" {
+  primary_key_arn         = aws_kms_key.test.arn
+  deletion_window_in_days = 7
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
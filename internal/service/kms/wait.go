@@ -25,6 +25,8 @@ const (
 	KeyStatePropagationTimeout       = 20 * time.Minute
 	KeyTagsPropagationTimeout        = 5 * time.Minute
 	KeyValidToPropagationTimeout     = 5 * time.Minute
+	ReplicaExternalKeyCreatedTimeout = 2 * time.Minute
+	ReplicaKeyCreatedTimeout         = 2 * time.Minute
 
 	PropagationTimeout = 2 * time.Minute
 )
@@ -189,6 +191,40 @@ func WaitKeyValidToPropagated(conn *kms.KMS, id string, validTo string) error {
 	return tfresource.WaitUntil(KeyValidToPropagationTimeout, checkFunc, opts)
 }
 
+func WaitReplicaExternalKeyCreated(conn *kms.KMS, id string) (*kms.KeyMetadata, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kms.KeyStateCreating},
+		Target:  []string{kms.KeyStatePendingImport},
+		Refresh: StatusKeyState(conn, id),
+		Timeout: ReplicaExternalKeyCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kms.KeyMetadata); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitReplicaKeyCreated(conn *kms.KMS, id string) (*kms.KeyMetadata, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kms.KeyStateCreating},
+		Target:  []string{kms.KeyStateEnabled},
+		Refresh: StatusKeyState(conn, id),
+		Timeout: ReplicaKeyCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kms.KeyMetadata); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitTagsPropagated(conn *kms.KMS, id string, tags tftags.KeyValueTags) error {
 	checkFunc := func() (bool, error) {
 		output, err := ListTags(conn, id)
@@ -176,3 +176,26 @@ func FilterDatabasePermissions(principal *string, allPermissions []*lakeformatio
 
 	return cleanPermissions
 }
+
+// FilterImplicitIAMAllowedPrincipalsPermissions removes the ALL permission that Lake Formation
+// implicitly grants IAM_ALLOWED_PRINCIPALS on new databases and tables (when the data lake's
+// default permissions are in effect) unless ALL is part of the configured permissions.
+func FilterImplicitIAMAllowedPrincipalsPermissions(permissions []string, configured []string) []string {
+	for _, v := range configured {
+		if v == lakeformation.PermissionAll {
+			return permissions
+		}
+	}
+
+	var cleanPermissions []string
+
+	for _, v := range permissions {
+		if v == lakeformation.PermissionAll {
+			continue
+		}
+
+		cleanPermissions = append(cleanPermissions, v)
+	}
+
+	return cleanPermissions
+}
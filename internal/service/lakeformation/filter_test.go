@@ -545,3 +545,47 @@ func TestFilterPermissions(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterImplicitIAMAllowedPrincipalsPermissions(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Permissions []string
+		Configured  []string
+		Expected    []string
+	}{
+		{
+			Name:        "implicit ALL removed",
+			Permissions: []string{lakeformation.PermissionAll, lakeformation.PermissionDescribe},
+			Configured:  []string{lakeformation.PermissionDescribe},
+			Expected:    []string{lakeformation.PermissionDescribe},
+		},
+		{
+			Name:        "only implicit ALL",
+			Permissions: []string{lakeformation.PermissionAll},
+			Configured:  []string{},
+			Expected:    nil,
+		},
+		{
+			Name:        "configured ALL kept",
+			Permissions: []string{lakeformation.PermissionAll},
+			Configured:  []string{lakeformation.PermissionAll},
+			Expected:    []string{lakeformation.PermissionAll},
+		},
+		{
+			Name:        "no ALL",
+			Permissions: []string{lakeformation.PermissionAlter, lakeformation.PermissionDrop},
+			Configured:  []string{lakeformation.PermissionAlter, lakeformation.PermissionDrop},
+			Expected:    []string{lakeformation.PermissionAlter, lakeformation.PermissionDrop},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := tflakeformation.FilterImplicitIAMAllowedPrincipalsPermissions(testCase.Permissions, testCase.Configured)
+
+			if !reflect.DeepEqual(testCase.Expected, got) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
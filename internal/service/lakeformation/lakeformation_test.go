@@ -12,6 +12,10 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"disappears":       testAccDataLakeSettings_disappears,
 			"withoutCatalogId": testAccDataLakeSettings_withoutCatalogID,
 		},
+		"LFTag": {
+			"basic":      testAccLFTag_basic,
+			"disappears": testAccLFTag_disappears,
+		},
 		"PermissionsBasic": {
 			"basic":              testAccPermissions_basic,
 			"database":           testAccPermissions_database,
@@ -20,6 +24,9 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"dataLocation":       testAccPermissions_dataLocation,
 			"disappears":         testAccPermissions_disappears,
 		},
+		"PermissionsBatch": {
+			"basic": testAccPermissionsBatch_basic,
+		},
 		"PermissionsDataSource": {
 			"basic":            testAccPermissionsDataSource_basic,
 			"database":         testAccPermissionsDataSource_database,
@@ -45,6 +52,11 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"wildcardSelectOnly":      testAccPermissions_twcWildcardSelectOnly,
 			"wildcardSelectPlus":      testAccPermissions_twcWildcardSelectPlus,
 		},
+		"ResourceLFTag": {
+			"database":         testAccResourceLFTag_database,
+			"table":            testAccResourceLFTag_table,
+			"tableWithColumns": testAccResourceLFTag_tableWithColumns,
+		},
 	}
 
 	for group, m := range testCases {
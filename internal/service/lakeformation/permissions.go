@@ -23,6 +23,7 @@ func ResourcePermissions() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePermissionsCreate,
 		Read:   resourcePermissionsRead,
+		Update: schema.Noop,
 		Delete: resourcePermissionsDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -106,6 +107,11 @@ func ResourcePermissions() *schema.Resource {
 					},
 				},
 			},
+			"ignore_implicit_iam_allowed_principals": {
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
+			},
 			"permissions": {
 				Type:     schema.TypeList,
 				ForceNew: true,
@@ -267,7 +273,7 @@ func ResourcePermissions() *schema.Resource {
 // returns.
 
 func resourcePermissionsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LakeFormationConn
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
 
 	input := &lakeformation.GrantPermissionsInput{
 		Permissions: flex.ExpandStringList(d.Get("permissions").([]interface{})),
@@ -349,7 +355,7 @@ func resourcePermissionsCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourcePermissionsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LakeFormationConn
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
 
 	input := &lakeformation.ListPermissionsInput{
 		Principal: &lakeformation.DataLakePrincipal{
@@ -454,9 +460,20 @@ func resourcePermissionsRead(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[INFO] Resource Lake Formation clean permissions (%d) and all permissions (%d) have different lengths (this is not necessarily a problem): %s", len(cleanPermissions), len(allPermissions), d.Id())
 	}
 
+	permissions := flattenLakeFormationPermissions(cleanPermissions)
+	permissionsWithGrantOption := flattenLakeFormationGrantPermissions(cleanPermissions)
+
+	// With the default data lake settings, Lake Formation grants ALL to IAM_ALLOWED_PRINCIPALS on every
+	// new database and table. That grant cannot be told apart from an explicit one and otherwise
+	// causes a perpetual diff when a narrower set of permissions is configured.
+	if d.Get("ignore_implicit_iam_allowed_principals").(bool) && aws.StringValue(cleanPermissions[0].Principal.DataLakePrincipalIdentifier) == IAMAllowedPrincipals {
+		permissions = FilterImplicitIAMAllowedPrincipalsPermissions(permissions, aws.StringValueSlice(flex.ExpandStringList(d.Get("permissions").([]interface{}))))
+		permissionsWithGrantOption = FilterImplicitIAMAllowedPrincipalsPermissions(permissionsWithGrantOption, aws.StringValueSlice(flex.ExpandStringList(d.Get("permissions_with_grant_option").([]interface{}))))
+	}
+
 	d.Set("principal", cleanPermissions[0].Principal.DataLakePrincipalIdentifier)
-	d.Set("permissions", flattenLakeFormationPermissions(cleanPermissions))
-	d.Set("permissions_with_grant_option", flattenLakeFormationGrantPermissions(cleanPermissions))
+	d.Set("permissions", permissions)
+	d.Set("permissions_with_grant_option", permissionsWithGrantOption)
 
 	if cleanPermissions[0].Resource.Catalog != nil {
 		d.Set("catalog_resource", true)
@@ -534,7 +551,7 @@ func resourcePermissionsRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourcePermissionsDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LakeFormationConn
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
 
 	input := &lakeformation.RevokePermissionsInput{
 		Permissions:                flex.ExpandStringList(d.Get("permissions").([]interface{})),
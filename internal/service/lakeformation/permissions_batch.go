@@ -0,0 +1,729 @@
+package lakeformation
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// BatchGrantPermissions and BatchRevokePermissions accept at most 20 entries per call.
+	permissionsBatchMaxEntries = 20
+)
+
+func ResourcePermissionsBatch() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePermissionsBatchCreate,
+		Read:   resourcePermissionsBatchRead,
+		Update: resourcePermissionsBatchUpdate,
+		Delete: resourcePermissionsBatchDelete,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"entry": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_resource": {
+							Type:     schema.TypeBool,
+							Default:  false,
+							Optional: true,
+						},
+						"data_location": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"catalog_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+								},
+							},
+						},
+						"database": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"permissions": {
+							Type:     schema.TypeSet,
+							MinItems: 1,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+							},
+						},
+						"permissions_with_grant_option": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+							},
+						},
+						"principal": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validPrincipal,
+						},
+						"table": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"database_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"wildcard": {
+										Type:     schema.TypeBool,
+										Default:  false,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"table_with_columns": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"column_names": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Set:      schema.HashString,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+									"database_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"excluded_column_names": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Set:      schema.HashString,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"wildcard": {
+										Type:     schema.TypeBool,
+										Default:  false,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePermissionsBatchCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
+	catalogID := d.Get("catalog_id").(string)
+
+	granted, err := grantPermissionsBatch(conn, catalogID, d.Get("entry").(*schema.Set).List())
+
+	if len(granted) == 0 {
+		if err != nil {
+			return fmt.Errorf("error creating Lake Formation Permissions Batch: %w", err)
+		}
+
+		return fmt.Errorf("error creating Lake Formation Permissions Batch: no entries granted")
+	}
+
+	d.SetId(resource.UniqueId())
+
+	if err != nil {
+		// Keep the entries that were granted so that they are revoked on destroy.
+		if err := d.Set("entry", granted); err != nil {
+			return fmt.Errorf("error setting entry: %w", err)
+		}
+
+		return fmt.Errorf("error creating Lake Formation Permissions Batch (%s): %w", d.Id(), err)
+	}
+
+	return resourcePermissionsBatchRead(d, meta)
+}
+
+func resourcePermissionsBatchRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
+	catalogID := d.Get("catalog_id").(string)
+
+	var entries []interface{}
+
+	for _, tfMapRaw := range d.Get("entry").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		found, err := findPermissionsBatchEntry(conn, catalogID, tfMap, d.IsNewResource())
+
+		if err != nil {
+			return fmt.Errorf("error reading Lake Formation Permissions Batch (%s): %w", d.Id(), err)
+		}
+
+		if !found {
+			log.Printf("[WARN] Lake Formation Permissions Batch (%s) entry for %s not found, removing from state", d.Id(), tfMap["principal"])
+			continue
+		}
+
+		entries = append(entries, tfMap)
+	}
+
+	if !d.IsNewResource() && len(entries) == 0 {
+		log.Printf("[WARN] Lake Formation Permissions Batch (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("entry", entries); err != nil {
+		return fmt.Errorf("error setting entry: %w", err)
+	}
+
+	return nil
+}
+
+func resourcePermissionsBatchUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
+	catalogID := d.Get("catalog_id").(string)
+
+	o, n := d.GetChange("entry")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+	remove, add := os.Difference(ns).List(), ns.Difference(os).List()
+
+	// Entries are identified by their whole content, so a changed entry is revoked and then granted again.
+	entries := os.Intersection(ns)
+
+	revoked, revokeErr := revokePermissionsBatch(conn, catalogID, remove)
+
+	for _, tfMap := range remove {
+		entries.Add(tfMap)
+	}
+
+	for _, tfMap := range revoked {
+		entries.Remove(tfMap)
+	}
+
+	var granted []interface{}
+	var grantErr error
+
+	if revokeErr == nil {
+		granted, grantErr = grantPermissionsBatch(conn, catalogID, add)
+	}
+
+	for _, tfMap := range granted {
+		entries.Add(tfMap)
+
+		// Wait for the new grants to become visible so that the refresh below does not drop them.
+		if _, err := findPermissionsBatchEntry(conn, catalogID, tfMap.(map[string]interface{}), true); err != nil {
+			log.Printf("[WARN] Lake Formation Permissions Batch (%s) entry for %s not yet visible: %s", d.Id(), tfMap.(map[string]interface{})["principal"], err)
+		}
+	}
+
+	if revokeErr != nil || grantErr != nil {
+		if err := d.Set("entry", entries.List()); err != nil {
+			return fmt.Errorf("error setting entry: %w", err)
+		}
+	}
+
+	if revokeErr != nil {
+		return fmt.Errorf("error updating Lake Formation Permissions Batch (%s): revoking: %w", d.Id(), revokeErr)
+	}
+
+	if grantErr != nil {
+		return fmt.Errorf("error updating Lake Formation Permissions Batch (%s): granting: %w", d.Id(), grantErr)
+	}
+
+	return resourcePermissionsBatchRead(d, meta)
+}
+
+func resourcePermissionsBatchDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
+
+	_, err := revokePermissionsBatch(conn, d.Get("catalog_id").(string), d.Get("entry").(*schema.Set).List())
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lake Formation Permissions Batch (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// grantPermissionsBatch grants the permissions of each entry and returns the entries that were granted.
+// Entries that failed are reported in the returned error.
+func grantPermissionsBatch(conn *lakeformation.LakeFormation, catalogID string, tfList []interface{}) ([]interface{}, error) {
+	return processPermissionsBatch(tfList, func(apiObjects []*lakeformation.BatchPermissionsRequestEntry) ([]*lakeformation.BatchPermissionsFailureEntry, error) {
+		input := &lakeformation.BatchGrantPermissionsInput{
+			Entries: apiObjects,
+		}
+
+		if catalogID != "" {
+			input.CatalogId = aws.String(catalogID)
+		}
+
+		log.Printf("[DEBUG] Granting Lake Formation Permissions Batch: %s", input)
+		output, err := conn.BatchGrantPermissions(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return output.Failures, nil
+	}, permissionsBatchGrantRetryable, nil)
+}
+
+// revokePermissionsBatch revokes the permissions of each entry and returns the entries that were revoked
+// or were already gone. Entries that failed are reported in the returned error.
+func revokePermissionsBatch(conn *lakeformation.LakeFormation, catalogID string, tfList []interface{}) ([]interface{}, error) {
+	return processPermissionsBatch(tfList, func(apiObjects []*lakeformation.BatchPermissionsRequestEntry) ([]*lakeformation.BatchPermissionsFailureEntry, error) {
+		input := &lakeformation.BatchRevokePermissionsInput{
+			Entries: apiObjects,
+		}
+
+		if catalogID != "" {
+			input.CatalogId = aws.String(catalogID)
+		}
+
+		log.Printf("[DEBUG] Revoking Lake Formation Permissions Batch: %s", input)
+		output, err := conn.BatchRevokePermissions(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return output.Failures, nil
+	}, permissionsBatchRevokeRetryable, permissionsBatchRevokeNotFound)
+}
+
+// processPermissionsBatch sends the entries in chunks, retrying entries whose failures are retryable until
+// the IAM propagation timeout. Failures for which ignore returns true count as successes.
+func processPermissionsBatch(tfList []interface{}, send func([]*lakeformation.BatchPermissionsRequestEntry) ([]*lakeformation.BatchPermissionsFailureEntry, error), retryable, ignore func(*lakeformation.ErrorDetail) bool) ([]interface{}, error) {
+	apiObjects := make([]*lakeformation.BatchPermissionsRequestEntry, 0, len(tfList))
+
+	for i, tfMapRaw := range tfList {
+		apiObject, err := expandPermissionsBatchEntry(tfMapRaw.(map[string]interface{}))
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObject.Id = aws.String(strconv.Itoa(i))
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	var processed []interface{}
+	var errs *multierror.Error
+
+	for start := 0; start < len(apiObjects); start += permissionsBatchMaxEntries {
+		end := start + permissionsBatchMaxEntries
+		if end > len(apiObjects) {
+			end = len(apiObjects)
+		}
+
+		pending := apiObjects[start:end]
+		var failures, retryFailures []*lakeformation.BatchPermissionsFailureEntry
+
+		attempt := func() error {
+			output, err := send(pending)
+
+			if err != nil {
+				return err
+			}
+
+			failed := make(map[string]bool)
+			var retry []*lakeformation.BatchPermissionsRequestEntry
+			retryFailures = nil
+
+			for _, failure := range output {
+				if failure == nil || failure.RequestEntry == nil {
+					continue
+				}
+
+				if ignore != nil && ignore(failure.Error) {
+					continue
+				}
+
+				failed[aws.StringValue(failure.RequestEntry.Id)] = true
+
+				if retryable(failure.Error) {
+					retry = append(retry, failure.RequestEntry)
+					retryFailures = append(retryFailures, failure)
+				} else {
+					failures = append(failures, failure)
+				}
+			}
+
+			for _, apiObject := range pending {
+				if id := aws.StringValue(apiObject.Id); !failed[id] {
+					i, _ := strconv.Atoi(id)
+					processed = append(processed, tfList[i])
+				}
+			}
+
+			if len(retry) > 0 {
+				pending = retry
+				return permissionsBatchFailuresError(retryFailures)
+			}
+
+			return nil
+		}
+
+		err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
+			err := attempt()
+
+			if err == nil {
+				return nil
+			}
+
+			if _, ok := err.(*multierror.Error); ok { //nolint:errorlint // Only the failures returned by attempt are retried
+				return resource.RetryableError(err)
+			}
+
+			if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeConcurrentModificationException) {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		})
+
+		if tfresource.TimedOut(err) {
+			err = attempt()
+		}
+
+		// Entries still failing with retryable errors are reported with the other failures.
+		if _, ok := err.(*multierror.Error); ok { //nolint:errorlint // Only the failures returned by attempt are collected
+			err = nil
+		}
+
+		if err != nil {
+			return processed, err
+		}
+
+		if failures = append(failures, retryFailures...); len(failures) > 0 {
+			errs = multierror.Append(errs, permissionsBatchFailuresError(failures).Errors...)
+		}
+	}
+
+	return processed, errs.ErrorOrNil()
+}
+
+func permissionsBatchFailuresError(failures []*lakeformation.BatchPermissionsFailureEntry) *multierror.Error {
+	var errs *multierror.Error
+
+	for _, failure := range failures {
+		var code, message string
+
+		if failure.Error != nil {
+			code, message = aws.StringValue(failure.Error.ErrorCode), aws.StringValue(failure.Error.ErrorMessage)
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("principal (%s) on %s: %s: %s", aws.StringValue(failure.RequestEntry.Principal.DataLakePrincipalIdentifier), permissionsBatchResourceString(failure.RequestEntry.Resource), code, message))
+	}
+
+	return errs
+}
+
+func permissionsBatchGrantRetryable(apiObject *lakeformation.ErrorDetail) bool {
+	if apiObject == nil {
+		return false
+	}
+
+	code, message := aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage)
+
+	switch code {
+	case lakeformation.ErrCodeInvalidInputException:
+		return strings.Contains(message, "Invalid principal") ||
+			strings.Contains(message, "Grantee has no permissions") ||
+			strings.Contains(message, "register the S3 path")
+	case lakeformation.ErrCodeConcurrentModificationException:
+		return true
+	case "AccessDeniedException":
+		return strings.Contains(message, "is not authorized to access requested permissions")
+	}
+
+	return false
+}
+
+func permissionsBatchRevokeRetryable(apiObject *lakeformation.ErrorDetail) bool {
+	if apiObject == nil {
+		return false
+	}
+
+	code, message := aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage)
+
+	switch code {
+	case lakeformation.ErrCodeInvalidInputException:
+		return strings.Contains(message, "register the S3 path")
+	case lakeformation.ErrCodeConcurrentModificationException:
+		return true
+	case "AccessDeniedException":
+		return strings.Contains(message, "is not authorized to access requested permissions")
+	}
+
+	return false
+}
+
+func permissionsBatchRevokeNotFound(apiObject *lakeformation.ErrorDetail) bool {
+	if apiObject == nil {
+		return false
+	}
+
+	code, message := aws.StringValue(apiObject.ErrorCode), aws.StringValue(apiObject.ErrorMessage)
+
+	switch code {
+	case lakeformation.ErrCodeEntityNotFoundException:
+		return true
+	case lakeformation.ErrCodeInvalidInputException:
+		return strings.Contains(message, "No permissions revoked. Grantee") ||
+			strings.Contains(message, "cannot grant/revoke permission on non-existent column")
+	case "AccessDeniedException":
+		return strings.Contains(message, "Resource does not exist")
+	}
+
+	return false
+}
+
+func permissionsBatchResourceString(apiObject *lakeformation.Resource) string {
+	switch {
+	case apiObject == nil:
+		return "unknown resource"
+	case apiObject.Catalog != nil:
+		return "catalog"
+	case apiObject.DataLocation != nil:
+		return fmt.Sprintf("data location (%s)", aws.StringValue(apiObject.DataLocation.ResourceArn))
+	case apiObject.Database != nil:
+		return fmt.Sprintf("database (%s)", aws.StringValue(apiObject.Database.Name))
+	case apiObject.Table != nil:
+		if apiObject.Table.TableWildcard != nil {
+			return fmt.Sprintf("all tables in database (%s)", aws.StringValue(apiObject.Table.DatabaseName))
+		}
+		return fmt.Sprintf("table (%s.%s)", aws.StringValue(apiObject.Table.DatabaseName), aws.StringValue(apiObject.Table.Name))
+	case apiObject.TableWithColumns != nil:
+		return fmt.Sprintf("table with columns (%s.%s)", aws.StringValue(apiObject.TableWithColumns.DatabaseName), aws.StringValue(apiObject.TableWithColumns.Name))
+	}
+
+	return "unknown resource"
+}
+
+// findPermissionsBatchEntry reports whether all of the entry's permissions are granted. When wait is true,
+// it waits for newly granted permissions to become visible.
+func findPermissionsBatchEntry(conn *lakeformation.LakeFormation, catalogID string, tfMap map[string]interface{}, wait bool) (bool, error) {
+	apiObject, err := expandPermissionsBatchEntry(tfMap)
+
+	if err != nil {
+		return false, err
+	}
+
+	input := &lakeformation.ListPermissionsInput{
+		Principal: apiObject.Principal,
+		Resource:  apiObject.Resource,
+	}
+
+	if catalogID != "" {
+		input.CatalogId = aws.String(catalogID)
+	}
+
+	tableType := ""
+	columnNames := make([]*string, 0)
+	excludedColumnNames := make([]*string, 0)
+	columnWildcard := false
+
+	if apiObject.Resource.Table != nil {
+		tableType = TableTypeTable
+	}
+
+	if v := apiObject.Resource.TableWithColumns; v != nil {
+		// can't ListPermissions for TableWithColumns, so use Table instead
+		tfMap := tfMap["table_with_columns"].([]interface{})[0].(map[string]interface{})
+		input.Resource = &lakeformation.Resource{Table: ExpandTableWithColumnsResourceAsTable(tfMap)}
+		tableType = TableTypeTableWithColumns
+
+		if v.ColumnNames != nil {
+			columnNames = v.ColumnNames
+		}
+
+		if v.ColumnWildcard != nil {
+			columnWildcard = true
+
+			if v.ColumnWildcard.ExcludedColumnNames != nil {
+				excludedColumnNames = v.ColumnWildcard.ExcludedColumnNames
+			}
+		}
+	}
+
+	var allPermissions []*lakeformation.PrincipalResourcePermissions
+
+	if wait {
+		allPermissions, err = waitPermissionsReady(conn, input, tableType, columnNames, excludedColumnNames, columnWildcard)
+	} else {
+		var outputRaw interface{}
+		var status string
+
+		outputRaw, status, err = statusPermissions(conn, input, tableType, columnNames, excludedColumnNames, columnWildcard)()
+
+		if status == statusNotFound || status == statusIAMDelay {
+			return false, nil
+		}
+
+		allPermissions, _ = outputRaw.([]*lakeformation.PrincipalResourcePermissions)
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	cleanPermissions := FilterPermissions(input, tableType, columnNames, excludedColumnNames, columnWildcard, allPermissions)
+
+	return containsAllPermissions(flattenLakeFormationPermissions(cleanPermissions), aws.StringValueSlice(apiObject.Permissions)) &&
+		containsAllPermissions(flattenLakeFormationGrantPermissions(cleanPermissions), aws.StringValueSlice(apiObject.PermissionsWithGrantOption)), nil
+}
+
+func containsAllPermissions(actual, expected []string) bool {
+	for _, e := range expected {
+		found := false
+
+		for _, a := range actual {
+			if a == e || a == lakeformation.PermissionAll {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func expandPermissionsBatchEntry(tfMap map[string]interface{}) (*lakeformation.BatchPermissionsRequestEntry, error) {
+	apiObject := &lakeformation.BatchPermissionsRequestEntry{
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(tfMap["principal"].(string)),
+		},
+		Resource: &lakeformation.Resource{},
+	}
+
+	if v, ok := tfMap["permissions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Permissions = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["permissions_with_grant_option"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PermissionsWithGrantOption = flex.ExpandStringSet(v)
+	}
+
+	resources := 0
+
+	if v, ok := tfMap["catalog_resource"].(bool); ok && v {
+		apiObject.Resource.Catalog = ExpandCatalogResource()
+		resources++
+	}
+
+	if v, ok := tfMap["data_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.DataLocation = ExpandDataLocationResource(v[0].(map[string]interface{}))
+		resources++
+	}
+
+	if v, ok := tfMap["database"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Resource.Database = ExpandDatabaseResource(v[0].(map[string]interface{}))
+		resources++
+	}
+
+	if v, ok := tfMap["table"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		if tfMap["name"].(string) == "" && !tfMap["wildcard"].(bool) {
+			return nil, fmt.Errorf("entry for principal (%s): table requires one of name or wildcard", aws.StringValue(apiObject.Principal.DataLakePrincipalIdentifier))
+		}
+
+		apiObject.Resource.Table = ExpandTableResource(tfMap)
+		resources++
+	}
+
+	if v, ok := tfMap["table_with_columns"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		if tfMap["column_names"].(*schema.Set).Len() == 0 && !tfMap["wildcard"].(bool) {
+			return nil, fmt.Errorf("entry for principal (%s): table_with_columns requires one of column_names or wildcard", aws.StringValue(apiObject.Principal.DataLakePrincipalIdentifier))
+		}
+
+		apiObject.Resource.TableWithColumns = expandLakeFormationTableWithColumnsResource(tfMap)
+		resources++
+	}
+
+	if resources != 1 {
+		return nil, fmt.Errorf("entry for principal (%s): exactly one of catalog_resource, data_location, database, table or table_with_columns must be set", aws.StringValue(apiObject.Principal.DataLakePrincipalIdentifier))
+	}
+
+	return apiObject, nil
+}
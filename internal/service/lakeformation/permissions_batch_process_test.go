@@ -0,0 +1,142 @@
+package lakeformation
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestProcessPermissionsBatch(t *testing.T) {
+	var tfList []interface{}
+
+	for i := 0; i < 25; i++ {
+		tfList = append(tfList, map[string]interface{}{
+			"principal":   fmt.Sprintf("arn:aws:iam::123456789012:role/role-%d", i),
+			"permissions": schema.NewSet(schema.HashString, []interface{}{lakeformation.PermissionDescribe}),
+			"database": []interface{}{map[string]interface{}{
+				"name": fmt.Sprintf("db-%d", i),
+			}},
+		})
+	}
+
+	var calls []int
+
+	processed, err := processPermissionsBatch(tfList, func(apiObjects []*lakeformation.BatchPermissionsRequestEntry) ([]*lakeformation.BatchPermissionsFailureEntry, error) {
+		calls = append(calls, len(apiObjects))
+
+		var failures []*lakeformation.BatchPermissionsFailureEntry
+
+		for _, apiObject := range apiObjects {
+			switch aws.StringValue(apiObject.Resource.Database.Name) {
+			case "db-3":
+				failures = append(failures, &lakeformation.BatchPermissionsFailureEntry{
+					Error: &lakeformation.ErrorDetail{
+						ErrorCode:    aws.String(lakeformation.ErrCodeInvalidInputException),
+						ErrorMessage: aws.String("Database not found"),
+					},
+					RequestEntry: apiObject,
+				})
+			case "db-21":
+				failures = append(failures, &lakeformation.BatchPermissionsFailureEntry{
+					Error: &lakeformation.ErrorDetail{
+						ErrorCode:    aws.String(lakeformation.ErrCodeEntityNotFoundException),
+						ErrorMessage: aws.String("Entity not found"),
+					},
+					RequestEntry: apiObject,
+				})
+			}
+		}
+
+		return failures, nil
+	}, permissionsBatchRevokeRetryable, permissionsBatchRevokeNotFound)
+
+	if got, want := fmt.Sprint(calls), "[20 5]"; got != want {
+		t.Errorf("got calls %s, want %s", got, want)
+	}
+
+	if got, want := len(processed), 24; got != want {
+		t.Errorf("got %d processed entries, want %d", got, want)
+	}
+
+	for _, tfMapRaw := range processed {
+		if tfMapRaw.(map[string]interface{})["principal"] == "arn:aws:iam::123456789012:role/role-3" {
+			t.Errorf("failed entry reported as processed")
+		}
+	}
+
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if got := err.Error(); !strings.Contains(got, "role-3") || !strings.Contains(got, "database (db-3)") || !strings.Contains(got, "Database not found") || strings.Contains(got, "db-21") {
+		t.Errorf("unexpected error: %s", got)
+	}
+}
+
+func TestExpandPermissionsBatchEntry(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		TFMap       map[string]interface{}
+		ExpectError bool
+	}{
+		{
+			Name: "no resource",
+			TFMap: map[string]interface{}{
+				"principal": "IAM_ALLOWED_PRINCIPALS",
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "two resources",
+			TFMap: map[string]interface{}{
+				"principal":        "IAM_ALLOWED_PRINCIPALS",
+				"catalog_resource": true,
+				"database": []interface{}{map[string]interface{}{
+					"name": "db",
+				}},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "table without name or wildcard",
+			TFMap: map[string]interface{}{
+				"principal": "IAM_ALLOWED_PRINCIPALS",
+				"table": []interface{}{map[string]interface{}{
+					"database_name": "db",
+					"name":          "",
+					"wildcard":      false,
+				}},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "table wildcard",
+			TFMap: map[string]interface{}{
+				"principal": "IAM_ALLOWED_PRINCIPALS",
+				"table": []interface{}{map[string]interface{}{
+					"database_name": "db",
+					"name":          "",
+					"wildcard":      true,
+				}},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := expandPermissionsBatchEntry(testCase.TFMap)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error, got none")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package lakeformation_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func testAccPermissionsBatch_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_permissions_batch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPermissionsBatchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsBatchConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBatchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"database.#":      "1",
+						"database.0.name": rName + "-0",
+						"permissions.#":   "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"table.#":                         "1",
+						"table.0.database_name":           rName + "-0",
+						"table.0.wildcard":                "true",
+						"permissions.#":                   "1",
+						"permissions_with_grant_option.#": "0",
+					}),
+				),
+			},
+			{
+				Config: testAccPermissionsBatchConfig_basic(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBatchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "4"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "entry.*", map[string]string{
+						"database.#":      "1",
+						"database.0.name": rName + "-2",
+					}),
+				),
+			},
+			{
+				Config: testAccPermissionsBatchConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBatchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPermissionsBatchDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_permissions_batch" {
+			continue
+		}
+
+		for _, entry := range permissionsBatchDatabaseEntries(rs) {
+			permCount, err := permissionCountForDatabase(conn, rs.Primary.Attributes["catalog_id"], entry.principal, entry.database)
+
+			if err != nil {
+				return err
+			}
+
+			if permCount != 0 {
+				return fmt.Errorf("Lake Formation Permissions Batch (%s) still has %d permissions for %s on database %s", rs.Primary.ID, permCount, entry.principal, entry.database)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckPermissionsBatchExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("acceptance test: resource not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn()
+
+		entries := permissionsBatchDatabaseEntries(rs)
+
+		if len(entries) == 0 {
+			return fmt.Errorf("Lake Formation Permissions Batch (%s) has no database entries", rs.Primary.ID)
+		}
+
+		for _, entry := range entries {
+			permCount, err := permissionCountForDatabase(conn, rs.Primary.Attributes["catalog_id"], entry.principal, entry.database)
+
+			if err != nil {
+				return err
+			}
+
+			if permCount == 0 {
+				return fmt.Errorf("Lake Formation Permissions Batch (%s) has no permissions for %s on database %s", rs.Primary.ID, entry.principal, entry.database)
+			}
+		}
+
+		return nil
+	}
+}
+
+type permissionsBatchDatabaseEntry struct {
+	principal string
+	database  string
+}
+
+// permissionsBatchDatabaseEntries returns the database entries in state.
+func permissionsBatchDatabaseEntries(rs *terraform.ResourceState) []permissionsBatchDatabaseEntry {
+	var entries []permissionsBatchDatabaseEntry
+
+	for k, v := range rs.Primary.Attributes {
+		if !strings.HasPrefix(k, "entry.") || !strings.HasSuffix(k, ".database.0.name") {
+			continue
+		}
+
+		entries = append(entries, permissionsBatchDatabaseEntry{
+			principal: rs.Primary.Attributes[strings.TrimSuffix(k, "database.0.name")+"principal"],
+			database:  v,
+		})
+	}
+
+	return entries
+}
+
+func permissionCountForDatabase(conn *lakeformation.LakeFormation, catalogID, principal, database string) (int, error) {
+	input := &lakeformation.ListPermissionsInput{
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(principal),
+		},
+		Resource: &lakeformation.Resource{
+			Database: &lakeformation.DatabaseResource{
+				Name: aws.String(database),
+			},
+		},
+	}
+
+	if catalogID != "" {
+		input.CatalogId = aws.String(catalogID)
+	}
+
+	var permCount int
+
+	err := conn.ListPermissionsPages(input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		for _, permission := range page.PrincipalResourcePermissions {
+			if permission == nil || aws.StringValue(permission.Principal.DataLakePrincipalIdentifier) != principal {
+				continue
+			}
+
+			permCount += len(permission.Permissions)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return 0, nil
+	}
+
+	if tfawserr.ErrMessageContains(err, "AccessDeniedException", "Resource does not exist") {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("error listing Lake Formation permissions for %s on database %s: %w", principal, database, err)
+	}
+
+	return permCount, nil
+}
+
+func testAccPermissionsBatchConfig_basic(rName string, databaseCount int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  count = 3
+
+  name = "%[1]s-${count.index}"
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_lakeformation_permissions_batch" "test" {
+  dynamic "entry" {
+    for_each = slice(aws_glue_catalog_database.test, 0, %[2]d)
+
+    content {
+      principal   = aws_iam_role.test.arn
+      permissions = ["ALTER", "CREATE_TABLE"]
+
+      database {
+        name = entry.value.name
+      }
+    }
+  }
+
+  entry {
+    principal   = aws_iam_role.test.arn
+    permissions = ["DESCRIBE"]
+
+    table {
+      database_name = aws_glue_catalog_database.test[0].name
+      wildcard      = true
+    }
+  }
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName, databaseCount)
+}
@@ -0,0 +1,386 @@
+package lakeformation
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceResourceLFTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceResourceLFTagCreate,
+		Read:   resourceResourceLFTagRead,
+		Delete: resourceResourceLFTagDelete,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				ForceNew:     true,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"database": {
+				Type:     schema.TypeList,
+				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+					},
+				},
+			},
+			"lf_tag": {
+				Type:     schema.TypeList,
+				ForceNew: true,
+				MaxItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"key": {
+							Type:         schema.TypeString,
+							ForceNew:     true,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							ForceNew:     true,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			"table": {
+				Type:     schema.TypeList,
+				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Optional: true,
+							AtLeastOneOf: []string{
+								"table.0.name",
+								"table.0.wildcard",
+							},
+						},
+						"wildcard": {
+							Type:     schema.TypeBool,
+							Default:  false,
+							ForceNew: true,
+							Optional: true,
+							AtLeastOneOf: []string{
+								"table.0.name",
+								"table.0.wildcard",
+							},
+						},
+					},
+				},
+			},
+			"table_with_columns": {
+				Type:     schema.TypeList,
+				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"column_names": {
+							Type:     schema.TypeSet,
+							ForceNew: true,
+							MinItems: 1,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceResourceLFTagCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
+
+	input := &lakeformation.AddLFTagsToResourceInput{
+		LFTags:   []*lakeformation.LFTagPair{expandLakeFormationLFTagPair(d.Get("lf_tag").([]interface{})[0].(map[string]interface{}))},
+		Resource: expandLakeFormationResourceLFTagResource(d),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Lake Formation Resource LF-Tag: %s", input)
+	output, err := conn.AddLFTagsToResource(input)
+
+	if err == nil && output != nil && len(output.Failures) > 0 {
+		err = lfTagErrorsError(output.Failures)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating Lake Formation Resource LF-Tag: %w", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", create.StringHashcode(input.String())))
+
+	return resourceResourceLFTagRead(d, meta)
+}
+
+func resourceResourceLFTagRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
+
+	input := &lakeformation.GetResourceLFTagsInput{
+		Resource:           expandLakeFormationResourceLFTagResource(d),
+		ShowAssignedLFTags: aws.Bool(true),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	output, err := conn.GetResourceLFTags(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		log.Printf("[WARN] Lake Formation Resource LF-Tag (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Resource LF-Tag (%s): %w", d.Id(), err)
+	}
+
+	tagKey := d.Get("lf_tag.0.key").(string)
+	var lfTag *lakeformation.LFTagPair
+
+	switch {
+	case input.Resource.Database != nil:
+		lfTag = findLakeFormationLFTagPairByKey(output.LFTagOnDatabase, tagKey)
+	case input.Resource.Table != nil:
+		lfTag = findLakeFormationLFTagPairByKey(output.LFTagsOnTable, tagKey)
+	case input.Resource.TableWithColumns != nil:
+		// The tag is only considered assigned when every configured column carries it.
+		for _, column := range output.LFTagsOnColumns {
+			if column == nil {
+				continue
+			}
+
+			v := findLakeFormationLFTagPairByKey(column.LFTags, tagKey)
+
+			if v == nil {
+				lfTag = nil
+				break
+			}
+
+			lfTag = v
+		}
+	}
+
+	if !d.IsNewResource() && lfTag == nil {
+		log.Printf("[WARN] Lake Formation Resource LF-Tag (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if lfTag == nil {
+		return fmt.Errorf("error reading Lake Formation Resource LF-Tag (%s): LF-Tag %q not assigned", d.Id(), tagKey)
+	}
+
+	if err := d.Set("lf_tag", []interface{}{flattenLakeFormationLFTagPair(lfTag)}); err != nil {
+		return fmt.Errorf("error setting lf_tag: %w", err)
+	}
+
+	return nil
+}
+
+func resourceResourceLFTagDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn()
+
+	input := &lakeformation.RemoveLFTagsFromResourceInput{
+		LFTags:   []*lakeformation.LFTagPair{expandLakeFormationLFTagPair(d.Get("lf_tag").([]interface{})[0].(map[string]interface{}))},
+		Resource: expandLakeFormationResourceLFTagResource(d),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting Lake Formation Resource LF-Tag: %s", input)
+	output, err := conn.RemoveLFTagsFromResource(input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err == nil && output != nil && len(output.Failures) > 0 {
+		err = lfTagErrorsError(output.Failures)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lake Formation Resource LF-Tag (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLakeFormationResourceLFTagResource(d *schema.ResourceData) *lakeformation.Resource {
+	apiObject := &lakeformation.Resource{}
+
+	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Database = ExpandDatabaseResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Table = ExpandTableResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table_with_columns"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.TableWithColumns = expandLakeFormationTableWithColumnsResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandLakeFormationLFTagPair(tfMap map[string]interface{}) *lakeformation.LFTagPair {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lakeformation.LFTagPair{}
+
+	if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+		apiObject.CatalogId = aws.String(v)
+	}
+
+	if v, ok := tfMap["key"].(string); ok && v != "" {
+		apiObject.TagKey = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.TagValues = aws.StringSlice([]string{v})
+	}
+
+	return apiObject
+}
+
+func flattenLakeFormationLFTagPair(apiObject *lakeformation.LFTagPair) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CatalogId; v != nil {
+		tfMap["catalog_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TagKey; v != nil {
+		tfMap["key"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TagValues; len(v) > 0 {
+		tfMap["value"] = aws.StringValue(v[0])
+	}
+
+	return tfMap
+}
+
+func findLakeFormationLFTagPairByKey(apiObjects []*lakeformation.LFTagPair, key string) *lakeformation.LFTagPair {
+	for _, apiObject := range apiObjects {
+		if apiObject != nil && aws.StringValue(apiObject.TagKey) == key {
+			return apiObject
+		}
+	}
+
+	return nil
+}
+
+func lfTagErrorsError(apiObjects []*lakeformation.LFTagError) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.Error == nil {
+			continue
+		}
+
+		errors = multierror.Append(errors, fmt.Errorf("%s: %s", aws.StringValue(apiObject.Error.ErrorCode), aws.StringValue(apiObject.Error.ErrorMessage)))
+	}
+
+	return errors.ErrorOrNil()
+}
@@ -0,0 +1,285 @@
+package lakeformation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func testAccResourceLFTag_database(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_lf_tag.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResourceLFTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceLFTagConfig_database(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceLFTagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "database.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "database.0.name", "aws_glue_catalog_database.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "lf_tag.0.key", "aws_lakeformation_lf_tag.test", "key"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.0.value", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceLFTag_table(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_lf_tag.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResourceLFTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceLFTagConfig_table(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceLFTagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "table.0.name", "aws_glue_catalog_table.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.0.value", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceLFTag_tableWithColumns(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_lf_tag.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResourceLFTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceLFTagConfig_tableWithColumns(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceLFTagExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table_with_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_with_columns.0.column_names.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.0.value", "value1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceLFTagDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_resource_lf_tag" {
+			continue
+		}
+
+		output, err := testAccGetResourceLFTags(conn, rs)
+
+		if err != nil {
+			// The tagged database, table or LF-Tag is gone as well.
+			continue
+		}
+
+		tagKey := rs.Primary.Attributes["lf_tag.0.key"]
+
+		for _, lfTags := range output {
+			for _, lfTag := range lfTags {
+				if aws.StringValue(lfTag.TagKey) == tagKey {
+					return fmt.Errorf("Lake Formation Resource LF-Tag %s still exists", rs.Primary.ID)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckResourceLFTagExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn()
+
+		output, err := testAccGetResourceLFTags(conn, rs)
+
+		if err != nil {
+			return err
+		}
+
+		tagKey := rs.Primary.Attributes["lf_tag.0.key"]
+
+		for _, lfTags := range output {
+			for _, lfTag := range lfTags {
+				if aws.StringValue(lfTag.TagKey) == tagKey {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("Lake Formation Resource LF-Tag %s not found", rs.Primary.ID)
+	}
+}
+
+// testAccGetResourceLFTags returns the LF-Tags assigned to the resource's database, table or columns.
+func testAccGetResourceLFTags(conn *lakeformation.LakeFormation, rs *terraform.ResourceState) ([][]*lakeformation.LFTagPair, error) {
+	input := &lakeformation.GetResourceLFTagsInput{
+		Resource:           &lakeformation.Resource{},
+		ShowAssignedLFTags: aws.Bool(true),
+	}
+
+	switch {
+	case rs.Primary.Attributes["database.#"] == "1":
+		input.Resource.Database = &lakeformation.DatabaseResource{
+			Name: aws.String(rs.Primary.Attributes["database.0.name"]),
+		}
+	case rs.Primary.Attributes["table.#"] == "1":
+		input.Resource.Table = &lakeformation.TableResource{
+			DatabaseName: aws.String(rs.Primary.Attributes["table.0.database_name"]),
+			Name:         aws.String(rs.Primary.Attributes["table.0.name"]),
+		}
+	case rs.Primary.Attributes["table_with_columns.#"] == "1":
+		input.Resource.Table = &lakeformation.TableResource{
+			DatabaseName: aws.String(rs.Primary.Attributes["table_with_columns.0.database_name"]),
+			Name:         aws.String(rs.Primary.Attributes["table_with_columns.0.name"]),
+		}
+	}
+
+	output, err := conn.GetResourceLFTags(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	lfTags := [][]*lakeformation.LFTagPair{output.LFTagOnDatabase, output.LFTagsOnTable}
+
+	for _, column := range output.LFTagsOnColumns {
+		lfTags = append(lfTags, column.LFTags)
+	}
+
+	return lfTags, nil
+}
+
+func testAccResourceLFTagConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_iam_session_context" "current" {
+  arn = data.aws_caller_identity.current.arn
+}
+
+resource "aws_lakeformation_data_lake_settings" "test" {
+  admins = [data.aws_iam_session_context.current.issuer_arn]
+}
+
+resource "aws_lakeformation_lf_tag" "test" {
+  key    = %[1]q
+  values = ["value1", "value2"]
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccResourceLFTagConfig_database(rName string) string {
+	return acctest.ConfigCompose(testAccResourceLFTagConfigBase(rName), `
+resource "aws_lakeformation_resource_lf_tag" "test" {
+  database {
+    name = aws_glue_catalog_database.test.name
+  }
+
+  lf_tag {
+    key   = aws_lakeformation_lf_tag.test.key
+    value = "value1"
+  }
+}
+`)
+}
+
+func testAccResourceLFTagConfig_table(rName string) string {
+	return acctest.ConfigCompose(testAccResourceLFTagConfigBase(rName), fmt.Sprintf(`
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+}
+
+resource "aws_lakeformation_resource_lf_tag" "test" {
+  table {
+    database_name = aws_glue_catalog_table.test.database_name
+    name          = aws_glue_catalog_table.test.name
+  }
+
+  lf_tag {
+    key   = aws_lakeformation_lf_tag.test.key
+    value = "value2"
+  }
+}
+`, rName))
+}
+
+func testAccResourceLFTagConfig_tableWithColumns(rName string) string {
+	return acctest.ConfigCompose(testAccResourceLFTagConfigBase(rName), fmt.Sprintf(`
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+
+  storage_descriptor {
+    columns {
+      name = "event"
+      type = "string"
+    }
+
+    columns {
+      name = "timestamp"
+      type = "date"
+    }
+
+    columns {
+      name = "transactionamount"
+      type = "double"
+    }
+  }
+}
+
+resource "aws_lakeformation_resource_lf_tag" "test" {
+  table_with_columns {
+    database_name = aws_glue_catalog_table.test.database_name
+    name          = aws_glue_catalog_table.test.name
+    column_names  = ["event", "timestamp"]
+  }
+
+  lf_tag {
+    key   = aws_lakeformation_lf_tag.test.key
+    value = "value1"
+  }
+}
+`, rName))
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -31,6 +32,8 @@ const awsMutexLambdaKey = `aws_lambda_function`
 
 const FunctionVersionLatest = "$LATEST"
 
+const lambdaServicePrincipal = "lambda.amazonaws.com"
+
 func ResourceFunction() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceFunctionCreate,
@@ -387,7 +390,7 @@ func hasConfigChanges(d verify.ResourceDiffer) bool {
 // resourceAwsLambdaFunction maps to:
 // CreateFunction in the API / SDK
 func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -530,6 +533,10 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		params.Tags = Tags(tags.IgnoreAWS())
 	}
 
+	if err := tfiam.WaitRoleAssumable(meta.(*conns.AWSClient).IAMConn(), iamRole, lambdaServicePrincipal); err != nil {
+		return fmt.Errorf("error creating Lambda Function (%s): %w", functionName, err)
+	}
+
 	err := resource.Retry(lambdaFunctionCreateTimeout, func() *resource.RetryError { // nosem: helper-schema-resource-Retry-without-TimeoutError-check
 		_, err := conn.CreateFunction(params)
 
@@ -637,7 +644,7 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 // resourceFunctionRead maps to:
 // GetFunction in the API / SDK
 func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -675,7 +682,7 @@ func resourceFunctionRead(d *schema.ResourceData, meta interface{}) error {
 		tags := KeyValueTags(getFunctionOutput.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 		//lintignore:AWSR002
-		if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 			return fmt.Errorf("error setting tags: %w", err)
 		}
 
@@ -904,7 +911,7 @@ func listVersionsByFunctionPages(c *lambda.Lambda, input *lambda.ListVersionsByF
 // resourceAwsLambdaFunction maps to:
 // DeleteFunction in the API / SDK
 func resourceFunctionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 
 	log.Printf("[INFO] Deleting Lambda Function: %s", d.Id())
 
@@ -938,7 +945,7 @@ func needsFunctionCodeUpdate(d verify.ResourceDiffer) bool {
 // resourceFunctionUpdate maps to:
 // UpdateFunctionCode in the API / SDK
 func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LambdaConn
+	conn := meta.(*conns.AWSClient).LambdaConn()
 
 	// If Code Signing Config is updated, calls PutFunctionCodeSigningConfig
 	// If removed, calls DeleteFunctionCodeSigningConfig
@@ -1072,6 +1079,12 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	configUpdate := hasConfigChanges(d)
 	if configUpdate {
+		if d.HasChange("role") {
+			if err := tfiam.WaitRoleAssumable(meta.(*conns.AWSClient).IAMConn(), d.Get("role").(string), lambdaServicePrincipal); err != nil {
+				return fmt.Errorf("error updating Lambda Function (%s): %w", d.Id(), err)
+			}
+		}
+
 		log.Printf("[DEBUG] Send Update Lambda Function Configuration request: %#v", configReq)
 
 		err := resource.Retry(lambdaFunctionUpdateTimeout, func() *resource.RetryError { // nosem: helper-schema-resource-Retry-without-TimeoutError-check
@@ -561,7 +561,7 @@ func testAccPermissionDisappears(n string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("No resource ID is set")
@@ -596,7 +596,7 @@ func testAccCheckLambdaPermissionExists(n string, statement *tflambda.PolicyStat
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		// IAM is eventually consistent
 		var foundStatement *tflambda.PolicyStatement
@@ -628,7 +628,7 @@ func testAccCheckLambdaPermissionExists(n string, statement *tflambda.PolicyStat
 }
 
 func testAccCheckPermissionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lambda_permission" {
@@ -31,7 +31,7 @@ func sweepFunctions(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).LambdaConn
+	conn := client.(*conns.AWSClient).LambdaConn()
 
 	resp, err := conn.ListFunctions(&lambda.ListFunctionsInput{})
 	if err != nil {
@@ -66,7 +66,7 @@ func sweepLayerVersions(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).LambdaConn
+	conn := client.(*conns.AWSClient).LambdaConn()
 	resp, err := conn.ListLayers(&lambda.ListLayersInput{})
 	if err != nil {
 		if sweep.SkipSweepError(err) {
@@ -135,7 +135,7 @@ func hasSlotTypeConfigChanges(d verify.ResourceDiffer) bool {
 }
 
 func resourceSlotTypeCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LexModelBuildingConn
+	conn := meta.(*conns.AWSClient).LexModelBuildingConn()
 	name := d.Get("name").(string)
 
 	input := &lexmodelbuildingservice.PutSlotTypeInput{
@@ -177,7 +177,7 @@ func resourceSlotTypeCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSlotTypeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LexModelBuildingConn
+	conn := meta.(*conns.AWSClient).LexModelBuildingConn()
 
 	resp, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
 		Name:    aws.String(d.Id()),
@@ -246,7 +246,7 @@ func getLatestLexSlotTypeVersion(conn *lexmodelbuildingservice.LexModelBuildingS
 }
 
 func resourceSlotTypeUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LexModelBuildingConn
+	conn := meta.(*conns.AWSClient).LexModelBuildingConn()
 
 	input := &lexmodelbuildingservice.PutSlotTypeInput{
 		Checksum:               aws.String(d.Get("checksum").(string)),
@@ -285,7 +285,7 @@ func resourceSlotTypeUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSlotTypeDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).LexModelBuildingConn
+	conn := meta.(*conns.AWSClient).LexModelBuildingConn()
 
 	input := &lexmodelbuildingservice.DeleteSlotTypeInput{
 		Name: aws.String(d.Id()),
@@ -80,7 +80,7 @@ func DataSourceSlotType() *schema.Resource {
 func dataSourceSlotTypeRead(d *schema.ResourceData, meta interface{}) error {
 	slotTypeName := d.Get("name").(string)
 
-	conn := meta.(*conns.AWSClient).LexModelBuildingConn
+	conn := meta.(*conns.AWSClient).LexModelBuildingConn()
 
 	resp, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
 		Name:    aws.String(slotTypeName),
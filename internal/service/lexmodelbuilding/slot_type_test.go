@@ -382,7 +382,7 @@ func testAccCheckSlotTypeExistsWithVersion(rName, slotTypeVersion string, output
 		}
 
 		var err error
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn()
 
 		output, err = conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
 			Name:    aws.String(rs.Primary.ID),
@@ -405,7 +405,7 @@ func testAccCheckSlotTypeExists(rName string, output *lexmodelbuildingservice.Ge
 
 func testAccCheckSlotTypeNotExists(slotTypeName, slotTypeVersion string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn()
 
 		_, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
 			Name:    aws.String(slotTypeName),
@@ -423,7 +423,7 @@ func testAccCheckSlotTypeNotExists(slotTypeName, slotTypeVersion string) resourc
 }
 
 func testAccCheckSlotTypeDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LexModelBuildingConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lex_slot_type" {
@@ -72,7 +72,7 @@ func TestAccMacieS3BucketAssociation_accountIdAndPrefix(t *testing.T) {
 }
 
 func testAccCheckS3BucketAssociationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).MacieConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MacieConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_macie_s3_bucket_association" {
@@ -109,7 +109,7 @@ func testAccCheckS3BucketAssociationDestroy(s *terraform.State) error {
 
 func testAccCheckS3BucketAssociationExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).MacieConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MacieConn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -146,7 +146,7 @@ func testAccCheckS3BucketAssociationExists(name string) resource.TestCheckFunc {
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).MacieConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MacieConn()
 
 	input := &macie.ListS3ResourcesInput{}
 
@@ -0,0 +1,99 @@
+package macie2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceOrganizationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMacie2OrganizationConfigurationPut,
+		ReadWithoutTimeout:   resourceMacie2OrganizationConfigurationRead,
+		UpdateWithoutTimeout: resourceMacie2OrganizationConfigurationPut,
+		DeleteWithoutTimeout: resourceMacie2OrganizationConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"auto_enable": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"max_account_limit_reached": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMacie2OrganizationConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn()
+
+	input := &macie2.UpdateOrganizationConfigurationInput{
+		AutoEnable: aws.Bool(d.Get("auto_enable").(bool)),
+	}
+
+	// The delegated administrator designation made by
+	// aws_macie2_organization_admin_account is eventually consistent.
+	if err := waitOrganizationConfigurationUpdated(ctx, conn, input); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Macie OrganizationConfiguration: %w", err))
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	}
+
+	return resourceMacie2OrganizationConfigurationRead(ctx, d, meta)
+}
+
+func resourceMacie2OrganizationConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn()
+
+	output, err := conn.DescribeOrganizationConfigurationWithContext(ctx, &macie2.DescribeOrganizationConfigurationInput{})
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled")) {
+		log.Printf("[WARN] Macie OrganizationConfiguration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Macie OrganizationConfiguration (%s): %w", d.Id(), err))
+	}
+
+	d.Set("auto_enable", output.AutoEnable)
+	d.Set("max_account_limit_reached", output.MaxAccountLimitReached)
+
+	return nil
+}
+
+func resourceMacie2OrganizationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Macie2Conn()
+
+	input := &macie2.UpdateOrganizationConfigurationInput{
+		AutoEnable: aws.Bool(false),
+	}
+
+	_, err := conn.UpdateOrganizationConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Macie OrganizationConfiguration (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
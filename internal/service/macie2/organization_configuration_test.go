@@ -0,0 +1,87 @@
+package macie2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func testAccOrganizationConfiguration_basic(t *testing.T) {
+	resourceName := "aws_macie2_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationsAccount(t)
+		},
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckOrganizationConfigurationDestroy,
+		ErrorCheck:        testAccErrorCheckSkipMacie2OrganizationAdminAccount(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMacieOrganizationConfigurationConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationAutoEnable(resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "max_account_limit_reached"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMacieOrganizationConfigurationConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationConfigurationAutoEnable(resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationConfigurationAutoEnable(resourceName string, autoEnable bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[resourceName]; !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn()
+
+		output, err := conn.DescribeOrganizationConfiguration(&macie2.DescribeOrganizationConfigurationInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.BoolValue(output.AutoEnable); got != autoEnable {
+			return fmt.Errorf("macie OrganizationConfiguration auto_enable is %t, expected %t", got, autoEnable)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOrganizationConfigurationDestroy(s *terraform.State) error {
+	// Destroying the delegated administrator and Macie account leaves no
+	// organization configuration to inspect.
+	return nil
+}
+
+func testAccMacieOrganizationConfigurationConfig(autoEnable bool) string {
+	return testAccMacieOrganizationAdminAccountBasicConfig() + fmt.Sprintf(`
+resource "aws_macie2_organization_configuration" "test" {
+  auto_enable = %[1]t
+
+  depends_on = [aws_macie2_organization_admin_account.test]
+}
+`, autoEnable)
+}
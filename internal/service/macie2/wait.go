@@ -6,11 +6,15 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
 	// Maximum amount of time to wait for the statusMemberRelationship to be Invited, Enabled, or Paused
 	memberInvitedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a new delegated administrator to be able to update the organization configuration
+	organizationConfigurationUpdatedTimeout = 4 * time.Minute
 )
 
 // waitMemberInvited waits for an AdminAccount to return Invited, Enabled and Paused
@@ -30,3 +34,14 @@ func waitMemberInvited(ctx context.Context, conn *macie2.Macie2, adminAccountID
 
 	return nil, err
 }
+
+// waitOrganizationConfigurationUpdated retries UpdateOrganizationConfiguration until the delegated administrator is recognized
+func waitOrganizationConfigurationUpdated(ctx context.Context, conn *macie2.Macie2, input *macie2.UpdateOrganizationConfigurationInput) error {
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, organizationConfigurationUpdatedTimeout,
+		func() (interface{}, error) {
+			return conn.UpdateOrganizationConfigurationWithContext(ctx, input)
+		},
+		macie2.ErrCodeAccessDeniedException, macie2.ErrCodeResourceNotFoundException)
+
+	return err
+}
@@ -10,7 +10,7 @@ import (
 
 func TestAccNASCallerIdentityDataSource_basic(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:   func() { acctest.PreCheck(t) },
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckEndpointsNotOverridden(t) },
 		ErrorCheck: acctest.ErrorCheck(t, sts.EndpointsID),
 		Providers:  acctest.Providers,
 		Steps: []resource.TestStep{
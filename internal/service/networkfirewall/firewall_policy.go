@@ -45,11 +45,35 @@ func ResourceFirewallPolicy() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"stateful_default_actions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"stateful_engine_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"rule_order": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(networkfirewall.RuleOrder_Values(), false),
+									},
+								},
+							},
+						},
 						"stateful_rule_group_reference": {
 							Type:     schema.TypeSet,
 							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"priority": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
 									"resource_arn": {
 										Type:         schema.TypeString,
 										Required:     true,
@@ -108,7 +132,7 @@ func ResourceFirewallPolicy() *schema.Resource {
 }
 
 func resourceFirewallPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).NetworkFirewallConn
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	name := d.Get("name").(string)
@@ -141,7 +165,7 @@ func resourceFirewallPolicyCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceFirewallPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).NetworkFirewallConn
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -179,7 +203,7 @@ func resourceFirewallPolicyRead(ctx context.Context, d *schema.ResourceData, met
 	tags := KeyValueTags(resp.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
@@ -191,7 +215,7 @@ func resourceFirewallPolicyRead(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceFirewallPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).NetworkFirewallConn
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn()
 	arn := d.Id()
 
 	log.Printf("[DEBUG] Updating NetworkFirewall Firewall Policy %s", arn)
@@ -223,7 +247,7 @@ func resourceFirewallPolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceFirewallPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).NetworkFirewallConn
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn()
 
 	log.Printf("[DEBUG] Deleting NetworkFirewall Firewall Policy %s", d.Id())
 
@@ -274,6 +298,9 @@ func expandNetworkFirewallStatefulRuleGroupReferences(l []interface{}) []*networ
 			continue
 		}
 		reference := &networkfirewall.StatefulRuleGroupReference{}
+		if v, ok := tfMap["priority"].(int); ok && v > 0 {
+			reference.Priority = aws.Int64(int64(v))
+		}
 		if v, ok := tfMap["resource_arn"].(string); ok && v != "" {
 			reference.ResourceArn = aws.String(v)
 		}
@@ -304,6 +331,21 @@ func expandNetworkFirewallStatelessRuleGroupReferences(l []interface{}) []*netwo
 	return references
 }
 
+func expandNetworkFirewallStatefulEngineOptions(l []interface{}) *networkfirewall.StatefulEngineOptions {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	options := &networkfirewall.StatefulEngineOptions{}
+
+	m := l[0].(map[string]interface{})
+	if v, ok := m["rule_order"].(string); ok && v != "" {
+		options.RuleOrder = aws.String(v)
+	}
+
+	return options
+}
+
 func expandNetworkFirewallFirewallPolicy(l []interface{}) *networkfirewall.FirewallPolicy {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -314,6 +356,14 @@ func expandNetworkFirewallFirewallPolicy(l []interface{}) *networkfirewall.Firew
 		StatelessFragmentDefaultActions: flex.ExpandStringSet(lRaw["stateless_fragment_default_actions"].(*schema.Set)),
 	}
 
+	if v, ok := lRaw["stateful_default_actions"].(*schema.Set); ok && v.Len() > 0 {
+		policy.StatefulDefaultActions = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := lRaw["stateful_engine_options"].([]interface{}); ok && len(v) > 0 {
+		policy.StatefulEngineOptions = expandNetworkFirewallStatefulEngineOptions(v)
+	}
+
 	if v, ok := lRaw["stateful_rule_group_reference"].(*schema.Set); ok && v.Len() > 0 {
 		policy.StatefulRuleGroupReferences = expandNetworkFirewallStatefulRuleGroupReferences(v.List())
 	}
@@ -334,6 +384,12 @@ func flattenNetworkFirewallFirewallPolicy(policy *networkfirewall.FirewallPolicy
 		return []interface{}{}
 	}
 	p := map[string]interface{}{}
+	if policy.StatefulDefaultActions != nil {
+		p["stateful_default_actions"] = flex.FlattenStringSet(policy.StatefulDefaultActions)
+	}
+	if policy.StatefulEngineOptions != nil {
+		p["stateful_engine_options"] = flattenNetworkFirewallStatefulEngineOptions(policy.StatefulEngineOptions)
+	}
 	if policy.StatefulRuleGroupReferences != nil {
 		p["stateful_rule_group_reference"] = flattenNetworkFirewallPolicyStatefulRuleGroupReference(policy.StatefulRuleGroupReferences)
 	}
@@ -353,12 +409,27 @@ func flattenNetworkFirewallFirewallPolicy(policy *networkfirewall.FirewallPolicy
 	return []interface{}{p}
 }
 
+func flattenNetworkFirewallStatefulEngineOptions(options *networkfirewall.StatefulEngineOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"rule_order": aws.StringValue(options.RuleOrder),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenNetworkFirewallPolicyStatefulRuleGroupReference(l []*networkfirewall.StatefulRuleGroupReference) []interface{} {
 	references := make([]interface{}, 0, len(l))
 	for _, ref := range l {
 		reference := map[string]interface{}{
 			"resource_arn": aws.StringValue(ref.ResourceArn),
 		}
+		if ref.Priority != nil {
+			reference["priority"] = int(aws.Int64Value(ref.Priority))
+		}
 		references = append(references, reference)
 	}
 
@@ -376,6 +376,21 @@ func ResourceRuleGroup() *schema.Resource {
 								},
 							},
 						},
+						"stateful_rule_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"rule_order": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(networkfirewall.RuleOrder_Values(), false),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -401,7 +416,7 @@ func ResourceRuleGroup() *schema.Resource {
 }
 
 func resourceRuleGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).NetworkFirewallConn
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	name := d.Get("name").(string)
@@ -442,7 +457,7 @@ func resourceRuleGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).NetworkFirewallConn
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -485,7 +500,7 @@ func resourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 	tags := KeyValueTags(resp.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
 	}
 
@@ -497,7 +512,7 @@ func resourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).NetworkFirewallConn
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn()
 	arn := d.Id()
 
 	log.Printf("[DEBUG] Updating NetworkFirewall Rule Group %s", arn)
@@ -540,7 +555,7 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceRuleGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	conn := meta.(*conns.AWSClient).NetworkFirewallConn
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn()
 
 	log.Printf("[DEBUG] Deleting NetworkFirewall Rule Group %s", d.Id())
 
@@ -726,6 +741,16 @@ func expandNetworkFirewallRuleGroup(l []interface{}) *networkfirewall.RuleGroup
 			ruleGroup.RulesSource = rulesSource
 		}
 	}
+	if tfList, ok := tfMap["stateful_rule_options"].([]interface{}); ok && len(tfList) > 0 && tfList[0] != nil {
+		statefulRuleOptions := &networkfirewall.StatefulRuleOptions{}
+		sroMap, ok := tfList[0].(map[string]interface{})
+		if ok {
+			if v, ok := sroMap["rule_order"].(string); ok && v != "" {
+				statefulRuleOptions.RuleOrder = aws.String(v)
+			}
+		}
+		ruleGroup.StatefulRuleOptions = statefulRuleOptions
+	}
 
 	return ruleGroup
 }
@@ -952,8 +977,21 @@ func flattenNetworkFirewallRuleGroup(r *networkfirewall.RuleGroup) []interface{}
 	}
 
 	m := map[string]interface{}{
-		"rule_variables": flattenNetworkFirewallRuleVariables(r.RuleVariables),
-		"rules_source":   flattenNetworkFirewallRulesSource(r.RulesSource),
+		"rule_variables":        flattenNetworkFirewallRuleVariables(r.RuleVariables),
+		"rules_source":          flattenNetworkFirewallRulesSource(r.RulesSource),
+		"stateful_rule_options": flattenNetworkFirewallStatefulRulesOptions(r.StatefulRuleOptions),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenNetworkFirewallStatefulRulesOptions(sro *networkfirewall.StatefulRuleOptions) []interface{} {
+	if sro == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"rule_order": aws.StringValue(sro.RuleOrder),
 	}
 
 	return []interface{}{m}
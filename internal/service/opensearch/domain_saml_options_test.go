@@ -0,0 +1,383 @@
+package opensearch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	opensearch "github.com/aws/aws-sdk-go/service/opensearchservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearch "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+)
+
+func TestAccOpenSearchDomainSamlOptions_SAML_basic(t *testing.T) {
+	var domain opensearch.DomainStatus
+
+	rName := sdkacctest.RandomWithPrefix("acc-test")
+	rUserName := sdkacctest.RandomWithPrefix("es-master-user")
+	resourceName := "aws_opensearch_domain_saml_options.main"
+	esDomainResourceName := "aws_opensearch_domain.example"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDomainSAMLOptionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainSAMLOptionsConfig(rUserName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists(esDomainResourceName, &domain),
+					testAccCheckDomainSAMLOptions(esDomainResourceName, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "saml_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "saml_options.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "saml_options.0.idp.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "saml_options.0.idp.0.entity_id", "https://terraform-dev-ed.my.salesforce.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchDomainSamlOptions_SAML_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("acc-test")
+	rUserName := sdkacctest.RandomWithPrefix("es-master-user")
+	resourceName := "aws_opensearch_domain_saml_options.main"
+	esDomainResourceName := "aws_opensearch_domain.example"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDomainSAMLOptionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainSAMLOptionsConfig(rUserName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainSAMLOptions(esDomainResourceName, resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfopensearch.ResourceDomainSAMLOptions(), resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchDomainSamlOptions_SAMLDisappears_domain(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("acc-test")
+	rUserName := sdkacctest.RandomWithPrefix("es-master-user")
+	resourceName := "aws_opensearch_domain_saml_options.main"
+	esDomainResourceName := "aws_opensearch_domain.example"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDomainSAMLOptionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainSAMLOptionsConfig(rUserName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainSAMLOptions(esDomainResourceName, resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfopensearch.ResourceDomain(), esDomainResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchDomainSamlOptions_SAML_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("acc-test")
+	rUserName := sdkacctest.RandomWithPrefix("es-master-user")
+	resourceName := "aws_opensearch_domain_saml_options.main"
+	esDomainResourceName := "aws_opensearch_domain.example"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDomainSAMLOptionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainSAMLOptionsConfig(rUserName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "saml_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "saml_options.0.session_timeout_minutes", "60"),
+					testAccCheckDomainSAMLOptions(esDomainResourceName, resourceName),
+				),
+			},
+			{
+				Config: testAccDomainSAMLOptionsConfigUpdate(rUserName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "saml_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "saml_options.0.session_timeout_minutes", "180"),
+					testAccCheckDomainSAMLOptions(esDomainResourceName, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchDomainSamlOptions_SAML_disabled(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix("acc-test")
+	rUserName := sdkacctest.RandomWithPrefix("es-master-user")
+	resourceName := "aws_opensearch_domain_saml_options.main"
+	esDomainResourceName := "aws_opensearch_domain.example"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDomainSAMLOptionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainSAMLOptionsConfig(rUserName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "saml_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "saml_options.0.session_timeout_minutes", "60"),
+					testAccCheckDomainSAMLOptions(esDomainResourceName, resourceName),
+				),
+			},
+			{
+				Config: testAccDomainSAMLOptionsConfigDisabled(rUserName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "saml_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "saml_options.0.session_timeout_minutes", "0"),
+					testAccCheckDomainSAMLOptions(esDomainResourceName, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDomainSAMLOptionsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_opensearch_domain_saml_options" {
+			continue
+		}
+
+		resp, err := conn.DescribeDomain(&opensearch.DescribeDomainInput{
+			DomainName: aws.String(rs.Primary.Attributes["domain_name"]),
+		})
+
+		if err == nil {
+			return fmt.Errorf("OpenSearch Domain still exists %s", resp)
+		}
+
+		awsErr, ok := err.(awserr.Error)
+		if !ok {
+			return err
+		}
+		if awsErr.Code() != "ResourceNotFoundException" {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func testAccCheckDomainSAMLOptions(esResource string, samlOptionsResource string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[esResource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", esResource)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		options, ok := s.RootModule().Resources[samlOptionsResource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", samlOptionsResource)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn()
+		_, err := conn.DescribeDomain(&opensearch.DescribeDomainInput{
+			DomainName: aws.String(options.Primary.Attributes["domain_name"]),
+		})
+
+		return err
+	}
+}
+
+func testAccDomainSAMLOptionsConfig(userName string, domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "es_master_user" {
+  name = "%s"
+}
+
+resource "aws_opensearch_domain" "example" {
+  domain_name           = "%s"
+  engine_version = "Elasticsearch_7.10"
+
+  cluster_config {
+    instance_type = "r5.large.search"
+  }
+
+  # Advanced security option must be enabled to configure SAML.
+  advanced_security_options {
+    enabled                        = true
+    internal_user_database_enabled = false
+    master_user_options {
+      master_user_arn = aws_iam_user.es_master_user.arn
+    }
+  }
+
+  # You must enable node-to-node encryption to use advanced security options.
+  encrypt_at_rest {
+    enabled = true
+  }
+
+  domain_endpoint_options {
+    enforce_https       = true
+    tls_security_policy = "Policy-Min-TLS-1-2-2019-07"
+  }
+
+  node_to_node_encryption {
+    enabled = true
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+
+resource "aws_opensearch_domain_saml_options" "main" {
+  domain_name = aws_opensearch_domain.example.domain_name
+
+  saml_options {
+    enabled = true
+    idp {
+      entity_id        = "https://terraform-dev-ed.my.salesforce.com"
+      metadata_content = file("./test-fixtures/saml-metadata.xml")
+    }
+  }
+}
+`, userName, domainName)
+}
+
+func testAccDomainSAMLOptionsConfigUpdate(userName string, domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "es_master_user" {
+  name = "%s"
+}
+
+resource "aws_opensearch_domain" "example" {
+  domain_name           = "%s"
+  engine_version = "Elasticsearch_7.10"
+
+  cluster_config {
+    instance_type = "r5.large.search"
+  }
+
+  # Advanced security option must be enabled to configure SAML.
+  advanced_security_options {
+    enabled                        = true
+    internal_user_database_enabled = false
+    master_user_options {
+      master_user_arn = aws_iam_user.es_master_user.arn
+    }
+  }
+
+  # You must enable node-to-node encryption to use advanced security options.
+  encrypt_at_rest {
+    enabled = true
+  }
+
+  domain_endpoint_options {
+    enforce_https       = true
+    tls_security_policy = "Policy-Min-TLS-1-2-2019-07"
+  }
+
+  node_to_node_encryption {
+    enabled = true
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+
+resource "aws_opensearch_domain_saml_options" "main" {
+  domain_name = aws_opensearch_domain.example.domain_name
+
+  saml_options {
+    enabled = true
+    idp {
+      entity_id        = "https://terraform-dev-ed.my.salesforce.com"
+      metadata_content = file("./test-fixtures/saml-metadata.xml")
+    }
+    session_timeout_minutes = 180
+  }
+}
+`, userName, domainName)
+}
+
+func testAccDomainSAMLOptionsConfigDisabled(userName string, domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "es_master_user" {
+  name = "%s"
+}
+
+resource "aws_opensearch_domain" "example" {
+  domain_name           = "%s"
+  engine_version = "Elasticsearch_7.10"
+
+  cluster_config {
+    instance_type = "r5.large.search"
+  }
+
+  # Advanced security option must be enabled to configure SAML.
+  advanced_security_options {
+    enabled                        = true
+    internal_user_database_enabled = false
+    master_user_options {
+      master_user_arn = aws_iam_user.es_master_user.arn
+    }
+  }
+
+  # You must enable node-to-node encryption to use advanced security options.
+  encrypt_at_rest {
+    enabled = true
+  }
+
+  domain_endpoint_options {
+    enforce_https       = true
+    tls_security_policy = "Policy-Min-TLS-1-2-2019-07"
+  }
+
+  node_to_node_encryption {
+    enabled = true
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+
+resource "aws_opensearch_domain_saml_options" "main" {
+  domain_name = aws_opensearch_domain.example.domain_name
+
+  saml_options {
+    enabled = false
+  }
+}
+`, userName, domainName)
+}
@@ -0,0 +1,177 @@
+package opensearch
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	opensearch "github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func expandAdvancedSecurityOptions(m []interface{}) *opensearch.AdvancedSecurityOptionsInput_ {
+	config := opensearch.AdvancedSecurityOptionsInput_{}
+	group := m[0].(map[string]interface{})
+
+	if advancedSecurityEnabled, ok := group["enabled"]; ok {
+		config.Enabled = aws.Bool(advancedSecurityEnabled.(bool))
+
+		if advancedSecurityEnabled.(bool) {
+			if v, ok := group["internal_user_database_enabled"].(bool); ok {
+				config.InternalUserDatabaseEnabled = aws.Bool(v)
+			}
+
+			if v, ok := group["master_user_options"].([]interface{}); ok {
+				if len(v) > 0 && v[0] != nil {
+					muo := opensearch.MasterUserOptions{}
+					masterUserOptions := v[0].(map[string]interface{})
+
+					if v, ok := masterUserOptions["master_user_arn"].(string); ok && v != "" {
+						muo.MasterUserARN = aws.String(v)
+					}
+
+					if v, ok := masterUserOptions["master_user_name"].(string); ok && v != "" {
+						muo.MasterUserName = aws.String(v)
+					}
+
+					if v, ok := masterUserOptions["master_user_password"].(string); ok && v != "" {
+						muo.MasterUserPassword = aws.String(v)
+					}
+
+					config.SetMasterUserOptions(&muo)
+				}
+			}
+		}
+	}
+
+	return &config
+}
+
+func expandSAMLOptions(data []interface{}) *opensearch.SAMLOptionsInput_ {
+	if len(data) == 0 {
+		return nil
+	}
+
+	if data[0] == nil {
+		return &opensearch.SAMLOptionsInput_{}
+	}
+
+	options := opensearch.SAMLOptionsInput_{}
+	group := data[0].(map[string]interface{})
+
+	if SAMLEnabled, ok := group["enabled"]; ok {
+		options.Enabled = aws.Bool(SAMLEnabled.(bool))
+
+		if SAMLEnabled.(bool) {
+			options.Idp = expandSAMLOptionsIdp(group["idp"].([]interface{}))
+			if v, ok := group["master_backend_role"].(string); ok && v != "" {
+				options.MasterBackendRole = aws.String(v)
+			}
+			if v, ok := group["master_user_name"].(string); ok && v != "" {
+				options.MasterUserName = aws.String(v)
+			}
+			if v, ok := group["roles_key"].(string); ok {
+				options.RolesKey = aws.String(v)
+			}
+			if v, ok := group["session_timeout_minutes"].(int); ok {
+				options.SessionTimeoutMinutes = aws.Int64(int64(v))
+			}
+			if v, ok := group["subject_key"].(string); ok {
+				options.SubjectKey = aws.String(v)
+			}
+		}
+	}
+
+	return &options
+}
+
+func expandSAMLOptionsIdp(l []interface{}) *opensearch.SAMLIdp {
+	if len(l) == 0 {
+		return nil
+	}
+
+	if l[0] == nil {
+		return &opensearch.SAMLIdp{}
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &opensearch.SAMLIdp{
+		EntityId:        aws.String(m["entity_id"].(string)),
+		MetadataContent: aws.String(m["metadata_content"].(string)),
+	}
+}
+
+func flattenAdvancedSecurityOptions(advancedSecurityOptions *opensearch.AdvancedSecurityOptions) []map[string]interface{} {
+	if advancedSecurityOptions == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+	m["enabled"] = aws.BoolValue(advancedSecurityOptions.Enabled)
+	if aws.BoolValue(advancedSecurityOptions.Enabled) {
+		m["internal_user_database_enabled"] = aws.BoolValue(advancedSecurityOptions.InternalUserDatabaseEnabled)
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func flattenSAMLOptions(d *schema.ResourceData, samlOptions *opensearch.SAMLOptionsOutput_) []interface{} {
+	if samlOptions == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"enabled": aws.BoolValue(samlOptions.Enabled),
+		"idp":     flattenSAMLIdpOptions(samlOptions.Idp),
+	}
+
+	m["roles_key"] = aws.StringValue(samlOptions.RolesKey)
+	m["session_timeout_minutes"] = aws.Int64Value(samlOptions.SessionTimeoutMinutes)
+	m["subject_key"] = aws.StringValue(samlOptions.SubjectKey)
+
+	// samlOptions.master_backend_role and samlOptions.master_user_name will be added to the
+	// all_access role in OpenSearch Dashboards security manager.  These values cannot be read or
+	// modified by the OpenSearch API.  So, we ignore it on read and let persist
+	// the value already in the state.
+	m["master_backend_role"] = d.Get("saml_options.0.master_backend_role").(string)
+	m["master_user_name"] = d.Get("saml_options.0.master_user_name").(string)
+
+	return []interface{}{m}
+}
+
+func flattenSAMLIdpOptions(SAMLIdp *opensearch.SAMLIdp) []interface{} {
+	if SAMLIdp == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"entity_id":        aws.StringValue(SAMLIdp.EntityId),
+		"metadata_content": aws.StringValue(SAMLIdp.MetadataContent),
+	}
+
+	return []interface{}{m}
+}
+
+func getMasterUserOptions(d *schema.ResourceData) []interface{} {
+	if v, ok := d.GetOk("advanced_security_options"); ok {
+		options := v.([]interface{})
+		if len(options) > 0 && options[0] != nil {
+			m := options[0].(map[string]interface{})
+			if opts, ok := m["master_user_options"]; ok {
+				return opts.([]interface{})
+			}
+		}
+	}
+	return []interface{}{}
+}
+
+func getUserDBEnabled(d *schema.ResourceData) bool {
+	if v, ok := d.GetOk("advanced_security_options"); ok {
+		options := v.([]interface{})
+		if len(options) > 0 && options[0] != nil {
+			m := options[0].(map[string]interface{})
+			if enabled, ok := m["internal_user_database_enabled"]; ok {
+				return enabled.(bool)
+			}
+		}
+	}
+	return false
+}
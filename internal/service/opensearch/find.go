@@ -0,0 +1,142 @@
+package opensearch
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	opensearch "github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindOutboundConnectionByID(conn *opensearch.OpenSearchService, id string) (*opensearch.OutboundConnection, error) {
+	input := &opensearch.DescribeOutboundConnectionsInput{
+		Filters: []*opensearch.Filter{
+			{
+				Name:   aws.String("connection-id"),
+				Values: aws.StringSlice([]string{id}),
+			},
+		},
+	}
+
+	var output *opensearch.OutboundConnection
+
+	err := conn.DescribeOutboundConnectionsPages(input, func(page *opensearch.DescribeOutboundConnectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Connections {
+			if v != nil && aws.StringValue(v.ConnectionId) == id {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConnectionStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.ConnectionStatus.StatusCode); status == opensearch.OutboundConnectionStatusCodeDeleted {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindInboundConnectionByID(conn *opensearch.OpenSearchService, id string) (*opensearch.InboundConnection, error) {
+	input := &opensearch.DescribeInboundConnectionsInput{
+		Filters: []*opensearch.Filter{
+			{
+				Name:   aws.String("connection-id"),
+				Values: aws.StringSlice([]string{id}),
+			},
+		},
+	}
+
+	var output *opensearch.InboundConnection
+
+	err := conn.DescribeInboundConnectionsPages(input, func(page *opensearch.DescribeInboundConnectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Connections {
+			if v != nil && aws.StringValue(v.ConnectionId) == id {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConnectionStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := aws.StringValue(output.ConnectionStatus.StatusCode); status == opensearch.InboundConnectionStatusCodeDeleted || status == opensearch.InboundConnectionStatusCodeRejected {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func FindPackageAssociationByTwoPartKey(conn *opensearch.OpenSearchService, domainName, packageID string) (*opensearch.DomainPackageDetails, error) {
+	input := &opensearch.ListPackagesForDomainInput{
+		DomainName: aws.String(domainName),
+	}
+
+	var output *opensearch.DomainPackageDetails
+
+	err := conn.ListPackagesForDomainPages(input, func(page *opensearch.ListPackagesForDomainOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DomainPackageDetailsList {
+			if v != nil && aws.StringValue(v.PackageID) == packageID {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearch.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,25 @@
+package opensearch
+
+import (
+	"fmt"
+	"strings"
+)
+
+const packageAssociationIDSeparator = ":"
+
+func PackageAssociationCreateID(domainName, packageID string) string {
+	parts := []string{domainName, packageID}
+	id := strings.Join(parts, packageAssociationIDSeparator)
+
+	return id
+}
+
+func PackageAssociationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, packageAssociationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DOMAIN-NAME%[2]sPACKAGE-ID", id, packageAssociationIDSeparator)
+}
@@ -0,0 +1,204 @@
+package opensearch_test
+
+import (
+	"fmt"
+	"testing"
+
+	opensearch "github.com/aws/aws-sdk-go/service/opensearchservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearch "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccOpenSearchOutboundConnection_basic(t *testing.T) {
+	var v opensearch.OutboundConnection
+	ri := sdkacctest.RandString(10)
+	name := fmt.Sprintf("tf-test-%s", ri)
+	resourceName := "aws_opensearch_outbound_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckIamServiceLinkedRole(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckOutboundConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutboundConnectionConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutboundConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "connection_alias", name),
+					resource.TestCheckResourceAttr(resourceName, "connection_status", opensearch.OutboundConnectionStatusCodePendingAcceptance),
+					resource.TestCheckResourceAttr(resourceName, "local_domain_info.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "local_domain_info.0.domain_name", "aws_opensearch_domain.domain_1", "domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "remote_domain_info.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "remote_domain_info.0.domain_name", "aws_opensearch_domain.domain_2", "domain_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchOutboundConnection_disappears(t *testing.T) {
+	var v opensearch.OutboundConnection
+	ri := sdkacctest.RandString(10)
+	name := fmt.Sprintf("tf-test-%s", ri)
+	resourceName := "aws_opensearch_outbound_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckIamServiceLinkedRole(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckOutboundConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOutboundConnectionConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOutboundConnectionExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfopensearch.ResourceOutboundConnection(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckOutboundConnectionExists(n string, v *opensearch.OutboundConnection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpenSearch Outbound Connection ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn()
+
+		output, err := tfopensearch.FindOutboundConnectionByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckOutboundConnectionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_opensearch_outbound_connection" {
+			continue
+		}
+
+		_, err := tfopensearch.FindOutboundConnectionByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("OpenSearch Outbound Connection %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccOutboundConnectionBaseConfig(name string) string {
+	// Cross-cluster search requires node-to-node encryption and HTTPS on both domains.
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_opensearch_domain" "domain_1" {
+  domain_name    = "%[1]s-1"
+  engine_version = "OpenSearch_1.0"
+
+  cluster_config {
+    instance_type = "t3.small.search"
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+
+  node_to_node_encryption {
+    enabled = true
+  }
+
+  encrypt_at_rest {
+    enabled = true
+  }
+
+  domain_endpoint_options {
+    enforce_https       = true
+    tls_security_policy = "Policy-Min-TLS-1-2-2019-07"
+  }
+}
+
+resource "aws_opensearch_domain" "domain_2" {
+  domain_name    = "%[1]s-2"
+  engine_version = "OpenSearch_1.0"
+
+  cluster_config {
+    instance_type = "t3.small.search"
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+
+  node_to_node_encryption {
+    enabled = true
+  }
+
+  encrypt_at_rest {
+    enabled = true
+  }
+
+  domain_endpoint_options {
+    enforce_https       = true
+    tls_security_policy = "Policy-Min-TLS-1-2-2019-07"
+  }
+}
+`, name)
+}
+
+func testAccOutboundConnectionConfig(name string) string {
+	return acctest.ConfigCompose(testAccOutboundConnectionBaseConfig(name), fmt.Sprintf(`
+resource "aws_opensearch_outbound_connection" "test" {
+  connection_alias = %[1]q
+
+  local_domain_info {
+    owner_id    = data.aws_caller_identity.current.account_id
+    region      = data.aws_region.current.name
+    domain_name = aws_opensearch_domain.domain_1.domain_name
+  }
+
+  remote_domain_info {
+    owner_id    = data.aws_caller_identity.current.account_id
+    region      = data.aws_region.current.name
+    domain_name = aws_opensearch_domain.domain_2.domain_name
+  }
+}
+`, name))
+}
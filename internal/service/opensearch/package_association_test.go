@@ -0,0 +1,158 @@
+package opensearch_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	opensearch "github.com/aws/aws-sdk-go/service/opensearchservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfopensearch "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Packages are not yet managed by Terraform, so these tests use an existing package.
+func testAccPreCheckPackageAssociation(t *testing.T) string {
+	packageID := os.Getenv("AWS_OPENSEARCH_PACKAGE_ID")
+
+	if packageID == "" {
+		t.Skip("Environment variable AWS_OPENSEARCH_PACKAGE_ID is not set")
+	}
+
+	return packageID
+}
+
+func TestAccOpenSearchPackageAssociation_basic(t *testing.T) {
+	packageID := testAccPreCheckPackageAssociation(t)
+	name := fmt.Sprintf("tf-test-%s", sdkacctest.RandString(10))
+	resourceName := "aws_opensearch_package_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckIamServiceLinkedRole(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPackageAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageAssociationConfig(name, packageID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_name", "aws_opensearch_domain.test", "domain_name"),
+					resource.TestCheckResourceAttr(resourceName, "package_id", packageID),
+					resource.TestCheckResourceAttrSet(resourceName, "package_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "reference_path"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOpenSearchPackageAssociation_disappears(t *testing.T) {
+	packageID := testAccPreCheckPackageAssociation(t)
+	name := fmt.Sprintf("tf-test-%s", sdkacctest.RandString(10))
+	resourceName := "aws_opensearch_package_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckIamServiceLinkedRole(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, opensearch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPackageAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageAssociationConfig(name, packageID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageAssociationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfopensearch.ResourcePackageAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPackageAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No OpenSearch Package Association ID is set")
+		}
+
+		domainName, packageID, err := tfopensearch.PackageAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn()
+
+		_, err = tfopensearch.FindPackageAssociationByTwoPartKey(conn, domainName, packageID)
+
+		return err
+	}
+}
+
+func testAccCheckPackageAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).OpenSearchConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_opensearch_package_association" {
+			continue
+		}
+
+		domainName, packageID, err := tfopensearch.PackageAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfopensearch.FindPackageAssociationByTwoPartKey(conn, domainName, packageID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("OpenSearch Package Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPackageAssociationConfig(name, packageID string) string {
+	return fmt.Sprintf(`
+resource "aws_opensearch_domain" "test" {
+  domain_name    = %[1]q
+  engine_version = "OpenSearch_1.0"
+
+  cluster_config {
+    instance_type = "t3.small.search"
+  }
+
+  ebs_options {
+    ebs_enabled = true
+    volume_size = 10
+  }
+}
+
+resource "aws_opensearch_package_association" "test" {
+  domain_name = aws_opensearch_domain.test.domain_name
+  package_id  = %[2]q
+}
+`, name, packageID)
+}
@@ -0,0 +1,56 @@
+package opensearch
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	opensearch "github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusOutboundConnection(conn *opensearch.OpenSearchService, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindOutboundConnectionByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ConnectionStatus.StatusCode), nil
+	}
+}
+
+func statusInboundConnection(conn *opensearch.OpenSearchService, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindInboundConnectionByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ConnectionStatus.StatusCode), nil
+	}
+}
+
+func statusPackageAssociation(conn *opensearch.OpenSearchService, domainName, packageID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPackageAssociationByTwoPartKey(conn, domainName, packageID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DomainPackageStatus), nil
+	}
+}
@@ -0,0 +1,152 @@
+package opensearch
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	opensearch "github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	inboundConnectionAcceptedTimeout = 1 * time.Minute
+	inboundConnectionDeletedTimeout  = 1 * time.Minute
+	outboundConnectionCreatedTimeout = 1 * time.Minute
+	outboundConnectionDeletedTimeout = 1 * time.Minute
+	packageAssociatedTimeout         = 10 * time.Minute
+	packageDissociatedTimeout        = 10 * time.Minute
+)
+
+func waitOutboundConnectionCreated(conn *opensearch.OpenSearchService, id string) (*opensearch.OutboundConnection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearch.OutboundConnectionStatusCodeValidating},
+		Target: []string{
+			opensearch.OutboundConnectionStatusCodePendingAcceptance,
+			opensearch.OutboundConnectionStatusCodeApproved,
+			opensearch.OutboundConnectionStatusCodeProvisioning,
+			opensearch.OutboundConnectionStatusCodeActive,
+		},
+		Refresh: statusOutboundConnection(conn, id),
+		Timeout: outboundConnectionCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearch.OutboundConnection); ok {
+		if status := aws.StringValue(output.ConnectionStatus.StatusCode); status == opensearch.OutboundConnectionStatusCodeValidationFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionStatus.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitOutboundConnectionDeleted(conn *opensearch.OpenSearchService, id string) (*opensearch.OutboundConnection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			opensearch.OutboundConnectionStatusCodeDeleting,
+			opensearch.OutboundConnectionStatusCodeRejecting,
+		},
+		Target:  []string{},
+		Refresh: statusOutboundConnection(conn, id),
+		Timeout: outboundConnectionDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearch.OutboundConnection); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInboundConnectionAccepted(conn *opensearch.OpenSearchService, id string) (*opensearch.InboundConnection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			opensearch.InboundConnectionStatusCodeApproved,
+			opensearch.InboundConnectionStatusCodeProvisioning,
+		},
+		Target:  []string{opensearch.InboundConnectionStatusCodeActive},
+		Refresh: statusInboundConnection(conn, id),
+		Timeout: inboundConnectionAcceptedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearch.InboundConnection); ok {
+		if status := aws.StringValue(output.ConnectionStatus.StatusCode); status != opensearch.InboundConnectionStatusCodeActive {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ConnectionStatus.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitInboundConnectionDeleted(conn *opensearch.OpenSearchService, id string) (*opensearch.InboundConnection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			opensearch.InboundConnectionStatusCodeDeleting,
+			opensearch.InboundConnectionStatusCodeRejecting,
+		},
+		Target:  []string{},
+		Refresh: statusInboundConnection(conn, id),
+		Timeout: inboundConnectionDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearch.InboundConnection); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPackageAssociated(conn *opensearch.OpenSearchService, domainName, packageID string) (*opensearch.DomainPackageDetails, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearch.DomainPackageStatusAssociating},
+		Target:  []string{opensearch.DomainPackageStatusActive},
+		Refresh: statusPackageAssociation(conn, domainName, packageID),
+		Timeout: packageAssociatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearch.DomainPackageDetails); ok {
+		if output.ErrorDetails != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorDetails.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPackageDissociated(conn *opensearch.OpenSearchService, domainName, packageID string) (*opensearch.DomainPackageDetails, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{opensearch.DomainPackageStatusDissociating},
+		Target:  []string{},
+		Refresh: statusPackageAssociation(conn, domainName, packageID),
+		Timeout: packageDissociatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*opensearch.DomainPackageDetails); ok {
+		if output.ErrorDetails != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.ErrorDetails.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
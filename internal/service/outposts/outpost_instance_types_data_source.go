@@ -30,7 +30,7 @@ func DataSourceOutpostInstanceTypes() *schema.Resource {
 }
 
 func dataSourceOutpostInstanceTypesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).OutpostsConn
+	conn := meta.(*conns.AWSClient).OutpostsConn()
 
 	input := &outposts.GetOutpostInstanceTypesInput{
 		OutpostId: aws.String(d.Get("arn").(string)), // Accepts both ARN and ID; prefer ARN which is more common
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/prometheusservice"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -60,3 +61,20 @@ func statusWorkspaceDeleted(ctx context.Context, conn *prometheusservice.Prometh
 		return output.Workspace, aws.StringValue(output.Workspace.Status.StatusCode), nil
 	}
 }
+
+// statusAlertManagerDefinition fetches the AlertManagerDefinition and its Status.
+func statusAlertManagerDefinition(ctx context.Context, conn *prometheusservice.PrometheusService, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAlertManagerDefinitionByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status.StatusCode), nil
+	}
+}
@@ -0,0 +1,26 @@
+package prometheus
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+)
+
+// validAlertManagerDefinition checks that an alert manager definition is a YAML
+// document containing a top-level "alertmanager_config" key, as required by AMP.
+func validAlertManagerDefinition(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	var definition map[string]interface{}
+
+	if err := yaml.Unmarshal([]byte(value), &definition); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid YAML: %s", k, err))
+		return
+	}
+
+	if _, ok := definition["alertmanager_config"]; !ok {
+		errors = append(errors, fmt.Errorf("%q must contain a top-level alertmanager_config key", k))
+	}
+
+	return
+}
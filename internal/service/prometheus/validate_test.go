@@ -0,0 +1,45 @@
+package prometheus
+
+import (
+	"testing"
+)
+
+func TestValidAlertManagerDefinition(t *testing.T) {
+	validDefinitions := []string{
+		`
+alertmanager_config: |
+  route:
+    receiver: 'default'
+  receivers:
+    - name: 'default'
+`,
+		`{"alertmanager_config": "route:\n  receiver: default\n"}`,
+		`
+template_files:
+  default_template: |
+    {{ define "sns.default.message" }}{{ .Status }}{{ end }}
+alertmanager_config: |
+  route:
+    receiver: 'default'
+`,
+	}
+	for _, v := range validDefinitions {
+		_, errors := validAlertManagerDefinition(v, "definition")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid alert manager definition: %q", v, errors)
+		}
+	}
+
+	invalidDefinitions := []string{
+		"",
+		"route:\n  receiver: 'default'\n",
+		"alertmanager_config: [\n",
+		"- alertmanager_config\n",
+	}
+	for _, v := range invalidDefinitions {
+		_, errors := validAlertManagerDefinition(v, "definition")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid alert manager definition", v)
+		}
+	}
+}
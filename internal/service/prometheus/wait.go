@@ -2,15 +2,21 @@ package prometheus
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/prometheusservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
 	// Maximum amount of time to wait for a Workspace to be created, updated, or deleted
 	workspaceTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for an AlertManagerDefinition to be created, updated, or deleted
+	alertManagerDefinitionTimeout = 5 * time.Minute
 )
 
 // waitWorkspaceCreated waits for a Workspace to return "Active"
@@ -48,3 +54,65 @@ func waitWorkspaceDeleted(ctx context.Context, conn *prometheusservice.Prometheu
 
 	return nil, err
 }
+
+// waitAlertManagerDefinitionCreated waits for an AlertManagerDefinition to return "Active"
+func waitAlertManagerDefinitionCreated(ctx context.Context, conn *prometheusservice.PrometheusService, id string) (*prometheusservice.AlertManagerDefinitionDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{prometheusservice.AlertManagerDefinitionStatusCodeCreating},
+		Target:  []string{prometheusservice.AlertManagerDefinitionStatusCodeActive},
+		Refresh: statusAlertManagerDefinition(ctx, conn, id),
+		Timeout: alertManagerDefinitionTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*prometheusservice.AlertManagerDefinitionDescription); ok {
+		if statusCode := aws.StringValue(output.Status.StatusCode); statusCode == prometheusservice.AlertManagerDefinitionStatusCodeCreationFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.StatusReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitAlertManagerDefinitionUpdated waits for an AlertManagerDefinition to return "Active"
+func waitAlertManagerDefinitionUpdated(ctx context.Context, conn *prometheusservice.PrometheusService, id string) (*prometheusservice.AlertManagerDefinitionDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{prometheusservice.AlertManagerDefinitionStatusCodeUpdating},
+		Target:  []string{prometheusservice.AlertManagerDefinitionStatusCodeActive},
+		Refresh: statusAlertManagerDefinition(ctx, conn, id),
+		Timeout: alertManagerDefinitionTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*prometheusservice.AlertManagerDefinitionDescription); ok {
+		if statusCode := aws.StringValue(output.Status.StatusCode); statusCode == prometheusservice.AlertManagerDefinitionStatusCodeUpdateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.StatusReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitAlertManagerDefinitionDeleted waits for an AlertManagerDefinition to be removed
+func waitAlertManagerDefinitionDeleted(ctx context.Context, conn *prometheusservice.PrometheusService, id string) (*prometheusservice.AlertManagerDefinitionDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{prometheusservice.AlertManagerDefinitionStatusCodeDeleting},
+		Target:  []string{},
+		Refresh: statusAlertManagerDefinition(ctx, conn, id),
+		Timeout: alertManagerDefinitionTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*prometheusservice.AlertManagerDefinitionDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -56,7 +56,7 @@ func ResourceResourceShare() *schema.Resource {
 }
 
 func resourceResourceShareCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RAMConn
+	conn := meta.(*conns.AWSClient).RAMConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -86,7 +86,7 @@ func resourceResourceShareCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceResourceShareRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RAMConn
+	conn := meta.(*conns.AWSClient).RAMConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -126,7 +126,7 @@ func resourceResourceShareRead(d *schema.ResourceData, meta interface{}) error {
 	tags := KeyValueTags(resourceShare.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -138,7 +138,7 @@ func resourceResourceShareRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceResourceShareUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RAMConn
+	conn := meta.(*conns.AWSClient).RAMConn()
 
 	if d.HasChanges("name", "allow_external_principals") {
 		request := &ram.UpdateResourceShareInput{
@@ -171,7 +171,7 @@ func resourceResourceShareUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceResourceShareDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RAMConn
+	conn := meta.(*conns.AWSClient).RAMConn()
 
 	deleteResourceShareInput := &ram.DeleteResourceShareInput{
 		ResourceShareArn: aws.String(d.Id()),
@@ -58,3 +58,34 @@ func ExportableLogType_Values() []string {
 		ExportableLogTypeUpgrade,
 	}
 }
+
+// https://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_ExportTask.html.
+const (
+	ExportTaskStatusCanceled   = "CANCELED"
+	ExportTaskStatusCanceling  = "CANCELING"
+	ExportTaskStatusComplete   = "COMPLETE"
+	ExportTaskStatusFailed     = "FAILED"
+	ExportTaskStatusInProgress = "IN_PROGRESS"
+	ExportTaskStatusStarting   = "STARTING"
+)
+
+const (
+	ReservedInstanceOfferingTypeAllUpfront     = "All Upfront"
+	ReservedInstanceOfferingTypeNoUpfront      = "No Upfront"
+	ReservedInstanceOfferingTypePartialUpfront = "Partial Upfront"
+)
+
+func ReservedInstanceOfferingType_Values() []string {
+	return []string{
+		ReservedInstanceOfferingTypeAllUpfront,
+		ReservedInstanceOfferingTypeNoUpfront,
+		ReservedInstanceOfferingTypePartialUpfront,
+	}
+}
+
+const (
+	ReservedInstanceStateActive         = "active"
+	ReservedInstanceStatePaymentFailed  = "payment-failed"
+	ReservedInstanceStatePaymentPending = "payment-pending"
+	ReservedInstanceStateRetired        = "retired"
+)
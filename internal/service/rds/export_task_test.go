@@ -0,0 +1,175 @@
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRDSExportTask_basic(t *testing.T) {
+	var v rds.ExportTask
+	resourceName := "aws_rds_export_task.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckExportTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportTaskConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExportTaskExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "export_task_identifier", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "source_arn", "aws_db_snapshot.test", "db_snapshot_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_bucket_name", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "export_only.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "export_only.0", "baz"),
+					resource.TestCheckResourceAttr(resourceName, "s3_prefix", "exports"),
+					resource.TestCheckResourceAttr(resourceName, "status", tfrds.ExportTaskStatusComplete),
+					resource.TestCheckResourceAttr(resourceName, "percent_progress", "100"),
+					resource.TestCheckResourceAttrSet(resourceName, "task_end_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// Completed export tasks cannot be deleted, so only check that no task is still in progress.
+func testAccCheckExportTaskDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_rds_export_task" {
+			continue
+		}
+
+		output, err := tfrds.FindExportTaskByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		switch status := aws.StringValue(output.Status); status {
+		case tfrds.ExportTaskStatusCanceled, tfrds.ExportTaskStatusComplete, tfrds.ExportTaskStatusFailed:
+			continue
+		default:
+			return fmt.Errorf("RDS Export Task %s still %s", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckExportTaskExists(n string, v *rds.ExportTask) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RDS Export Task ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSConn()
+
+		output, err := tfrds.FindExportTaskByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccExportTaskConfig(rName string) string {
+	return acctest.ConfigCompose(testAccSnapshotConfig(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "export.rds.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:ListBucket",
+        "s3:GetBucketLocation",
+      ]
+      Effect   = "Allow"
+      Resource = aws_s3_bucket.test.arn
+      }, {
+      Action = [
+        "s3:PutObject*",
+        "s3:GetObject*",
+        "s3:DeleteObject*",
+      ]
+      Effect   = "Allow"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+    }]
+  })
+}
+
+resource "aws_rds_export_task" "test" {
+  export_task_identifier = %[1]q
+  source_arn             = aws_db_snapshot.test.db_snapshot_arn
+  s3_bucket_name         = aws_s3_bucket.test.id
+  iam_role_arn           = aws_iam_role.test.arn
+  kms_key_id             = aws_kms_key.test.arn
+  export_only            = ["baz"]
+  s3_prefix              = "exports"
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
@@ -189,3 +189,61 @@ func FindEventSubscriptionByID(conn *rds.RDS, id string) (*rds.EventSubscription
 
 	return output.EventSubscriptionsList[0], nil
 }
+
+func FindExportTaskByID(conn *rds.RDS, id string) (*rds.ExportTask, error) {
+	input := &rds.DescribeExportTasksInput{
+		ExportTaskIdentifier: aws.String(id),
+	}
+
+	output, err := conn.DescribeExportTasks(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeExportTaskNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ExportTasks) == 0 || output.ExportTasks[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ExportTasks); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ExportTasks[0], nil
+}
+
+func FindReservedDBInstanceByID(conn *rds.RDS, id string) (*rds.ReservedDBInstance, error) {
+	input := &rds.DescribeReservedDBInstancesInput{
+		ReservedDBInstanceId: aws.String(id),
+	}
+
+	output, err := conn.DescribeReservedDBInstances(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeReservedDBInstanceNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ReservedDBInstances) == 0 || output.ReservedDBInstances[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ReservedDBInstances); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ReservedDBInstances[0], nil
+}
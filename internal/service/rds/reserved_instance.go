@@ -0,0 +1,238 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceReservedInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceReservedInstanceCreate,
+		Read:   resourceReservedInstanceRead,
+		Update: resourceReservedInstanceUpdate,
+		Delete: resourceReservedInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"currency_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_instance_class": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"duration": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fixed_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"instance_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"lease_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"multi_az": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"offering_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"offering_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"product_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recurring_charges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recurring_charge_amount": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"recurring_charge_frequency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"reservation_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"usage_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceReservedInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &rds.PurchaseReservedDBInstancesOfferingInput{
+		DBInstanceCount:               aws.Int64(int64(d.Get("instance_count").(int))),
+		ReservedDBInstancesOfferingId: aws.String(d.Get("offering_id").(string)),
+	}
+
+	if v, ok := d.GetOk("reservation_id"); ok {
+		input.ReservedDBInstanceId = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Purchasing RDS Reserved Instance: %s", input)
+	output, err := conn.PurchaseReservedDBInstancesOffering(input)
+
+	if err != nil {
+		return fmt.Errorf("error purchasing RDS Reserved Instance offering (%s): %w", d.Get("offering_id").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.ReservedDBInstance.ReservedDBInstanceId))
+
+	if _, err := waitReservedInstanceCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for RDS Reserved Instance (%s) to become active: %w", d.Id(), err)
+	}
+
+	return resourceReservedInstanceRead(d, meta)
+}
+
+func resourceReservedInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	reservation, err := FindReservedDBInstanceByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS Reserved Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS Reserved Instance (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(reservation.ReservedDBInstanceArn)
+	d.Set("arn", arn)
+	d.Set("currency_code", reservation.CurrencyCode)
+	d.Set("db_instance_class", reservation.DBInstanceClass)
+	d.Set("duration", reservation.Duration)
+	d.Set("fixed_price", reservation.FixedPrice)
+	d.Set("instance_count", reservation.DBInstanceCount)
+	d.Set("lease_id", reservation.LeaseId)
+	d.Set("multi_az", reservation.MultiAZ)
+	d.Set("offering_id", reservation.ReservedDBInstancesOfferingId)
+	d.Set("offering_type", reservation.OfferingType)
+	d.Set("product_description", reservation.ProductDescription)
+	if err := d.Set("recurring_charges", flattenRecurringCharges(reservation.RecurringCharges)); err != nil {
+		return fmt.Errorf("error setting recurring_charges: %w", err)
+	}
+	d.Set("reservation_id", reservation.ReservedDBInstanceId)
+	if reservation.StartTime != nil {
+		d.Set("start_time", aws.TimeValue(reservation.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set("start_time", nil)
+	}
+	d.Set("state", reservation.State)
+	d.Set("usage_price", reservation.UsagePrice)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for RDS Reserved Instance (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceReservedInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating RDS Reserved Instance (%s) tags: %w", d.Get("arn").(string), err)
+		}
+	}
+
+	return resourceReservedInstanceRead(d, meta)
+}
+
+func resourceReservedInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	// Reservations cannot be canceled or deleted, they are retired by AWS at the end of their term.
+	log.Printf("[WARN] RDS Reserved Instance (%s) cannot be deleted, removing from state only", d.Id())
+
+	return nil
+}
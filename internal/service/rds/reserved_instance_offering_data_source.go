@@ -0,0 +1,111 @@
+package rds
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceReservedInstanceOffering() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceReservedInstanceOfferingRead,
+
+		Schema: map[string]*schema.Schema{
+			"currency_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_instance_class": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"duration": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice([]int{31536000, 94608000}),
+			},
+			"fixed_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"multi_az": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"offering_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"offering_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(ReservedInstanceOfferingType_Values(), false),
+			},
+			"product_description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceReservedInstanceOfferingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RDSConn()
+
+	input := &rds.DescribeReservedDBInstancesOfferingsInput{
+		DBInstanceClass:    aws.String(d.Get("db_instance_class").(string)),
+		Duration:           aws.String(strconv.Itoa(d.Get("duration").(int))),
+		MultiAZ:            aws.Bool(d.Get("multi_az").(bool)),
+		OfferingType:       aws.String(d.Get("offering_type").(string)),
+		ProductDescription: aws.String(d.Get("product_description").(string)),
+	}
+
+	var offerings []*rds.ReservedDBInstancesOffering
+
+	err := conn.DescribeReservedDBInstancesOfferingsPages(input, func(page *rds.DescribeReservedDBInstancesOfferingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, offering := range page.ReservedDBInstancesOfferings {
+			if offering == nil {
+				continue
+			}
+
+			offerings = append(offerings, offering)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS Reserved Instance Offerings: %w", err)
+	}
+
+	if len(offerings) == 0 {
+		return fmt.Errorf("no RDS Reserved Instance Offerings found matching criteria; try different search")
+	}
+
+	if len(offerings) > 1 {
+		return fmt.Errorf("multiple RDS Reserved Instance Offerings found matching criteria; try different search")
+	}
+
+	offering := offerings[0]
+
+	d.SetId(aws.StringValue(offering.ReservedDBInstancesOfferingId))
+	d.Set("currency_code", offering.CurrencyCode)
+	d.Set("db_instance_class", offering.DBInstanceClass)
+	d.Set("duration", offering.Duration)
+	d.Set("fixed_price", offering.FixedPrice)
+	d.Set("multi_az", offering.MultiAZ)
+	d.Set("offering_id", offering.ReservedDBInstancesOfferingId)
+	d.Set("offering_type", offering.OfferingType)
+	d.Set("product_description", offering.ProductDescription)
+
+	return nil
+}
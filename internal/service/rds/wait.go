@@ -1,10 +1,13 @@
 package rds
 
 import (
+	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -198,3 +201,63 @@ func waitDBClusterInstanceDeleted(conn *rds.RDS, id string, timeout time.Duratio
 
 	return nil, err
 }
+
+func waitExportTaskCreated(conn *rds.RDS, id string, timeout time.Duration) (*rds.ExportTask, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ExportTaskStatusStarting, ExportTaskStatusInProgress},
+		Target:     []string{ExportTaskStatusComplete},
+		Refresh:    statusExportTask(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.ExportTask); ok {
+		if status := aws.StringValue(output.Status); status == ExportTaskStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.FailureCause)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitExportTaskCanceled(conn *rds.RDS, id string, timeout time.Duration) (*rds.ExportTask, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ExportTaskStatusStarting, ExportTaskStatusInProgress, ExportTaskStatusCanceling},
+		Target:     []string{ExportTaskStatusCanceled, ExportTaskStatusComplete, ExportTaskStatusFailed},
+		Refresh:    statusExportTask(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.ExportTask); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReservedInstanceCreated(conn *rds.RDS, id string, timeout time.Duration) (*rds.ReservedDBInstance, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ReservedInstanceStatePaymentPending},
+		Target:     []string{ReservedInstanceStateActive},
+		Refresh:    statusReservedInstance(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.ReservedDBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,131 @@
+package redshift
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataShareAuthorization() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataShareAuthorizationCreate,
+		Read:   resourceDataShareAuthorizationRead,
+		Delete: resourceDataShareAuthorizationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					verify.ValidAccountID,
+					verify.ValidARN,
+				),
+			},
+			"data_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"producer_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareAuthorizationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	dataShareARN := d.Get("data_share_arn").(string)
+	consumerIdentifier := d.Get("consumer_identifier").(string)
+	id := DataShareAssociationCreateID(dataShareARN, consumerIdentifier)
+	input := &redshift.AuthorizeDataShareInput{
+		ConsumerIdentifier: aws.String(consumerIdentifier),
+		DataShareArn:       aws.String(dataShareARN),
+	}
+
+	log.Printf("[DEBUG] Creating Redshift Data Share Authorization: %s", input)
+	_, err := conn.AuthorizeDataShare(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Redshift Data Share Authorization (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceDataShareAuthorizationRead(d, meta)
+}
+
+func resourceDataShareAuthorizationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	dataShareARN, consumerIdentifier, err := DataShareAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	dataShare, association, err := FindDataShareAuthorizationByTwoPartKey(conn, dataShareARN, consumerIdentifier)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Data Share Authorization (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Data Share Authorization (%s): %w", d.Id(), err)
+	}
+
+	d.Set("consumer_identifier", association.ConsumerIdentifier)
+	d.Set("data_share_arn", dataShare.DataShareArn)
+	d.Set("producer_arn", dataShare.ProducerArn)
+	d.Set("status", association.Status)
+
+	return nil
+}
+
+func resourceDataShareAuthorizationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	dataShareARN, consumerIdentifier, err := DataShareAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Redshift Data Share Authorization: %s", d.Id())
+	_, err = conn.DeauthorizeDataShare(&redshift.DeauthorizeDataShareInput{
+		ConsumerIdentifier: aws.String(consumerIdentifier),
+		DataShareArn:       aws.String(dataShareARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeInvalidDataShareFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Redshift Data Share Authorization (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
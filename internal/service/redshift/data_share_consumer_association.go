@@ -0,0 +1,154 @@
+package redshift
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataShareConsumerAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataShareConsumerAssociationCreate,
+		Read:   resourceDataShareConsumerAssociationRead,
+		Delete: resourceDataShareConsumerAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"associate_entire_account": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"associate_entire_account", "consumer_arn"},
+			},
+			"consumer_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"associate_entire_account", "consumer_arn"},
+			},
+			"data_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"producer_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareConsumerAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	dataShareARN := d.Get("data_share_arn").(string)
+	input := &redshift.AssociateDataShareConsumerInput{
+		DataShareArn: aws.String(dataShareARN),
+	}
+
+	// An association with the entire account is identified by the consumer's account ID.
+	consumerIdentifier := meta.(*conns.AWSClient).AccountID
+
+	if v, ok := d.GetOk("associate_entire_account"); ok && v.(bool) {
+		input.AssociateEntireAccount = aws.Bool(true)
+	}
+
+	if v, ok := d.GetOk("consumer_arn"); ok {
+		consumerIdentifier = v.(string)
+		input.ConsumerArn = aws.String(consumerIdentifier)
+	}
+
+	id := DataShareAssociationCreateID(dataShareARN, consumerIdentifier)
+
+	log.Printf("[DEBUG] Creating Redshift Data Share Consumer Association: %s", input)
+	_, err := conn.AssociateDataShareConsumer(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Redshift Data Share Consumer Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceDataShareConsumerAssociationRead(d, meta)
+}
+
+func resourceDataShareConsumerAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	dataShareARN, consumerIdentifier, err := DataShareAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	dataShare, _, err := FindDataShareConsumerAssociationByTwoPartKey(conn, dataShareARN, consumerIdentifier)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Data Share Consumer Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Data Share Consumer Association (%s): %w", d.Id(), err)
+	}
+
+	if arn.IsARN(consumerIdentifier) {
+		d.Set("associate_entire_account", false)
+		d.Set("consumer_arn", consumerIdentifier)
+	} else {
+		d.Set("associate_entire_account", true)
+		d.Set("consumer_arn", nil)
+	}
+	d.Set("data_share_arn", dataShare.DataShareArn)
+	d.Set("producer_arn", dataShare.ProducerArn)
+
+	return nil
+}
+
+func resourceDataShareConsumerAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	dataShareARN, consumerIdentifier, err := DataShareAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &redshift.DisassociateDataShareConsumerInput{
+		DataShareArn: aws.String(dataShareARN),
+	}
+
+	if arn.IsARN(consumerIdentifier) {
+		input.ConsumerArn = aws.String(consumerIdentifier)
+	} else {
+		input.DisassociateEntireAccount = aws.Bool(true)
+	}
+
+	log.Printf("[DEBUG] Deleting Redshift Data Share Consumer Association: %s", d.Id())
+	_, err = conn.DisassociateDataShareConsumer(input)
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeInvalidDataShareFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Redshift Data Share Consumer Association (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
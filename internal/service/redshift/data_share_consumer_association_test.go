@@ -0,0 +1,141 @@
+package redshift_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// The data share must already be authorized for the account running the tests.
+func testAccPreCheckDataShareConsumerAssociation(t *testing.T) string {
+	dataShareARN := os.Getenv("AWS_REDSHIFT_CONSUMER_DATA_SHARE_ARN")
+
+	if dataShareARN == "" {
+		t.Skip("Environment variable AWS_REDSHIFT_CONSUMER_DATA_SHARE_ARN is not set")
+	}
+
+	return dataShareARN
+}
+
+func TestAccRedshiftDataShareConsumerAssociation_basic(t *testing.T) {
+	dataShareARN := testAccPreCheckDataShareConsumerAssociation(t)
+	resourceName := "aws_redshift_data_share_consumer_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDataShareConsumerAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareConsumerAssociationConfig(dataShareARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareConsumerAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "associate_entire_account", "true"),
+					resource.TestCheckResourceAttr(resourceName, "consumer_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "data_share_arn", dataShareARN),
+					resource.TestCheckResourceAttrSet(resourceName, "producer_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedshiftDataShareConsumerAssociation_disappears(t *testing.T) {
+	dataShareARN := testAccPreCheckDataShareConsumerAssociation(t)
+	resourceName := "aws_redshift_data_share_consumer_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDataShareConsumerAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareConsumerAssociationConfig(dataShareARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataShareConsumerAssociationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfredshift.ResourceDataShareConsumerAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDataShareConsumerAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_redshift_data_share_consumer_association" {
+			continue
+		}
+
+		dataShareARN, consumerIdentifier, err := tfredshift.DataShareAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, _, err = tfredshift.FindDataShareConsumerAssociationByTwoPartKey(conn, dataShareARN, consumerIdentifier)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Redshift Data Share Consumer Association %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDataShareConsumerAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Redshift Data Share Consumer Association ID is set")
+		}
+
+		dataShareARN, consumerIdentifier, err := tfredshift.DataShareAssociationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftConn()
+
+		_, _, err = tfredshift.FindDataShareConsumerAssociationByTwoPartKey(conn, dataShareARN, consumerIdentifier)
+
+		return err
+	}
+}
+
+func testAccDataShareConsumerAssociationConfig(dataShareARN string) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_data_share_consumer_association" "test" {
+  data_share_arn           = %[1]q
+  associate_entire_account = true
+}
+`, dataShareARN)
+}
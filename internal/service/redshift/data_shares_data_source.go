@@ -0,0 +1,67 @@
+package redshift
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceDataShares() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDataSharesRead,
+
+		Schema: map[string]*schema.Schema{
+			"data_shares": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_publicly_accessible_consumers": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"data_share_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"producer_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDataSharesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn()
+
+	dataShares, err := FindDataShares(conn, &redshift.DescribeDataSharesInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Data Shares: %w", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	var tfList []interface{}
+
+	for _, dataShare := range dataShares {
+		tfList = append(tfList, map[string]interface{}{
+			"allow_publicly_accessible_consumers": aws.BoolValue(dataShare.AllowPubliclyAccessibleConsumers),
+			"data_share_arn":                      aws.StringValue(dataShare.DataShareArn),
+			"producer_arn":                        aws.StringValue(dataShare.ProducerArn),
+		})
+	}
+
+	if err := d.Set("data_shares", tfList); err != nil {
+		return fmt.Errorf("error setting data_shares: %w", err)
+	}
+
+	return nil
+}
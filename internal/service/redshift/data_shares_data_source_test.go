@@ -0,0 +1,31 @@
+package redshift_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRedshiftDataSharesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_redshift_data_shares.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSharesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "data_shares.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSharesDataSourceConfig = `
+data "aws_redshift_data_shares" "test" {}
+`
@@ -65,3 +65,110 @@ func FindScheduledActionByName(conn *redshift.Redshift, name string) (*redshift.
 
 	return output.ScheduledActions[0], nil
 }
+
+func FindDataShareByARN(conn *redshift.Redshift, arn string) (*redshift.DataShare, error) {
+	input := &redshift.DescribeDataSharesInput{
+		DataShareArn: aws.String(arn),
+	}
+
+	output, err := FindDataShares(conn, input)
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeInvalidDataShareFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 || output[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+func FindDataShares(conn *redshift.Redshift, input *redshift.DescribeDataSharesInput) ([]*redshift.DataShare, error) {
+	var output []*redshift.DataShare
+
+	for {
+		page, err := conn.DescribeDataShares(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			break
+		}
+
+		for _, v := range page.DataShares {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.Marker) == "" {
+			break
+		}
+
+		input.Marker = page.Marker
+	}
+
+	return output, nil
+}
+
+func FindDataShareAssociationByTwoPartKey(conn *redshift.Redshift, dataShareARN, consumerIdentifier string) (*redshift.DataShare, *redshift.DataShareAssociation, error) {
+	dataShare, err := FindDataShareByARN(conn, dataShareARN)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, v := range dataShare.DataShareAssociations {
+		if v != nil && aws.StringValue(v.ConsumerIdentifier) == consumerIdentifier {
+			return dataShare, v, nil
+		}
+	}
+
+	return nil, nil, &resource.NotFoundError{}
+}
+
+func FindDataShareAuthorizationByTwoPartKey(conn *redshift.Redshift, dataShareARN, consumerIdentifier string) (*redshift.DataShare, *redshift.DataShareAssociation, error) {
+	dataShare, association, err := FindDataShareAssociationByTwoPartKey(conn, dataShareARN, consumerIdentifier)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if status := aws.StringValue(association.Status); status == redshift.DataShareStatusDeauthorized || status == redshift.DataShareStatusRejected {
+		return nil, nil, &resource.NotFoundError{
+			Message: status,
+		}
+	}
+
+	return dataShare, association, nil
+}
+
+func FindDataShareConsumerAssociationByTwoPartKey(conn *redshift.Redshift, dataShareARN, consumerIdentifier string) (*redshift.DataShare, *redshift.DataShareAssociation, error) {
+	dataShare, association, err := FindDataShareAssociationByTwoPartKey(conn, dataShareARN, consumerIdentifier)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if status := aws.StringValue(association.Status); status != redshift.DataShareStatusActive {
+		return nil, nil, &resource.NotFoundError{
+			Message: status,
+		}
+	}
+
+	return dataShare, association, nil
+}
@@ -0,0 +1,25 @@
+package redshift
+
+import (
+	"fmt"
+	"strings"
+)
+
+const dataShareAssociationIDSeparator = ","
+
+func DataShareAssociationCreateID(dataShareARN, consumerIdentifier string) string {
+	parts := []string{dataShareARN, consumerIdentifier}
+	id := strings.Join(parts, dataShareAssociationIDSeparator)
+
+	return id
+}
+
+func DataShareAssociationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, dataShareAssociationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DATA-SHARE-ARN%[2]sCONSUMER-IDENTIFIER", id, dataShareAssociationIDSeparator)
+}
@@ -59,7 +59,7 @@ func sweepClusterSnapshots(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).RedshiftConn
+	conn := client.(*conns.AWSClient).RedshiftConn()
 
 	err = conn.DescribeClusterSnapshotsPages(&redshift.DescribeClusterSnapshotsInput{}, func(resp *redshift.DescribeClusterSnapshotsOutput, lastPage bool) bool {
 		if len(resp.Snapshots) == 0 {
@@ -102,7 +102,7 @@ func sweepClusters(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).RedshiftConn
+	conn := client.(*conns.AWSClient).RedshiftConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -148,7 +148,7 @@ func sweepEventSubscriptions(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).RedshiftConn
+	conn := client.(*conns.AWSClient).RedshiftConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -189,7 +189,7 @@ func sweepScheduledActions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).RedshiftConn
+	conn := client.(*conns.AWSClient).RedshiftConn()
 	input := &redshift.DescribeScheduledActionsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -234,7 +234,7 @@ func sweepSnapshotSchedules(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).RedshiftConn
+	conn := client.(*conns.AWSClient).RedshiftConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -288,7 +288,7 @@ func sweepSubnetGroups(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).RedshiftConn
+	conn := client.(*conns.AWSClient).RedshiftConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -125,7 +125,7 @@ func testAccCheckResourceGroupExists(n string, v *resourcegroups.Group) resource
 			return fmt.Errorf("No resource group name is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsConn()
 
 		resp, err := conn.GetGroup(&resourcegroups.GetGroupInput{
 			GroupName: aws.String(rs.Primary.ID),
@@ -150,7 +150,7 @@ func testAccCheckResourceGroupDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsConn()
 		resp, err := conn.GetGroup(&resourcegroups.GetGroupInput{
 			GroupName: aws.String(rs.Primary.ID),
 		})
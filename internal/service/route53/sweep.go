@@ -55,7 +55,7 @@ func sweepHealthchecks(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).Route53Conn
+	conn := client.(*conns.AWSClient).Route53Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -106,7 +106,7 @@ func sweepKeySigningKeys(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).Route53Conn
+	conn := client.(*conns.AWSClient).Route53Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
@@ -183,7 +183,7 @@ func sweepQueryLogs(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).Route53Conn
+	conn := client.(*conns.AWSClient).Route53Conn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListQueryLoggingConfigsPages(&route53.ListQueryLoggingConfigsInput{}, func(page *route53.ListQueryLoggingConfigsOutput, lastPage bool) bool {
@@ -228,7 +228,7 @@ func sweepZones(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).Route53Conn
+	conn := client.(*conns.AWSClient).Route53Conn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
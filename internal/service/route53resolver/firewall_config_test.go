@@ -67,7 +67,7 @@ func TestAccRoute53ResolverFirewallConfig_disappears(t *testing.T) {
 }
 
 func testAccCheckRoute53ResolverFirewallConfigDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_resolver_firewall_config" {
@@ -105,7 +105,7 @@ func testAccCheckRoute53ResolverFirewallConfigExists(n string, v *route53resolve
 			return fmt.Errorf("No Route 53 Resolver DNS Firewall config ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverConn()
 
 		out, err := tfroute53resolver.FindFirewallConfigByID(conn, rs.Primary.ID)
 
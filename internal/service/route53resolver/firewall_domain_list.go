@@ -56,7 +56,7 @@ func ResourceFirewallDomainList() *schema.Resource {
 }
 
 func resourceFirewallDomainListCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -82,7 +82,7 @@ func resourceFirewallDomainListCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceFirewallDomainListRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -133,7 +133,7 @@ func resourceFirewallDomainListRead(d *schema.ResourceData, meta interface{}) er
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -145,7 +145,7 @@ func resourceFirewallDomainListRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceFirewallDomainListUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	if d.HasChange("domains") {
 		o, n := d.GetChange("domains")
@@ -194,7 +194,7 @@ func resourceFirewallDomainListUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceFirewallDomainListDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	_, err := conn.DeleteFirewallDomainList(&route53resolver.DeleteFirewallDomainListInput{
 		FirewallDomainListId: aws.String(d.Id()),
@@ -0,0 +1,138 @@
+package route53resolver
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceFirewallDomainList() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFirewallDomainListRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"creator_request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"domain_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"firewall_domain_list_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"firewall_domain_list_id", "name"},
+			},
+
+			"managed_owner_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"modification_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"firewall_domain_list_id", "name"},
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceFirewallDomainListRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
+
+	id := d.Get("firewall_domain_list_id").(string)
+
+	if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		var ids []string
+
+		// ListFirewallDomainLists includes the AWS managed domain lists, which cannot be looked up any other way.
+		log.Printf("[DEBUG] Listing Route53 Resolver DNS Firewall domain lists")
+		err := conn.ListFirewallDomainListsPages(&route53resolver.ListFirewallDomainListsInput{}, func(page *route53resolver.ListFirewallDomainListsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.FirewallDomainLists {
+				if v == nil || aws.StringValue(v.Name) != name {
+					continue
+				}
+
+				ids = append(ids, aws.StringValue(v.Id))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return fmt.Errorf("error listing Route53 Resolver DNS Firewall domain lists: %w", err)
+		}
+
+		if n := len(ids); n == 0 {
+			return fmt.Errorf("no Route53 Resolver DNS Firewall domain lists matched name (%s)", name)
+		} else if n > 1 {
+			return fmt.Errorf("%d Route53 Resolver DNS Firewall domain lists matched name (%s); use firewall_domain_list_id instead", n, name)
+		}
+
+		id = ids[0]
+	}
+
+	firewallDomainList, err := FindFirewallDomainListByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("error getting Route53 Resolver DNS Firewall domain list (%s): %w", id, err)
+	}
+
+	if firewallDomainList == nil {
+		return fmt.Errorf("error getting Route53 Resolver DNS Firewall domain list (%s): not found", id)
+	}
+
+	d.SetId(aws.StringValue(firewallDomainList.Id))
+	d.Set("arn", firewallDomainList.Arn)
+	d.Set("creation_time", firewallDomainList.CreationTime)
+	d.Set("creator_request_id", firewallDomainList.CreatorRequestId)
+	d.Set("domain_count", firewallDomainList.DomainCount)
+	d.Set("firewall_domain_list_id", firewallDomainList.Id)
+	d.Set("managed_owner_name", firewallDomainList.ManagedOwnerName)
+	d.Set("modification_time", firewallDomainList.ModificationTime)
+	d.Set("name", firewallDomainList.Name)
+	d.Set("status", firewallDomainList.Status)
+	d.Set("status_message", firewallDomainList.StatusMessage)
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package route53resolver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53ResolverFirewallDomainListDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_firewall_domain_list.test"
+	ds1ResourceName := "data.aws_route53_resolver_firewall_domain_list.by_id"
+	ds2ResourceName := "data.aws_route53_resolver_firewall_domain_list.by_name"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, route53resolver.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallDomainListDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(ds1ResourceName, "domain_count", "2"),
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "firewall_domain_list_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(ds1ResourceName, "managed_owner_name", ""),
+					resource.TestCheckResourceAttrPair(ds1ResourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrSet(ds1ResourceName, "creation_time"),
+					resource.TestCheckResourceAttrSet(ds1ResourceName, "modification_time"),
+					resource.TestCheckResourceAttrSet(ds1ResourceName, "status"),
+
+					resource.TestCheckResourceAttrPair(ds2ResourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(ds2ResourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(ds2ResourceName, "firewall_domain_list_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(ds2ResourceName, "name", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ResolverFirewallDomainListDataSource_awsManaged(t *testing.T) {
+	dataSourceName := "data.aws_route53_resolver_firewall_domain_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, route53resolver.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallDomainListDataSourceAWSManagedConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "firewall_domain_list_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "managed_owner_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "AWSManagedDomainsMalwareDomainList"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallDomainListDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_resolver_firewall_domain_list" "test" {
+  name    = %[1]q
+  domains = ["example.com.", "test.example.com."]
+}
+
+data "aws_route53_resolver_firewall_domain_list" "by_id" {
+  firewall_domain_list_id = aws_route53_resolver_firewall_domain_list.test.id
+}
+
+data "aws_route53_resolver_firewall_domain_list" "by_name" {
+  name = aws_route53_resolver_firewall_domain_list.test.name
+}
+`, rName)
+}
+
+func testAccFirewallDomainListDataSourceAWSManagedConfig() string {
+	return `
+data "aws_route53_resolver_firewall_domain_list" "test" {
+  name = "AWSManagedDomainsMalwareDomainList"
+}
+`
+}
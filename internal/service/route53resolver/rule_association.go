@@ -58,7 +58,7 @@ func ResourceRuleAssociation() *schema.Resource {
 }
 
 func resourceRuleAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	req := &route53resolver.AssociateResolverRuleInput{
 		ResolverRuleId: aws.String(d.Get("resolver_rule_id").(string)),
@@ -87,7 +87,7 @@ func resourceRuleAssociationCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceRuleAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	assocRaw, state, err := route53ResolverRuleAssociationRefresh(conn, d.Id())()
 	if err != nil {
@@ -109,7 +109,7 @@ func resourceRuleAssociationRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceRuleAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).Route53ResolverConn
+	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
 	log.Printf("[DEBUG] Deleting Route53 Resolver rule association: %s", d.Id())
 	_, err := conn.DisassociateResolverRule(&route53resolver.DisassociateResolverRuleInput{
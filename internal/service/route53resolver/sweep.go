@@ -101,7 +101,7 @@ func sweepDNSSECConfig(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).Route53ResolverConn
+	conn := client.(*conns.AWSClient).Route53ResolverConn()
 
 	var sweeperErrs *multierror.Error
 	err = conn.ListResolverDnssecConfigsPages(&route53resolver.ListResolverDnssecConfigsInput{}, func(page *route53resolver.ListResolverDnssecConfigsOutput, lastPage bool) bool {
@@ -154,7 +154,7 @@ func sweepEndpoints(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).Route53ResolverConn
+	conn := client.(*conns.AWSClient).Route53ResolverConn()
 
 	var errors error
 	err = conn.ListResolverEndpointsPages(&route53resolver.ListResolverEndpointsInput{}, func(page *route53resolver.ListResolverEndpointsOutput, lastPage bool) bool {
@@ -204,7 +204,7 @@ func sweepFirewallsConfig(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).Route53ResolverConn
+	conn := client.(*conns.AWSClient).Route53ResolverConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListFirewallConfigsPages(&route53resolver.ListFirewallConfigsInput{}, func(page *route53resolver.ListFirewallConfigsOutput, lastPage bool) bool {
@@ -246,7 +246,7 @@ func sweepFirewallDomainLists(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).Route53ResolverConn
+	conn := client.(*conns.AWSClient).Route53ResolverConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListFirewallDomainListsPages(&route53resolver.ListFirewallDomainListsInput{}, func(page *route53resolver.ListFirewallDomainListsOutput, lastPage bool) bool {
@@ -288,7 +288,7 @@ func sweepFirewallRuleGroupAssociations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).Route53ResolverConn
+	conn := client.(*conns.AWSClient).Route53ResolverConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListFirewallRuleGroupAssociationsPages(&route53resolver.ListFirewallRuleGroupAssociationsInput{}, func(page *route53resolver.ListFirewallRuleGroupAssociationsOutput, lastPage bool) bool {
@@ -355,7 +355,7 @@ func sweepFirewallRuleGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).Route53ResolverConn
+	conn := client.(*conns.AWSClient).Route53ResolverConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListFirewallRuleGroupsPages(&route53resolver.ListFirewallRuleGroupsInput{}, func(page *route53resolver.ListFirewallRuleGroupsOutput, lastPage bool) bool {
@@ -397,7 +397,7 @@ func sweepFirewallRules(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).Route53ResolverConn
+	conn := client.(*conns.AWSClient).Route53ResolverConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListFirewallRuleGroupsPages(&route53resolver.ListFirewallRuleGroupsInput{}, func(page *route53resolver.ListFirewallRuleGroupsOutput, lastPage bool) bool {
@@ -473,7 +473,7 @@ func sweepQueryLogAssociationsConfig(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).Route53ResolverConn
+	conn := client.(*conns.AWSClient).Route53ResolverConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListResolverQueryLogConfigAssociationsPages(&route53resolver.ListResolverQueryLogConfigAssociationsInput{}, func(page *route53resolver.ListResolverQueryLogConfigAssociationsOutput, lastPage bool) bool {
@@ -518,7 +518,7 @@ func sweepQueryLogsConfig(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).Route53ResolverConn
+	conn := client.(*conns.AWSClient).Route53ResolverConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListResolverQueryLogConfigsPages(&route53resolver.ListResolverQueryLogConfigsInput{}, func(page *route53resolver.ListResolverQueryLogConfigsOutput, lastPage bool) bool {
@@ -560,7 +560,7 @@ func sweepRuleAssociations(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).Route53ResolverConn
+	conn := client.(*conns.AWSClient).Route53ResolverConn()
 
 	var errors error
 	err = conn.ListResolverRuleAssociationsPages(&route53resolver.ListResolverRuleAssociationsInput{}, func(page *route53resolver.ListResolverRuleAssociationsOutput, lastPage bool) bool {
@@ -615,7 +615,7 @@ func sweepRules(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).Route53ResolverConn
+	conn := client.(*conns.AWSClient).Route53ResolverConn()
 
 	var errors error
 	err = conn.ListResolverRulesPages(&route53resolver.ListResolverRulesInput{}, func(page *route53resolver.ListResolverRulesOutput, lastPage bool) bool {
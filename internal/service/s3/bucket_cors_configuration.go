@@ -0,0 +1,235 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func ResourceBucketCorsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketCorsConfigurationCreate,
+		Read:   resourceBucketCorsConfigurationRead,
+		Update: resourceBucketCorsConfigurationUpdate,
+		Delete: resourceBucketCorsConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"cors_rule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_headers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_methods": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_origins": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"expose_headers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+						"max_age_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBucketCorsConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketCorsInput{
+		Bucket: aws.String(bucket),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: expandBucketCorsRules(d.Get("cors_rule").(*schema.Set).List()),
+		},
+	}
+
+	_, err := waitRetryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketCors(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) CORS Configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketCorsConfigurationRead(d, meta)
+}
+
+func resourceBucketCorsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	input := &s3.GetBucketCorsInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketCors(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchCORSConfiguration) {
+		log.Printf("[WARN] S3 Bucket CORS Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) CORS Configuration: %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) CORS Configuration: empty response", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("cors_rule", flattenBucketCorsRules(output.CORSRules)); err != nil {
+		return fmt.Errorf("error setting cors_rule: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketCorsConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	input := &s3.PutBucketCorsInput{
+		Bucket: aws.String(d.Id()),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: expandBucketCorsRules(d.Get("cors_rule").(*schema.Set).List()),
+		},
+	}
+
+	_, err := conn.PutBucketCors(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) CORS Configuration: %w", d.Id(), err)
+	}
+
+	return resourceBucketCorsConfigurationRead(d, meta)
+}
+
+func resourceBucketCorsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	input := &s3.DeleteBucketCorsInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteBucketCors(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchCORSConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) CORS Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBucketCorsRules(tfList []interface{}) []*s3.CORSRule {
+	var apiObjects []*s3.CORSRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.CORSRule{}
+
+		if v, ok := tfMap["allowed_headers"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AllowedHeaders = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["allowed_methods"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AllowedMethods = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["allowed_origins"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AllowedOrigins = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["expose_headers"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ExposeHeaders = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.ID = aws.String(v)
+		}
+
+		if v, ok := tfMap["max_age_seconds"].(int); ok && v != 0 {
+			apiObject.MaxAgeSeconds = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenBucketCorsRules(apiObjects []*s3.CORSRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"allowed_headers": flex.FlattenStringSet(apiObject.AllowedHeaders),
+			"allowed_methods": flex.FlattenStringSet(apiObject.AllowedMethods),
+			"allowed_origins": flex.FlattenStringSet(apiObject.AllowedOrigins),
+			"expose_headers":  flex.FlattenStringSet(apiObject.ExposeHeaders),
+			"id":              aws.StringValue(apiObject.ID),
+			"max_age_seconds": int(aws.Int64Value(apiObject.MaxAgeSeconds)),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
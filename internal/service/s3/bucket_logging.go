@@ -0,0 +1,284 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceBucketLogging() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketLoggingCreate,
+		Read:   resourceBucketLoggingRead,
+		Update: resourceBucketLoggingUpdate,
+		Delete: resourceBucketLoggingDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"target_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"target_grant": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grantee": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"email_address": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.Type_Values(), false),
+									},
+									"uri": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"permission": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(s3.BucketLogsPermission_Values(), false),
+						},
+					},
+				},
+			},
+			"target_prefix": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceBucketLoggingCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketLoggingInput{
+		Bucket: aws.String(bucket),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{
+			LoggingEnabled: expandBucketLoggingEnabled(d),
+		},
+	}
+
+	_, err := waitRetryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketLogging(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) Logging: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketLoggingRead(d, meta)
+}
+
+func resourceBucketLoggingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	input := &s3.GetBucketLoggingInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketLogging(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket Logging (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Logging: %w", d.Id(), err)
+	}
+
+	if output == nil || output.LoggingEnabled == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading S3 Bucket (%s) Logging: empty response", d.Id())
+		}
+
+		log.Printf("[WARN] S3 Bucket Logging (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	loggingEnabled := output.LoggingEnabled
+
+	d.Set("bucket", d.Id())
+	d.Set("target_bucket", loggingEnabled.TargetBucket)
+	d.Set("target_prefix", loggingEnabled.TargetPrefix)
+
+	if err := d.Set("target_grant", flattenBucketLoggingTargetGrants(loggingEnabled.TargetGrants)); err != nil {
+		return fmt.Errorf("error setting target_grant: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketLoggingUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	input := &s3.PutBucketLoggingInput{
+		Bucket: aws.String(d.Id()),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{
+			LoggingEnabled: expandBucketLoggingEnabled(d),
+		},
+	}
+
+	_, err := conn.PutBucketLogging(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) Logging: %w", d.Id(), err)
+	}
+
+	return resourceBucketLoggingRead(d, meta)
+}
+
+func resourceBucketLoggingDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	// Logging is disabled by putting an empty logging status.
+	input := &s3.PutBucketLoggingInput{
+		Bucket:              aws.String(d.Id()),
+		BucketLoggingStatus: &s3.BucketLoggingStatus{},
+	}
+
+	_, err := conn.PutBucketLogging(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Logging: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBucketLoggingEnabled(d *schema.ResourceData) *s3.LoggingEnabled {
+	apiObject := &s3.LoggingEnabled{
+		TargetBucket: aws.String(d.Get("target_bucket").(string)),
+		TargetPrefix: aws.String(d.Get("target_prefix").(string)),
+	}
+
+	if v, ok := d.GetOk("target_grant"); ok && v.(*schema.Set).Len() > 0 {
+		apiObject.TargetGrants = expandBucketLoggingTargetGrants(v.(*schema.Set).List())
+	}
+
+	return apiObject
+}
+
+func expandBucketLoggingTargetGrants(tfList []interface{}) []*s3.TargetGrant {
+	var apiObjects []*s3.TargetGrant
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.TargetGrant{}
+
+		if v, ok := tfMap["grantee"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Grantee = expandBucketLoggingGrantee(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["permission"].(string); ok && v != "" {
+			apiObject.Permission = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandBucketLoggingGrantee(tfMap map[string]interface{}) *s3.Grantee {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.Grantee{}
+
+	if v, ok := tfMap["email_address"].(string); ok && v != "" {
+		apiObject.EmailAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.ID = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["uri"].(string); ok && v != "" {
+		apiObject.URI = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenBucketLoggingTargetGrants(apiObjects []*s3.TargetGrant) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"permission": aws.StringValue(apiObject.Permission),
+		}
+
+		if v := apiObject.Grantee; v != nil {
+			tfMap["grantee"] = []interface{}{
+				map[string]interface{}{
+					"email_address": aws.StringValue(v.EmailAddress),
+					"id":            aws.StringValue(v.ID),
+					"type":          aws.StringValue(v.Type),
+					"uri":           aws.StringValue(v.URI),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -31,6 +32,10 @@ import (
 
 const s3BucketObjectCreationTimeout = 2 * time.Minute
 
+// bucketObjectUploadPartSize is the largest object, and the largest part,
+// that can be uploaded in a single request (5 GiB).
+const bucketObjectUploadPartSize = 5 * 1024 * 1024 * 1024
+
 func ResourceBucketObject() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBucketObjectCreate,
@@ -210,7 +215,7 @@ func ResourceBucketObject() *schema.Resource {
 }
 
 func resourceBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -251,7 +256,7 @@ func resourceBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
-	putInput := &s3.PutObjectInput{
+	putInput := &s3manager.UploadInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 		ACL:    aws.String(d.Get("acl").(string)),
@@ -320,7 +325,7 @@ func resourceBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 		putInput.ObjectLockRetainUntilDate = expandS3ObjectDate(v.(string))
 	}
 
-	if _, err := conn.PutObject(putInput); err != nil {
+	if err := uploadBucketObject(conn, putInput); err != nil {
 		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
 	}
 
@@ -328,12 +333,32 @@ func resourceBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 	return resourceBucketObjectRead(d, meta)
 }
 
+// uploadBucketObject uploads an object with the upload manager, which streams
+// seekable sources part by part instead of buffering them, and switches to a
+// multipart upload only for objects larger than a single PutObject allows so
+// that ETags remain MD5 digests.
+func uploadBucketObject(conn *s3.S3, input *s3manager.UploadInput) error {
+	// Objects without content must still have an io.ReaderAt body,
+	// otherwise the upload manager buffers a whole part before reading from it.
+	if input.Body == nil {
+		input.Body = bytes.NewReader(nil)
+	}
+
+	uploader := s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		u.PartSize = bucketObjectUploadPartSize
+	})
+
+	_, err := uploader.Upload(input)
+
+	return err
+}
+
 func resourceBucketObjectCreate(d *schema.ResourceData, meta interface{}) error {
 	return resourceBucketObjectPut(d, meta)
 }
 
 func resourceBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -435,7 +460,7 @@ func resourceBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -451,7 +476,7 @@ func resourceBucketObjectUpdate(d *schema.ResourceData, meta interface{}) error
 		return resourceBucketObjectPut(d, meta)
 	}
 
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -518,7 +543,7 @@ func resourceBucketObjectUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceBucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).S3Conn
+	conn := meta.(*conns.AWSClient).S3Conn()
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -564,7 +589,7 @@ func resourceBucketObjectSetKMS(d *schema.ResourceData, meta interface{}, sseKMS
 	// Only set non-default KMS key ID (one that doesn't match default)
 	if sseKMSKeyId != nil {
 		// retrieve S3 KMS Default Master Key
-		conn := meta.(*conns.AWSClient).KMSConn
+		conn := meta.(*conns.AWSClient).KMSConn()
 		kmsresp, err := conn.DescribeKey(&kms.DescribeKeyInput{
 			KeyId: aws.String("alias/aws/s3"),
 		})
@@ -0,0 +1,62 @@
+package s3
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+func TestUploadBucketObject_noBody(t *testing.T) {
+	var requests []string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+
+		if err != nil {
+			t.Errorf("error reading request body: %s", err)
+		}
+
+		if len(body) != 0 {
+			t.Errorf("got request body %q, expected none", body)
+		}
+
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("ETag", `"d41d8cd98f00b204e9800998ecf8427e"`)
+	}))
+	defer ts.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials:      credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+		Endpoint:         aws.String(ts.URL),
+		Region:           aws.String("us-east-1"), //lintignore:AWSAT003
+		S3ForcePathStyle: aws.Bool(true),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	err = uploadBucketObject(s3.New(sess), &s3manager.UploadInput{
+		Bucket: aws.String("test-bucket"),
+		Key:    aws.String("test-key"),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(requests), 1; got != want {
+		t.Fatalf("got %d requests (%v), expected %d", got, requests, want)
+	}
+
+	if got, want := requests[0], "PUT /test-bucket/test-key"; got != want {
+		t.Errorf("got request %q, expected %q", got, want)
+	}
+}
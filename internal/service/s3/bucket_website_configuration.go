@@ -0,0 +1,454 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceBucketWebsiteConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketWebsiteConfigurationCreate,
+		Read:   resourceBucketWebsiteConfigurationRead,
+		Update: resourceBucketWebsiteConfigurationUpdate,
+		Delete: resourceBucketWebsiteConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"error_document": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"index_document": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"index_document", "redirect_all_requests_to"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"suffix": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"redirect_all_requests_to": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				ConflictsWith: []string{
+					"error_document",
+					"routing_rule",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(s3.Protocol_Values(), false),
+						},
+					},
+				},
+			},
+			"routing_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"http_error_code_returned_equals": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"key_prefix_equals": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"redirect": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"http_redirect_code": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(s3.Protocol_Values(), false),
+									},
+									"replace_key_prefix_with": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"replace_key_with": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"website_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"website_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBucketWebsiteConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(bucket),
+		WebsiteConfiguration: expandBucketWebsiteConfiguration(d),
+	}
+
+	_, err := waitRetryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketWebsite(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) Website Configuration: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketWebsiteConfigurationRead(d, meta)
+}
+
+func resourceBucketWebsiteConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	input := &s3.GetBucketWebsiteInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetBucketWebsite(input)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchWebsiteConfiguration) {
+		log.Printf("[WARN] S3 Bucket Website Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Website Configuration: %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Website Configuration: empty response", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("error_document", flattenBucketWebsiteErrorDocument(output.ErrorDocument)); err != nil {
+		return fmt.Errorf("error setting error_document: %w", err)
+	}
+
+	if err := d.Set("index_document", flattenBucketWebsiteIndexDocument(output.IndexDocument)); err != nil {
+		return fmt.Errorf("error setting index_document: %w", err)
+	}
+
+	if err := d.Set("redirect_all_requests_to", flattenBucketWebsiteRedirectAllRequestsTo(output.RedirectAllRequestsTo)); err != nil {
+		return fmt.Errorf("error setting redirect_all_requests_to: %w", err)
+	}
+
+	if err := d.Set("routing_rule", flattenBucketWebsiteRoutingRules(output.RoutingRules)); err != nil {
+		return fmt.Errorf("error setting routing_rule: %w", err)
+	}
+
+	locationOutput, err := conn.GetBucketLocation(&s3.GetBucketLocationInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Location: %w", d.Id(), err)
+	}
+
+	var region string
+	if locationOutput != nil {
+		region = aws.StringValue(locationOutput.LocationConstraint)
+	}
+
+	website := WebsiteEndpoint(meta.(*conns.AWSClient), d.Id(), region)
+	d.Set("website_domain", website.Domain)
+	d.Set("website_endpoint", website.Endpoint)
+
+	return nil
+}
+
+func resourceBucketWebsiteConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	input := &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(d.Id()),
+		WebsiteConfiguration: expandBucketWebsiteConfiguration(d),
+	}
+
+	_, err := conn.PutBucketWebsite(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) Website Configuration: %w", d.Id(), err)
+	}
+
+	return resourceBucketWebsiteConfigurationRead(d, meta)
+}
+
+func resourceBucketWebsiteConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn()
+
+	input := &s3.DeleteBucketWebsiteInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteBucketWebsite(input)
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket, ErrCodeNoSuchWebsiteConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Website Configuration: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBucketWebsiteConfiguration(d *schema.ResourceData) *s3.WebsiteConfiguration {
+	apiObject := &s3.WebsiteConfiguration{}
+
+	if v, ok := d.GetOk("error_document"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		apiObject.ErrorDocument = &s3.ErrorDocument{
+			Key: aws.String(tfMap["key"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("index_document"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		apiObject.IndexDocument = &s3.IndexDocument{
+			Suffix: aws.String(tfMap["suffix"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("redirect_all_requests_to"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		apiObject.RedirectAllRequestsTo = &s3.RedirectAllRequestsTo{
+			HostName: aws.String(tfMap["host_name"].(string)),
+		}
+
+		if v, ok := tfMap["protocol"].(string); ok && v != "" {
+			apiObject.RedirectAllRequestsTo.Protocol = aws.String(v)
+		}
+	}
+
+	if v, ok := d.GetOk("routing_rule"); ok && len(v.([]interface{})) > 0 {
+		apiObject.RoutingRules = expandBucketWebsiteRoutingRules(v.([]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandBucketWebsiteRoutingRules(tfList []interface{}) []*s3.RoutingRule {
+	var apiObjects []*s3.RoutingRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &s3.RoutingRule{}
+
+		if v, ok := tfMap["condition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Condition = expandBucketWebsiteRoutingRuleCondition(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["redirect"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Redirect = expandBucketWebsiteRoutingRuleRedirect(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandBucketWebsiteRoutingRuleCondition(tfMap map[string]interface{}) *s3.Condition {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.Condition{}
+
+	if v, ok := tfMap["http_error_code_returned_equals"].(string); ok && v != "" {
+		apiObject.HttpErrorCodeReturnedEquals = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_prefix_equals"].(string); ok && v != "" {
+		apiObject.KeyPrefixEquals = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandBucketWebsiteRoutingRuleRedirect(tfMap map[string]interface{}) *s3.Redirect {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.Redirect{}
+
+	if v, ok := tfMap["host_name"].(string); ok && v != "" {
+		apiObject.HostName = aws.String(v)
+	}
+
+	if v, ok := tfMap["http_redirect_code"].(string); ok && v != "" {
+		apiObject.HttpRedirectCode = aws.String(v)
+	}
+
+	if v, ok := tfMap["protocol"].(string); ok && v != "" {
+		apiObject.Protocol = aws.String(v)
+	}
+
+	if v, ok := tfMap["replace_key_prefix_with"].(string); ok && v != "" {
+		apiObject.ReplaceKeyPrefixWith = aws.String(v)
+	}
+
+	if v, ok := tfMap["replace_key_with"].(string); ok && v != "" {
+		apiObject.ReplaceKeyWith = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenBucketWebsiteErrorDocument(apiObject *s3.ErrorDocument) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key": aws.StringValue(apiObject.Key),
+		},
+	}
+}
+
+func flattenBucketWebsiteIndexDocument(apiObject *s3.IndexDocument) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"suffix": aws.StringValue(apiObject.Suffix),
+		},
+	}
+}
+
+func flattenBucketWebsiteRedirectAllRequestsTo(apiObject *s3.RedirectAllRequestsTo) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"host_name": aws.StringValue(apiObject.HostName),
+			"protocol":  aws.StringValue(apiObject.Protocol),
+		},
+	}
+}
+
+func flattenBucketWebsiteRoutingRules(apiObjects []*s3.RoutingRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Condition; v != nil {
+			tfMap["condition"] = []interface{}{
+				map[string]interface{}{
+					"http_error_code_returned_equals": aws.StringValue(v.HttpErrorCodeReturnedEquals),
+					"key_prefix_equals":               aws.StringValue(v.KeyPrefixEquals),
+				},
+			}
+		}
+
+		if v := apiObject.Redirect; v != nil {
+			tfMap["redirect"] = []interface{}{
+				map[string]interface{}{
+					"host_name":               aws.StringValue(v.HostName),
+					"http_redirect_code":      aws.StringValue(v.HttpRedirectCode),
+					"protocol":                aws.StringValue(v.Protocol),
+					"replace_key_prefix_with": aws.StringValue(v.ReplaceKeyPrefixWith),
+					"replace_key_with":        aws.StringValue(v.ReplaceKeyWith),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
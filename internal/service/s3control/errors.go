@@ -4,6 +4,7 @@ package s3control
 // https://docs.aws.amazon.com/sdk-for-go/api/service/s3control/#pkg-constants
 
 const (
-	errCodeNoSuchAccessPoint       = "NoSuchAccessPoint"
-	errCodeNoSuchAccessPointPolicy = "NoSuchAccessPointPolicy"
+	errCodeNoSuchAccessPoint            = "NoSuchAccessPoint"
+	errCodeNoSuchAccessPointPolicy      = "NoSuchAccessPointPolicy"
+	errCodeNoSuchMultiRegionAccessPoint = "NoSuchMultiRegionAccessPoint"
 )
@@ -0,0 +1,139 @@
+package s3control_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3control"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+)
+
+func TestAccS3ControlMultiRegionAccessPointPolicy_basic(t *testing.T) {
+	var v s3control.MultiRegionAccessPointPolicyDocument
+	resourceName := "aws_s3control_multi_region_access_point_policy.test"
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(s3control.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMultiRegionAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionAccessPointPolicyConfig_basic(bucketName, rName, "s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiRegionAccessPointPolicyExists(resourceName, &v),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "details.0.name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "details.0.policy"),
+					resource.TestCheckResourceAttrSet(resourceName, "proposed"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ControlMultiRegionAccessPointPolicy_update(t *testing.T) {
+	var v s3control.MultiRegionAccessPointPolicyDocument
+	resourceName := "aws_s3control_multi_region_access_point_policy.test"
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(s3control.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckMultiRegionAccessPointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMultiRegionAccessPointPolicyConfig_basic(bucketName, rName, "s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiRegionAccessPointPolicyExists(resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "details.0.policy", regexp.MustCompile(`"s3:GetObject"`)),
+				),
+			},
+			{
+				Config: testAccMultiRegionAccessPointPolicyConfig_basic(bucketName, rName, "s3:PutObject"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMultiRegionAccessPointPolicyExists(resourceName, &v),
+					resource.TestMatchResourceAttr(resourceName, "details.0.policy", regexp.MustCompile(`"s3:PutObject"`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMultiRegionAccessPointPolicyExists(n string, v *s3control.MultiRegionAccessPointPolicyDocument) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Multi-Region Access Point Policy ID is set")
+		}
+
+		accountID, name, err := tfs3control.MultiRegionAccessPointParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn, err := tfs3control.ConnForMultiRegionAccessPoint(acctest.Provider.Meta())
+
+		if err != nil {
+			return err
+		}
+
+		output, err := tfs3control.FindMultiRegionAccessPointPolicyDocumentByAccountIDAndName(conn, accountID, name)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccMultiRegionAccessPointPolicyConfig_basic(bucketName, multiRegionAccessPointName, action string) string {
+	return acctest.ConfigCompose(testAccMultiRegionAccessPointConfig_basic(bucketName, multiRegionAccessPointName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_s3control_multi_region_access_point_policy" "test" {
+  details {
+    name   = aws_s3control_multi_region_access_point.test.details[0].name
+    policy = jsonencode({
+      "Version" : "2012-10-17",
+      "Statement" : [
+        {
+          "Sid" : "Test",
+          "Effect" : "Allow",
+          "Principal" : {
+            "AWS" : data.aws_caller_identity.current.account_id
+          },
+          "Action" : %[1]q,
+          "Resource" : "arn:${data.aws_partition.current.partition}:s3::${data.aws_caller_identity.current.account_id}:accesspoint/${aws_s3control_multi_region_access_point.test.alias}/object/*"
+        }
+      ]
+    })
+  }
+}
+`, action))
+}
@@ -1,11 +1,13 @@
 package s3control
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3control"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // statusPublicAccessBlockConfigurationBlockPublicACLs fetches the PublicAccessBlockConfiguration and its BlockPublicAcls
@@ -75,3 +77,29 @@ func statusPublicAccessBlockConfigurationRestrictPublicBuckets(conn *s3control.S
 		return publicAccessBlockConfiguration, strconv.FormatBool(aws.BoolValue(publicAccessBlockConfiguration.RestrictPublicBuckets)), nil
 	}
 }
+
+func statusMultiRegionAccessPointRequest(conn *s3control.S3Control, accountID string, requestTokenARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findMultiRegionAccessPointOperationByAccountIDAndTokenARN(conn, accountID, requestTokenARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(output.RequestStatus)
+
+		if status == RequestStatusFailed {
+			if v := output.ResponseDetails; v != nil && v.ErrorDetails != nil {
+				return output, status, fmt.Errorf("%s: %s", aws.StringValue(v.ErrorDetails.Code), aws.StringValue(v.ErrorDetails.Message))
+			}
+
+			return output, status, fmt.Errorf("S3 Multi-Region Access Point operation (%s) failed", requestTokenARN)
+		}
+
+		return output, status, nil
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/s3control"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
@@ -21,6 +22,11 @@ func init() {
 		Name: "aws_s3_access_point",
 		F:    sweepAccessPoints,
 	})
+
+	resource.AddTestSweepers("aws_s3control_multi_region_access_point", &resource.Sweeper{
+		Name: "aws_s3control_multi_region_access_point",
+		F:    sweepMultiRegionAccessPoints,
+	})
 }
 
 func sweepAccessPoints(region string) error {
@@ -30,7 +36,7 @@ func sweepAccessPoints(region string) error {
 	}
 
 	accountId := client.(*conns.AWSClient).AccountID
-	conn := client.(*conns.AWSClient).S3ControlConn
+	conn := client.(*conns.AWSClient).S3ControlConn()
 
 	input := &s3control.ListAccessPointsInput{
 		AccountId: aws.String(accountId),
@@ -78,3 +84,56 @@ func sweepAccessPoints(region string) error {
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+func sweepMultiRegionAccessPoints(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	// Multi-Region Access Points are global resources managed from US West (Oregon).
+	if region != endpoints.UsWest2RegionID {
+		log.Printf("[WARN] Skipping S3 Multi-Region Access Point sweep for region: %s", region)
+		return nil
+	}
+
+	conn := client.(*conns.AWSClient).S3ControlConn()
+	accountID := client.(*conns.AWSClient).AccountID
+	input := &s3control.ListMultiRegionAccessPointsInput{
+		AccountId: aws.String(accountID),
+	}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListMultiRegionAccessPointsPages(input, func(page *s3control.ListMultiRegionAccessPointsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, accessPoint := range page.AccessPoints {
+			r := ResourceMultiRegionAccessPoint()
+			d := r.Data(nil)
+			d.SetId(MultiRegionAccessPointCreateResourceID(accountID, aws.StringValue(accessPoint.Name)))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping S3 Multi-Region Access Point sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing S3 Multi-Region Access Points (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping S3 Multi-Region Access Points (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -19,6 +19,12 @@ const (
 	propagationTimeout = 1 * time.Minute
 )
 
+// Multi-Region Access Point asynchronous operation request statuses.
+const (
+	RequestStatusFailed    = "FAILED"
+	RequestStatusSucceeded = "SUCCEEDED"
+)
+
 func waitPublicAccessBlockConfigurationBlockPublicACLsUpdated(conn *s3control.S3Control, accountID string, expectedValue bool) (*s3control.PublicAccessBlockConfiguration, error) {
 	stateConf := &resource.StateChangeConf{
 		Target:                    []string{strconv.FormatBool(expectedValue)},
@@ -90,3 +96,21 @@ func waitPublicAccessBlockConfigurationRestrictPublicBucketsUpdated(conn *s3cont
 
 	return nil, err
 }
+
+func waitMultiRegionAccessPointRequestSucceeded(conn *s3control.S3Control, accountID string, requestTokenARN string, timeout time.Duration) (*s3control.AsyncOperation, error) { //nolint:unparam
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{RequestStatusSucceeded},
+		Timeout:    timeout,
+		Refresh:    statusMultiRegionAccessPointRequest(conn, accountID, requestTokenARN),
+		MinTimeout: 5 * time.Second,
+		Delay:      15 * time.Second, // Wait 15 secs before starting
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*s3control.AsyncOperation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -69,7 +69,7 @@ func ResourceCodeRepository() *schema.Resource {
 }
 
 func resourceCodeRepositoryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SageMakerConn
+	conn := meta.(*conns.AWSClient).SageMakerConn()
 
 	name := d.Get("code_repository_name").(string)
 
@@ -90,7 +90,7 @@ func resourceCodeRepositoryCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceCodeRepositoryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SageMakerConn
+	conn := meta.(*conns.AWSClient).SageMakerConn()
 
 	codeRepository, err := FindCodeRepositoryByName(conn, d.Id())
 	if err != nil {
@@ -114,7 +114,7 @@ func resourceCodeRepositoryRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceCodeRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SageMakerConn
+	conn := meta.(*conns.AWSClient).SageMakerConn()
 
 	input := &sagemaker.UpdateCodeRepositoryInput{
 		CodeRepositoryName: aws.String(d.Id()),
@@ -131,7 +131,7 @@ func resourceCodeRepositoryUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceCodeRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SageMakerConn
+	conn := meta.(*conns.AWSClient).SageMakerConn()
 
 	input := &sagemaker.DeleteCodeRepositoryInput{
 		CodeRepositoryName: aws.String(d.Id()),
@@ -57,7 +57,7 @@ func ResourceRegistry() *schema.Resource {
 }
 
 func resourceRegistryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SchemasConn
+	conn := meta.(*conns.AWSClient).SchemasConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -87,7 +87,7 @@ func resourceRegistryCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRegistryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SchemasConn
+	conn := meta.(*conns.AWSClient).SchemasConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -115,7 +115,7 @@ func resourceRegistryRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error listing tags for EventBridge Schemas Registry (%s): %w", d.Id(), err)
 	}
 
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -127,7 +127,7 @@ func resourceRegistryRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRegistryUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SchemasConn
+	conn := meta.(*conns.AWSClient).SchemasConn()
 
 	if d.HasChanges("description") {
 		input := &schemas.UpdateRegistryInput{
@@ -154,7 +154,7 @@ func resourceRegistryUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRegistryDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SchemasConn
+	conn := meta.(*conns.AWSClient).SchemasConn()
 
 	log.Printf("[INFO] Deleting EventBridge Schemas Registry (%s)", d.Id())
 	_, err := conn.DeleteRegistry(&schemas.DeleteRegistryInput{
@@ -49,7 +49,7 @@ func ResourceSecretPolicy() *schema.Resource {
 }
 
 func resourceSecretPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SecretsManagerConn
+	conn := meta.(*conns.AWSClient).SecretsManagerConn()
 
 	input := &secretsmanager.PutResourcePolicyInput{
 		ResourcePolicy: aws.String(d.Get("policy").(string)),
@@ -88,7 +88,7 @@ func resourceSecretPolicyCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceSecretPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SecretsManagerConn
+	conn := meta.(*conns.AWSClient).SecretsManagerConn()
 
 	input := &secretsmanager.GetResourcePolicyInput{
 		SecretId: aws.String(d.Id()),
@@ -145,7 +145,7 @@ func resourceSecretPolicyRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceSecretPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SecretsManagerConn
+	conn := meta.(*conns.AWSClient).SecretsManagerConn()
 
 	if d.HasChanges("policy", "block_public_policy") {
 		policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
@@ -182,7 +182,7 @@ func resourceSecretPolicyUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceSecretPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SecretsManagerConn
+	conn := meta.(*conns.AWSClient).SecretsManagerConn()
 
 	input := &secretsmanager.DeleteResourcePolicyInput{
 		SecretId: aws.String(d.Id()),
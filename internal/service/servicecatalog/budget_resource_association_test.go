@@ -66,7 +66,7 @@ func TestAccServiceCatalogBudgetResourceAssociation_disappears(t *testing.T) {
 }
 
 func testAccCheckBudgetResourceAssociationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_servicecatalog_budget_resource_association" {
@@ -107,7 +107,7 @@ func testAccCheckBudgetResourceAssociationExists(resourceName string) resource.T
 			return fmt.Errorf("could not parse ID (%s): %w", rs.Primary.ID, err)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogConn()
 
 		_, err = tfservicecatalog.WaitBudgetResourceAssociationReady(conn, budgetName, resourceID)
 
@@ -80,7 +80,7 @@ func DataSourceProduct() *schema.Resource {
 }
 
 func dataSourceProductRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	output, err := WaitProductReady(conn, d.Get("accept_language").(string), d.Get("id").(string))
 
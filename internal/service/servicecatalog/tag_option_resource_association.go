@@ -56,7 +56,7 @@ func ResourceTagOptionResourceAssociation() *schema.Resource {
 }
 
 func resourceTagOptionResourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	input := &servicecatalog.AssociateTagOptionWithResourceInput{
 		ResourceId:  aws.String(d.Get("resource_id").(string)),
@@ -98,7 +98,7 @@ func resourceTagOptionResourceAssociationCreate(d *schema.ResourceData, meta int
 }
 
 func resourceTagOptionResourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	tagOptionID, resourceID, err := TagOptionResourceAssociationParseID(d.Id())
 
@@ -136,7 +136,7 @@ func resourceTagOptionResourceAssociationRead(d *schema.ResourceData, meta inter
 }
 
 func resourceTagOptionResourceAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceCatalogConn
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn()
 
 	tagOptionID, resourceID, err := TagOptionResourceAssociationParseID(d.Id())
 
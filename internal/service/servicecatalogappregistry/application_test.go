@@ -0,0 +1,232 @@
+package servicecatalogappregistry_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicecatalogappregistry "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalogappregistry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccServiceCatalogAppRegistryApplication_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_servicecatalogappregistry_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appregistry.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "servicecatalog", regexp.MustCompile(`/applications/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogAppRegistryApplication_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_servicecatalogappregistry_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appregistry.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfservicecatalogappregistry.ResourceApplication(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogAppRegistryApplication_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_servicecatalogappregistry_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appregistry.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationDescriptionConfig(rName, "description 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationDescriptionConfig(rNameUpdated, "description 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description 2"),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogAppRegistryApplication_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_servicecatalogappregistry_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appregistry.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApplicationTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccApplicationTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogAppRegistryConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalogappregistry_application" {
+			continue
+		}
+
+		_, err := tfservicecatalogappregistry.FindApplicationByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog AppRegistry Application %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckApplicationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog AppRegistry Application ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogAppRegistryConn()
+
+		_, err := tfservicecatalogappregistry.FindApplicationByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccApplicationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_application" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccApplicationDescriptionConfig(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_application" "test" {
+  name        = %[1]q
+  description = %[2]q
+}
+`, rName, description)
+}
+
+func testAccApplicationTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_application" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccApplicationTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_application" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
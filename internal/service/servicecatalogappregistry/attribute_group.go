@@ -0,0 +1,190 @@
+package servicecatalogappregistry
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAttributeGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAttributeGroupCreate,
+		Read:   resourceAttributeGroupRead,
+		Update: resourceAttributeGroupUpdate,
+		Delete: resourceAttributeGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"attributes": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[-.\w]+$`), "must contain only alphanumeric characters, hyphens, underscores and periods"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAttributeGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &appregistry.CreateAttributeGroupInput{
+		Attributes: aws.String(d.Get("attributes").(string)),
+		Name:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog AppRegistry Attribute Group: %s", input)
+	output, err := conn.CreateAttributeGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog AppRegistry Attribute Group (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.AttributeGroup.Id))
+
+	return resourceAttributeGroupRead(d, meta)
+}
+
+func resourceAttributeGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindAttributeGroupByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Catalog AppRegistry Attribute Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog AppRegistry Attribute Group (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("attributes", output.Attributes)
+	d.Set("description", output.Description)
+	d.Set("name", output.Name)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAttributeGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &appregistry.UpdateAttributeGroupInput{
+			AttributeGroup: aws.String(d.Id()),
+		}
+
+		if d.HasChange("attributes") {
+			input.Attributes = aws.String(d.Get("attributes").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		log.Printf("[DEBUG] Updating Service Catalog AppRegistry Attribute Group: %s", input)
+		_, err := conn.UpdateAttributeGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Service Catalog AppRegistry Attribute Group (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Service Catalog AppRegistry Attribute Group (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAttributeGroupRead(d, meta)
+}
+
+func resourceAttributeGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn()
+
+	log.Printf("[DEBUG] Deleting Service Catalog AppRegistry Attribute Group: %s", d.Id())
+	_, err := conn.DeleteAttributeGroup(&appregistry.DeleteAttributeGroupInput{
+		AttributeGroup: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog AppRegistry Attribute Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
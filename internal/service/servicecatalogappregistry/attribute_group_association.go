@@ -0,0 +1,114 @@
+package servicecatalogappregistry
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceAttributeGroupAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAttributeGroupAssociationCreate,
+		Read:   resourceAttributeGroupAssociationRead,
+		Delete: resourceAttributeGroupAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"attribute_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAttributeGroupAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn()
+
+	applicationID := d.Get("application_id").(string)
+	attributeGroupID := d.Get("attribute_group_id").(string)
+	id := AttributeGroupAssociationCreateID(applicationID, attributeGroupID)
+	input := &appregistry.AssociateAttributeGroupInput{
+		Application:    aws.String(applicationID),
+		AttributeGroup: aws.String(attributeGroupID),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog AppRegistry Attribute Group Association: %s", input)
+	_, err := conn.AssociateAttributeGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog AppRegistry Attribute Group Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAttributeGroupAssociationRead(d, meta)
+}
+
+func resourceAttributeGroupAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn()
+
+	applicationID, attributeGroupID, err := AttributeGroupAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	err = FindAttributeGroupAssociationByTwoPartKey(conn, applicationID, attributeGroupID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Catalog AppRegistry Attribute Group Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog AppRegistry Attribute Group Association (%s): %w", d.Id(), err)
+	}
+
+	d.Set("application_id", applicationID)
+	d.Set("attribute_group_id", attributeGroupID)
+
+	return nil
+}
+
+func resourceAttributeGroupAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ServiceCatalogAppRegistryConn()
+
+	applicationID, attributeGroupID, err := AttributeGroupAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog AppRegistry Attribute Group Association: %s", d.Id())
+	_, err = conn.DisassociateAttributeGroup(&appregistry.DisassociateAttributeGroupInput{
+		Application:    aws.String(applicationID),
+		AttributeGroup: aws.String(attributeGroupID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog AppRegistry Attribute Group Association (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
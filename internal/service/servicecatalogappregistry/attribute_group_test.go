@@ -0,0 +1,207 @@
+package servicecatalogappregistry_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicecatalogappregistry "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalogappregistry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccServiceCatalogAppRegistryAttributeGroup_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_servicecatalogappregistry_attribute_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appregistry.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAttributeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttributeGroupConfig(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeGroupExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "servicecatalog", regexp.MustCompile(`/attribute-groups/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "attributes", `{"key1":"value1"}`),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAttributeGroupConfig(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes", `{"key1":"value2"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogAppRegistryAttributeGroup_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_servicecatalogappregistry_attribute_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appregistry.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAttributeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttributeGroupConfig(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeGroupExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfservicecatalogappregistry.ResourceAttributeGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogAppRegistryAttributeGroup_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_servicecatalogappregistry_attribute_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appregistry.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAttributeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttributeGroupTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAttributeGroupTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAttributeGroupTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAttributeGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogAppRegistryConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalogappregistry_attribute_group" {
+			continue
+		}
+
+		_, err := tfservicecatalogappregistry.FindAttributeGroupByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog AppRegistry Attribute Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAttributeGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog AppRegistry Attribute Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogAppRegistryConn()
+
+		_, err := tfservicecatalogappregistry.FindAttributeGroupByID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAttributeGroupConfig(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_attribute_group" "test" {
+  name = %[1]q
+
+  attributes = jsonencode({
+    key1 = %[2]q
+  })
+}
+`, rName, value)
+}
+
+func testAccAttributeGroupTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_attribute_group" "test" {
+  name = %[1]q
+
+  attributes = jsonencode({
+    key1 = "value1"
+  })
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAttributeGroupTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_attribute_group" "test" {
+  name = %[1]q
+
+  attributes = jsonencode({
+    key1 = "value1"
+  })
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
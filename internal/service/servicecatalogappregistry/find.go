@@ -0,0 +1,101 @@
+package servicecatalogappregistry
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindApplicationByID(conn *appregistry.AppRegistry, id string) (*appregistry.GetApplicationOutput, error) {
+	input := &appregistry.GetApplicationInput{
+		Application: aws.String(id),
+	}
+
+	output, err := conn.GetApplication(input)
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindAttributeGroupByID(conn *appregistry.AppRegistry, id string) (*appregistry.GetAttributeGroupOutput, error) {
+	input := &appregistry.GetAttributeGroupInput{
+		AttributeGroup: aws.String(id),
+	}
+
+	output, err := conn.GetAttributeGroup(input)
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindAttributeGroupAssociationByTwoPartKey(conn *appregistry.AppRegistry, applicationID, attributeGroupID string) error {
+	input := &appregistry.ListAssociatedAttributeGroupsInput{
+		Application: aws.String(applicationID),
+	}
+	var found bool
+
+	err := conn.ListAssociatedAttributeGroupsPages(input, func(page *appregistry.ListAssociatedAttributeGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AttributeGroups {
+			if aws.StringValue(v) == attributeGroupID {
+				found = true
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, appregistry.ErrCodeResourceNotFoundException) {
+		return &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return nil
+}
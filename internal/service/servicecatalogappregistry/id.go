@@ -0,0 +1,25 @@
+package servicecatalogappregistry
+
+import (
+	"fmt"
+	"strings"
+)
+
+const attributeGroupAssociationIDSeparator = ","
+
+func AttributeGroupAssociationCreateID(applicationID, attributeGroupID string) string {
+	parts := []string{applicationID, attributeGroupID}
+	id := strings.Join(parts, attributeGroupAssociationIDSeparator)
+
+	return id
+}
+
+func AttributeGroupAssociationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, attributeGroupAssociationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected APPLICATION-ID%[2]sATTRIBUTE-GROUP-ID", id, attributeGroupAssociationIDSeparator)
+}
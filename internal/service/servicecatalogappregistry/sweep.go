@@ -0,0 +1,132 @@
+//go:build sweep
+// +build sweep
+
+package servicecatalogappregistry
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_servicecatalogappregistry_application", &resource.Sweeper{
+		Name: "aws_servicecatalogappregistry_application",
+		F:    sweepApplications,
+	})
+
+	resource.AddTestSweepers("aws_servicecatalogappregistry_attribute_group", &resource.Sweeper{
+		Name: "aws_servicecatalogappregistry_attribute_group",
+		F:    sweepAttributeGroups,
+	})
+}
+
+func sweepApplications(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).ServiceCatalogAppRegistryConn()
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &appregistry.ListApplicationsInput{}
+
+	err = conn.ListApplicationsPages(input, func(page *appregistry.ListApplicationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.Applications {
+			if item == nil {
+				continue
+			}
+
+			id := aws.StringValue(item.Id)
+
+			log.Printf("[INFO] Deleting Service Catalog AppRegistry Application (%s)", id)
+			r := ResourceApplication()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Service Catalog AppRegistry Applications: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog AppRegistry Applications for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Service Catalog AppRegistry Applications sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepAttributeGroups(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).ServiceCatalogAppRegistryConn()
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &appregistry.ListAttributeGroupsInput{}
+
+	err = conn.ListAttributeGroupsPages(input, func(page *appregistry.ListAttributeGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, item := range page.AttributeGroups {
+			if item == nil {
+				continue
+			}
+
+			id := aws.StringValue(item.Id)
+
+			log.Printf("[INFO] Deleting Service Catalog AppRegistry Attribute Group (%s)", id)
+			r := ResourceAttributeGroup()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Service Catalog AppRegistry Attribute Groups: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Service Catalog AppRegistry Attribute Groups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Service Catalog AppRegistry Attribute Groups sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
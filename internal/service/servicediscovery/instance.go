@@ -42,6 +42,12 @@ func ResourceInstance() *schema.Resource {
 					validation.MapValueMatch(regexp.MustCompile(`^([a-zA-Z0-9!-~][ \ta-zA-Z0-9!-~]*){0,1}[a-zA-Z0-9!-~]{0,1}$`), ""),
 				),
 			},
+			"health_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(servicediscovery.CustomHealthStatus_Values(), false),
+			},
 			"instance_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -62,36 +68,109 @@ func ResourceInstance() *schema.Resource {
 }
 
 func resourceInstancePut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceDiscoveryConn
+	conn := meta.(*conns.AWSClient).ServiceDiscoveryConn()
 
 	instanceID := d.Get("instance_id").(string)
-	input := &servicediscovery.RegisterInstanceInput{
-		Attributes:       flex.ExpandStringMap(d.Get("attributes").(map[string]interface{})),
-		CreatorRequestId: aws.String(resource.UniqueId()),
-		InstanceId:       aws.String(instanceID),
-		ServiceId:        aws.String(d.Get("service_id").(string)),
-	}
+	serviceID := d.Get("service_id").(string)
+
+	// Re-registering an existing instance recreates its health check,
+	// so its custom health status has to be set again afterwards.
+	registered := false
+
+	if d.IsNewResource() || d.HasChange("attributes") {
+		input := &servicediscovery.RegisterInstanceInput{
+			Attributes:       flex.ExpandStringMap(d.Get("attributes").(map[string]interface{})),
+			CreatorRequestId: aws.String(resource.UniqueId()),
+			InstanceId:       aws.String(instanceID),
+			ServiceId:        aws.String(serviceID),
+		}
 
-	log.Printf("[DEBUG] Registering Service Discovery Instance: %s", input)
-	output, err := conn.RegisterInstance(input)
+		log.Printf("[DEBUG] Registering Service Discovery Instance: %s", input)
+		output, err := conn.RegisterInstance(input)
 
-	if err != nil {
-		return fmt.Errorf("error registering Service Discovery Instance (%s): %w", instanceID, err)
+		if err != nil {
+			return fmt.Errorf("error registering Service Discovery Instance (%s): %w", instanceID, err)
+		}
+
+		d.SetId(instanceID)
+
+		if output != nil && output.OperationId != nil {
+			if _, err := WaitOperationSuccess(conn, aws.StringValue(output.OperationId)); err != nil {
+				return fmt.Errorf("error waiting for Service Discovery Instance (%s) register: %w", d.Id(), err)
+			}
+		}
+
+		registered = true
 	}
 
-	d.SetId(instanceID)
+	if v, ok := d.GetOk("health_status"); ok {
+		status := v.(string)
+		update := d.HasChange("health_status")
 
-	if output != nil && output.OperationId != nil {
-		if _, err := WaitOperationSuccess(conn, aws.StringValue(output.OperationId)); err != nil {
-			return fmt.Errorf("error waiting for Service Discovery Instance (%s) register: %w", d.Id(), err)
+		// An unchanged value may have been read from the instance rather than configured,
+		// so it is only set again if it is a custom health status the service accepts.
+		if !update && registered && isCustomHealthStatus(status) {
+			customHealthCheck, err := serviceHasCustomHealthCheck(conn, serviceID)
+
+			if err != nil {
+				return fmt.Errorf("error reading Service Discovery Service (%s): %w", serviceID, err)
+			}
+
+			update = customHealthCheck
+		}
+
+		if update {
+			if err := updateInstanceCustomHealthStatus(conn, serviceID, instanceID, status); err != nil {
+				return err
+			}
 		}
 	}
 
 	return resourceInstanceRead(d, meta)
 }
 
+func updateInstanceCustomHealthStatus(conn *servicediscovery.ServiceDiscovery, serviceID, instanceID, status string) error {
+	input := &servicediscovery.UpdateInstanceCustomHealthStatusInput{
+		InstanceId: aws.String(instanceID),
+		ServiceId:  aws.String(serviceID),
+		Status:     aws.String(status),
+	}
+
+	log.Printf("[DEBUG] Updating Service Discovery Instance custom health status: %s", input)
+	if _, err := conn.UpdateInstanceCustomHealthStatus(input); err != nil {
+		return fmt.Errorf("error updating Service Discovery Instance (%s) custom health status: %w", instanceID, err)
+	}
+
+	if _, err := WaitInstanceHealthStatus(conn, serviceID, instanceID, status); err != nil {
+		return fmt.Errorf("error waiting for Service Discovery Instance (%s) custom health status update: %w", instanceID, err)
+	}
+
+	return nil
+}
+
+func isCustomHealthStatus(status string) bool {
+	for _, v := range servicediscovery.CustomHealthStatus_Values() {
+		if v == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// serviceHasCustomHealthCheck returns whether the service's instances have custom health statuses.
+func serviceHasCustomHealthCheck(conn *servicediscovery.ServiceDiscovery, serviceID string) (bool, error) {
+	service, err := FindServiceByID(conn, serviceID)
+
+	if err != nil {
+		return false, err
+	}
+
+	return service.HealthCheckCustomConfig != nil, nil
+}
+
 func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceDiscoveryConn
+	conn := meta.(*conns.AWSClient).ServiceDiscoveryConn()
 
 	instance, err := FindInstanceByServiceIDAndInstanceID(conn, d.Get("service_id").(string), d.Get("instance_id").(string))
 
@@ -112,14 +191,33 @@ func resourceInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		delete(attributes, "AWS_INSTANCE_IPV4")
 	}
 
+	serviceID := d.Get("service_id").(string)
+	customHealthCheck, err := serviceHasCustomHealthCheck(conn, serviceID)
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Discovery Service (%s): %w", serviceID, err)
+	}
+
+	var healthStatus string
+
+	// Only custom health statuses are managed by this resource.
+	if customHealthCheck {
+		healthStatus, err = FindInstanceHealthStatusByServiceIDAndInstanceID(conn, serviceID, d.Get("instance_id").(string))
+
+		if err != nil && !tfresource.NotFound(err) {
+			return fmt.Errorf("error reading Service Discovery Instance (%s) health status: %w", d.Id(), err)
+		}
+	}
+
 	d.Set("attributes", aws.StringValueMap(attributes))
+	d.Set("health_status", healthStatus)
 	d.Set("instance_id", instance.Id)
 
 	return nil
 }
 
 func resourceInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ServiceDiscoveryConn
+	conn := meta.(*conns.AWSClient).ServiceDiscoveryConn()
 
 	err := deregisterServiceDiscoveryInstance(conn, d.Get("service_id").(string), d.Get("instance_id").(string))
 
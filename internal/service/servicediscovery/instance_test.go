@@ -146,6 +146,7 @@ func TestAccServiceDiscoveryInstance_http(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "service_id"),
 					resource.TestCheckResourceAttr(resourceName, "instance_id", rName),
 					resource.TestCheckResourceAttrSet(resourceName, "attributes.AWS_EC2_INSTANCE_ID"),
+					resource.TestCheckResourceAttr(resourceName, "health_status", ""),
 				),
 			},
 			{
@@ -159,6 +160,7 @@ func TestAccServiceDiscoveryInstance_http(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "service_id"),
 					resource.TestCheckResourceAttr(resourceName, "instance_id", rName),
 					resource.TestCheckResourceAttr(resourceName, "attributes.AWS_INSTANCE_IPV4", "172.18.0.12"),
+					resource.TestCheckResourceAttr(resourceName, "health_status", ""),
 				),
 			},
 			{
@@ -171,6 +173,53 @@ func TestAccServiceDiscoveryInstance_http(t *testing.T) {
 	})
 }
 
+func TestAccServiceDiscoveryInstance_healthStatus(t *testing.T) {
+	resourceName := "aws_service_discovery_instance.instance"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(servicediscovery.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, servicediscovery.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					testAccInstanceBaseConfig(rName),
+					testAccInstancePrivateNamespaceConfig(rName, domainName),
+					testAccInstanceHealthStatusConfig(rName, "UNHEALTHY"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "health_status", "UNHEALTHY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccInstanceImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: acctest.ConfigCompose(
+					testAccInstanceBaseConfig(rName),
+					testAccInstancePrivateNamespaceConfig(rName, domainName),
+					testAccInstanceHealthStatusConfig(rName, "HEALTHY"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "health_status", "HEALTHY"),
+				),
+			},
+		},
+	})
+}
+
 func testAccInstanceBaseConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "sd_register_instance" {
@@ -271,6 +320,19 @@ resource "aws_service_discovery_instance" "instance" {
 }`, instanceID, attributes)
 }
 
+func testAccInstanceHealthStatusConfig(instanceID, healthStatus string) string {
+	return fmt.Sprintf(`
+resource "aws_service_discovery_instance" "instance" {
+  service_id    = aws_service_discovery_service.sd_register_instance.id
+  instance_id   = %[1]q
+  health_status = %[2]q
+
+  attributes = {
+    AWS_INSTANCE_IPV4 = "10.0.0.1"
+  }
+}`, instanceID, healthStatus)
+}
+
 func testAccCheckInstanceExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -282,7 +344,7 @@ func testAccCheckInstanceExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("No Service Discovery Instance ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceDiscoveryConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceDiscoveryConn()
 
 		_, err := tfservicediscovery.FindInstanceByServiceIDAndInstanceID(conn, rs.Primary.Attributes["service_id"], rs.Primary.Attributes["instance_id"])
 
@@ -306,7 +368,7 @@ func testAccInstanceImportStateIdFunc(resourceName string) resource.ImportStateI
 }
 
 func testAccCheckInstanceDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceDiscoveryConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceDiscoveryConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_service_discovery_instance" {
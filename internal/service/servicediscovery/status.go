@@ -23,3 +23,20 @@ func StatusOperation(conn *servicediscovery.ServiceDiscovery, id string) resourc
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+// StatusInstanceHealth fetches the Instance's health status
+func StatusInstanceHealth(conn *servicediscovery.ServiceDiscovery, serviceID, instanceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindInstanceHealthStatusByServiceIDAndInstanceID(conn, serviceID, instanceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, output, nil
+	}
+}
@@ -13,6 +13,9 @@ import (
 const (
 	// Maximum amount of time to wait for an Operation to return Success
 	OperationSuccessTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for an Instance's custom health status to be reported
+	InstanceHealthStatusTimeout = 2 * time.Minute
 )
 
 // WaitOperationSuccess waits for an Operation to return Success
@@ -40,3 +43,28 @@ func WaitOperationSuccess(conn *servicediscovery.ServiceDiscovery, operationID s
 
 	return nil, err
 }
+
+// WaitInstanceHealthStatus waits for an Instance to report the specified health status
+func WaitInstanceHealthStatus(conn *servicediscovery.ServiceDiscovery, serviceID, instanceID, status string) (string, error) {
+	var pending []string
+	for _, v := range servicediscovery.HealthStatus_Values() {
+		if v != status {
+			pending = append(pending, v)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{status},
+		Refresh: StatusInstanceHealth(conn, serviceID, instanceID),
+		Timeout: InstanceHealthStatusTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(string); ok {
+		return output, err
+	}
+
+	return "", err
+}
@@ -0,0 +1,215 @@
+package sesv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsesv2 "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSESV2ConfigurationSetEventDestination_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_configuration_set_event_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigurationSetEventDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationSetEventDestinationCloudWatchConfig(rName, "defaultDimensionValue1", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetEventDestinationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration_set_name", "aws_sesv2_configuration_set.test", "configuration_set_name"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.cloud_watch_destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.cloud_watch_destination.0.dimension_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.cloud_watch_destination.0.dimension_configuration.0.default_dimension_value", "defaultDimensionValue1"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.cloud_watch_destination.0.dimension_configuration.0.dimension_name", "dimensionName1"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.cloud_watch_destination.0.dimension_configuration.0.dimension_value_source", sesv2.DimensionValueSourceMessageTag),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.matching_event_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "event_destination.0.matching_event_types.*", sesv2.EventTypeSend),
+					resource.TestCheckResourceAttr(resourceName, "event_destination_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationSetEventDestinationCloudWatchConfig(rName, "defaultDimensionValue2", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetEventDestinationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.cloud_watch_destination.0.dimension_configuration.0.default_dimension_value", "defaultDimensionValue2"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSESV2ConfigurationSetEventDestination_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_configuration_set_event_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigurationSetEventDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationSetEventDestinationCloudWatchConfig(rName, "defaultDimensionValue1", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetEventDestinationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsesv2.ResourceConfigurationSetEventDestination(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSESV2ConfigurationSetEventDestination_snsDestination(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_configuration_set_event_destination.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigurationSetEventDestinationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationSetEventDestinationSNSConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetEventDestinationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.cloud_watch_destination.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.0.sns_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "event_destination.0.sns_destination.0.topic_arn", "aws_sns_topic.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfigurationSetEventDestinationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_configuration_set_event_destination" {
+			continue
+		}
+
+		configurationSetName, eventDestinationName, err := tfsesv2.ConfigurationSetEventDestinationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfsesv2.FindConfigurationSetEventDestinationByTwoPartKey(conn, configurationSetName, eventDestinationName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SESv2 Configuration Set Event Destination %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckConfigurationSetEventDestinationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SESv2 Configuration Set Event Destination ID is set")
+		}
+
+		configurationSetName, eventDestinationName, err := tfsesv2.ConfigurationSetEventDestinationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn()
+
+		_, err = tfsesv2.FindConfigurationSetEventDestinationByTwoPartKey(conn, configurationSetName, eventDestinationName)
+
+		return err
+	}
+}
+
+func testAccConfigurationSetEventDestinationCloudWatchConfig(rName, defaultDimensionValue string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+}
+
+resource "aws_sesv2_configuration_set_event_destination" "test" {
+  configuration_set_name = aws_sesv2_configuration_set.test.configuration_set_name
+  event_destination_name = %[1]q
+
+  event_destination {
+    cloud_watch_destination {
+      dimension_configuration {
+        default_dimension_value = %[2]q
+        dimension_name          = "dimensionName1"
+        dimension_value_source  = "MESSAGE_TAG"
+      }
+    }
+
+    enabled              = %[3]t
+    matching_event_types = ["SEND"]
+  }
+}
+`, rName, defaultDimensionValue, enabled)
+}
+
+func testAccConfigurationSetEventDestinationSNSConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+}
+
+resource "aws_sesv2_configuration_set_event_destination" "test" {
+  configuration_set_name = aws_sesv2_configuration_set.test.configuration_set_name
+  event_destination_name = %[1]q
+
+  event_destination {
+    sns_destination {
+      topic_arn = aws_sns_topic.test.arn
+    }
+
+    matching_event_types = ["BOUNCE", "COMPLAINT"]
+  }
+}
+`, rName)
+}
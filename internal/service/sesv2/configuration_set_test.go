@@ -0,0 +1,262 @@
+package sesv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsesv2 "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSESV2ConfigurationSet_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationSetConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "ses", fmt.Sprintf("configuration-set/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "configuration_set_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "sending_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sending_options.0.sending_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "suppression_options.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSESV2ConfigurationSet_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationSetConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsesv2.ResourceConfigurationSet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSESV2ConfigurationSet_options(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationSetOptionsConfig(rName, sesv2.TlsPolicyRequire, true, false, sesv2.SuppressionListReasonBounce),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.0.tls_policy", sesv2.TlsPolicyRequire),
+					resource.TestCheckResourceAttr(resourceName, "reputation_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "reputation_options.0.reputation_metrics_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "sending_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sending_options.0.sending_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "suppression_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "suppression_options.0.suppressed_reasons.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "suppression_options.0.suppressed_reasons.*", sesv2.SuppressionListReasonBounce),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationSetOptionsConfig(rName, sesv2.TlsPolicyOptional, false, true, sesv2.SuppressionListReasonComplaint),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.0.tls_policy", sesv2.TlsPolicyOptional),
+					resource.TestCheckResourceAttr(resourceName, "reputation_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "reputation_options.0.reputation_metrics_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "sending_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sending_options.0.sending_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "suppression_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "suppression_options.0.suppressed_reasons.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "suppression_options.0.suppressed_reasons.*", sesv2.SuppressionListReasonComplaint),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSESV2ConfigurationSet_tags(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_configuration_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationSetTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationSetTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccConfigurationSetTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfigurationSetDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_configuration_set" {
+			continue
+		}
+
+		_, err := tfsesv2.FindConfigurationSetByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SESv2 Configuration Set %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckConfigurationSetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SESv2 Configuration Set ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn()
+
+		_, err := tfsesv2.FindConfigurationSetByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccConfigurationSetConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+}
+`, rName)
+}
+
+func testAccConfigurationSetOptionsConfig(rName, tlsPolicy string, reputationMetricsEnabled, sendingEnabled bool, suppressedReason string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+
+  delivery_options {
+    tls_policy = %[2]q
+  }
+
+  reputation_options {
+    reputation_metrics_enabled = %[3]t
+  }
+
+  sending_options {
+    sending_enabled = %[4]t
+  }
+
+  suppression_options {
+    suppressed_reasons = [%[5]q]
+  }
+}
+`, rName, tlsPolicy, reputationMetricsEnabled, sendingEnabled, suppressedReason)
+}
+
+func testAccConfigurationSetTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccConfigurationSetTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  configuration_set_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
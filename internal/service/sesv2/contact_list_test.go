@@ -0,0 +1,182 @@
+package sesv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsesv2 "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// Only one contact list is allowed per account and Region, so these tests must not run in parallel.
+
+func TestAccSESV2ContactList_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_contact_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContactListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactListConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactListExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "ses", fmt.Sprintf("contact-list/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "contact_list_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "created_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "topic.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSESV2ContactList_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_contact_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContactListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactListConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactListExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsesv2.ResourceContactList(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSESV2ContactList_topic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sesv2_contact_list.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckContactListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContactListTopicConfig(rName, "description1", sesv2.SubscriptionStatusOptIn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "topic.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "topic.*", map[string]string{
+						"default_subscription_status": sesv2.SubscriptionStatusOptIn,
+						"display_name":                "topic1",
+						"topic_name":                  "topic1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContactListTopicConfig(rName, "description2", sesv2.SubscriptionStatusOptOut),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContactListExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "topic.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "topic.*", map[string]string{
+						"default_subscription_status": sesv2.SubscriptionStatusOptOut,
+						"display_name":                "topic1",
+						"topic_name":                  "topic1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContactListDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_contact_list" {
+			continue
+		}
+
+		_, err := tfsesv2.FindContactListByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SESv2 Contact List %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckContactListExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SESv2 Contact List ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn()
+
+		_, err := tfsesv2.FindContactListByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccContactListConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_contact_list" "test" {
+  contact_list_name = %[1]q
+}
+`, rName)
+}
+
+func testAccContactListTopicConfig(rName, description, defaultSubscriptionStatus string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_contact_list" "test" {
+  contact_list_name = %[1]q
+  description       = %[2]q
+
+  topic {
+    default_subscription_status = %[3]q
+    display_name                = "topic1"
+    topic_name                  = "topic1"
+  }
+}
+`, rName, description, defaultSubscriptionStatus)
+}
@@ -0,0 +1,157 @@
+package sesv2
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDedicatedIPPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDedicatedIPPoolCreate,
+		Read:   resourceDedicatedIPPoolRead,
+		Update: resourceDedicatedIPPoolUpdate,
+		Delete: resourceDedicatedIPPoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pool_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDedicatedIPPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("pool_name").(string)
+	input := &sesv2.CreateDedicatedIpPoolInput{
+		PoolName: aws.String(name),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SESv2 Dedicated IP Pool: %s", input)
+	_, err := conn.CreateDedicatedIpPool(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SESv2 Dedicated IP Pool (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceDedicatedIPPoolRead(d, meta)
+}
+
+func resourceDedicatedIPPoolRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	_, err := FindDedicatedIPPoolByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Dedicated IP Pool (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Dedicated IP Pool (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "ses",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("dedicated-ip-pool/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("pool_name", d.Id())
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for SESv2 Dedicated IP Pool (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDedicatedIPPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating SESv2 Dedicated IP Pool (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDedicatedIPPoolRead(d, meta)
+}
+
+func resourceDedicatedIPPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+
+	log.Printf("[DEBUG] Deleting SESv2 Dedicated IP Pool: %s", d.Id())
+	_, err := conn.DeleteDedicatedIpPool(&sesv2.DeleteDedicatedIpPoolInput{
+		PoolName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Dedicated IP Pool (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,339 @@
+package sesv2
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceEmailIdentity() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEmailIdentityCreate,
+		Read:   resourceEmailIdentityRead,
+		Update: resourceEmailIdentityUpdate,
+		Delete: resourceEmailIdentityDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_set_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"dkim_signing_attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"current_signing_key_length": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domain_signing_private_key": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(1, 20480),
+							RequiredWith: []string{"dkim_signing_attributes.0.domain_signing_selector"},
+						},
+						"domain_signing_selector": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 63),
+							RequiredWith: []string{"dkim_signing_attributes.0.domain_signing_private_key"},
+						},
+						"last_key_generation_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_signing_key_length": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ValidateFunc:  validation.StringInSlice(sesv2.DkimSigningKeyLength_Values(), false),
+							ConflictsWith: []string{"dkim_signing_attributes.0.domain_signing_private_key", "dkim_signing_attributes.0.domain_signing_selector"},
+						},
+						"signing_attributes_origin": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tokens": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"email_identity": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 320),
+			},
+			"identity_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"verified_for_sending_status": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceEmailIdentityCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("email_identity").(string)
+	input := &sesv2.CreateEmailIdentityInput{
+		EmailIdentity: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("configuration_set_name"); ok {
+		input.ConfigurationSetName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("dkim_signing_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DkimSigningAttributes = expandDkimSigningAttributes(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SESv2 Email Identity: %s", input)
+	_, err := conn.CreateEmailIdentity(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SESv2 Email Identity (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceEmailIdentityRead(d, meta)
+}
+
+func resourceEmailIdentityRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindEmailIdentityByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SESv2 Email Identity (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SESv2 Email Identity (%s): %w", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "ses",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("identity/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("configuration_set_name", output.ConfigurationSetName)
+	d.Set("email_identity", d.Id())
+
+	if output.DkimAttributes != nil {
+		tfMap := flattenDkimAttributes(output.DkimAttributes)
+
+		// The private key is write-only; preserve the configured value.
+		if v, ok := d.GetOk("dkim_signing_attributes.0.domain_signing_private_key"); ok {
+			tfMap["domain_signing_private_key"] = v.(string)
+		}
+
+		if v, ok := d.GetOk("dkim_signing_attributes.0.domain_signing_selector"); ok {
+			tfMap["domain_signing_selector"] = v.(string)
+		}
+
+		if err := d.Set("dkim_signing_attributes", []interface{}{tfMap}); err != nil {
+			return fmt.Errorf("error setting dkim_signing_attributes: %w", err)
+		}
+	} else {
+		d.Set("dkim_signing_attributes", nil)
+	}
+
+	d.Set("identity_type", output.IdentityType)
+	d.Set("verified_for_sending_status", output.VerifiedForSendingStatus)
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceEmailIdentityUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+
+	if d.HasChange("configuration_set_name") {
+		input := &sesv2.PutEmailIdentityConfigurationSetAttributesInput{
+			EmailIdentity: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("configuration_set_name"); ok {
+			input.ConfigurationSetName = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Email Identity configuration set attributes: %s", input)
+		_, err := conn.PutEmailIdentityConfigurationSetAttributes(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating SESv2 Email Identity (%s) configuration set attributes: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("dkim_signing_attributes.0.domain_signing_private_key", "dkim_signing_attributes.0.domain_signing_selector", "dkim_signing_attributes.0.next_signing_key_length") {
+		input := &sesv2.PutEmailIdentityDkimSigningAttributesInput{
+			EmailIdentity:           aws.String(d.Id()),
+			SigningAttributesOrigin: aws.String(sesv2.DkimSigningAttributesOriginAwsSes),
+		}
+
+		if v, ok := d.GetOk("dkim_signing_attributes"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+
+			input.SigningAttributes = expandDkimSigningAttributes(tfMap)
+
+			if v, ok := tfMap["domain_signing_private_key"].(string); ok && v != "" {
+				input.SigningAttributesOrigin = aws.String(sesv2.DkimSigningAttributesOriginExternal)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating SESv2 Email Identity DKIM signing attributes: %s", input)
+		_, err := conn.PutEmailIdentityDkimSigningAttributes(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating SESv2 Email Identity (%s) DKIM signing attributes: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating SESv2 Email Identity (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceEmailIdentityRead(d, meta)
+}
+
+func resourceEmailIdentityDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESV2Conn()
+
+	log.Printf("[DEBUG] Deleting SESv2 Email Identity: %s", d.Id())
+	_, err := conn.DeleteEmailIdentity(&sesv2.DeleteEmailIdentityInput{
+		EmailIdentity: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sesv2.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SESv2 Email Identity (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDkimSigningAttributes(tfMap map[string]interface{}) *sesv2.DkimSigningAttributes {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sesv2.DkimSigningAttributes{}
+
+	if v, ok := tfMap["domain_signing_private_key"].(string); ok && v != "" {
+		apiObject.DomainSigningPrivateKey = aws.String(v)
+	}
+
+	if v, ok := tfMap["domain_signing_selector"].(string); ok && v != "" {
+		apiObject.DomainSigningSelector = aws.String(v)
+	}
+
+	if v, ok := tfMap["next_signing_key_length"].(string); ok && v != "" {
+		apiObject.NextSigningKeyLength = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenDkimAttributes(apiObject *sesv2.DkimAttributes) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CurrentSigningKeyLength; v != nil {
+		tfMap["current_signing_key_length"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LastKeyGenerationTimestamp; v != nil {
+		tfMap["last_key_generation_timestamp"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.NextSigningKeyLength; v != nil {
+		tfMap["next_signing_key_length"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.SigningAttributesOrigin; v != nil {
+		tfMap["signing_attributes_origin"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Status; v != nil {
+		tfMap["status"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Tokens; v != nil {
+		tfMap["tokens"] = aws.StringValueSlice(v)
+	}
+
+	return tfMap
+}
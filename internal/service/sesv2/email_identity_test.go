@@ -0,0 +1,236 @@
+package sesv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsesv2 "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSESV2EmailIdentity_basicEmailAddress(t *testing.T) {
+	rName := acctest.DefaultEmailAddress
+	resourceName := "aws_sesv2_email_identity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "ses", fmt.Sprintf("identity/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "email_identity", rName),
+					resource.TestCheckResourceAttr(resourceName, "identity_type", sesv2.IdentityTypeEmailAddress),
+					resource.TestCheckResourceAttr(resourceName, "verified_for_sending_status", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSESV2EmailIdentity_basicDomain(t *testing.T) {
+	rName := acctest.RandomDomainName()
+	resourceName := "aws_sesv2_email_identity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "ses", fmt.Sprintf("identity/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.signing_attributes_origin", sesv2.DkimSigningAttributesOriginAwsSes),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.tokens.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "email_identity", rName),
+					resource.TestCheckResourceAttr(resourceName, "identity_type", sesv2.IdentityTypeDomain),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSESV2EmailIdentity_disappears(t *testing.T) {
+	rName := acctest.RandomDomainName()
+	resourceName := "aws_sesv2_email_identity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsesv2.ResourceEmailIdentity(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSESV2EmailIdentity_configurationSetName(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	resourceName := "aws_sesv2_email_identity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityConfigurationSetNameConfig(rName, domain, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration_set_name", "aws_sesv2_configuration_set.test.0", "configuration_set_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEmailIdentityConfigurationSetNameConfig(rName, domain, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration_set_name", "aws_sesv2_configuration_set.test.1", "configuration_set_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSESV2EmailIdentity_nextSigningKeyLength(t *testing.T) {
+	rName := acctest.RandomDomainName()
+	resourceName := "aws_sesv2_email_identity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sesv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmailIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailIdentityNextSigningKeyLengthConfig(rName, sesv2.DkimSigningKeyLengthRsa2048Bit),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmailIdentityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dkim_signing_attributes.0.next_signing_key_length", sesv2.DkimSigningKeyLengthRsa2048Bit),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEmailIdentityDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sesv2_email_identity" {
+			continue
+		}
+
+		_, err := tfsesv2.FindEmailIdentityByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SESv2 Email Identity %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckEmailIdentityExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SESv2 Email Identity ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SESV2Conn()
+
+		_, err := tfsesv2.FindEmailIdentityByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccEmailIdentityConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity" "test" {
+  email_identity = %[1]q
+}
+`, rName)
+}
+
+func testAccEmailIdentityConfigurationSetNameConfig(rName, domain string, index int) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_configuration_set" "test" {
+  count = 2
+
+  configuration_set_name = "%[1]s-${count.index}"
+}
+
+resource "aws_sesv2_email_identity" "test" {
+  email_identity         = %[2]q
+  configuration_set_name = aws_sesv2_configuration_set.test[%[3]d].configuration_set_name
+}
+`, rName, domain, index)
+}
+
+func testAccEmailIdentityNextSigningKeyLengthConfig(rName, nextSigningKeyLength string) string {
+	return fmt.Sprintf(`
+resource "aws_sesv2_email_identity" "test" {
+  email_identity = %[1]q
+
+  dkim_signing_attributes {
+    next_signing_key_length = %[2]q
+  }
+}
+`, rName, nextSigningKeyLength)
+}
@@ -0,0 +1,25 @@
+package sesv2
+
+import (
+	"fmt"
+	"strings"
+)
+
+const configurationSetEventDestinationIDSeparator = "|"
+
+func ConfigurationSetEventDestinationCreateID(configurationSetName, eventDestinationName string) string {
+	parts := []string{configurationSetName, eventDestinationName}
+	id := strings.Join(parts, configurationSetEventDestinationIDSeparator)
+
+	return id
+}
+
+func ConfigurationSetEventDestinationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, configurationSetEventDestinationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CONFIGURATION_SET_NAME%[2]sEVENT_DESTINATION_NAME", id, configurationSetEventDestinationIDSeparator)
+}
@@ -0,0 +1,249 @@
+//go:build sweep
+// +build sweep
+
+package sesv2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sesv2"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_sesv2_configuration_set", &resource.Sweeper{
+		Name: "aws_sesv2_configuration_set",
+		F:    sweepConfigurationSets,
+	})
+
+	resource.AddTestSweepers("aws_sesv2_contact_list", &resource.Sweeper{
+		Name: "aws_sesv2_contact_list",
+		F:    sweepContactLists,
+	})
+
+	resource.AddTestSweepers("aws_sesv2_dedicated_ip_pool", &resource.Sweeper{
+		Name: "aws_sesv2_dedicated_ip_pool",
+		F:    sweepDedicatedIPPools,
+		Dependencies: []string{
+			"aws_sesv2_configuration_set",
+		},
+	})
+
+	resource.AddTestSweepers("aws_sesv2_email_identity", &resource.Sweeper{
+		Name: "aws_sesv2_email_identity",
+		F:    sweepEmailIdentities,
+	})
+}
+
+func sweepConfigurationSets(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).SESV2Conn()
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &sesv2.ListConfigurationSetsInput{}
+
+	err = conn.ListConfigurationSetsPages(input, func(page *sesv2.ListConfigurationSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ConfigurationSets {
+			if v == nil {
+				continue
+			}
+
+			id := aws.StringValue(v)
+
+			log.Printf("[INFO] Deleting SESv2 Configuration Set (%s)", id)
+			r := ResourceConfigurationSet()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing SESv2 Configuration Sets: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping SESv2 Configuration Sets for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping SESv2 Configuration Sets sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepContactLists(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).SESV2Conn()
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &sesv2.ListContactListsInput{}
+
+	err = conn.ListContactListsPages(input, func(page *sesv2.ListContactListsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ContactLists {
+			if v == nil {
+				continue
+			}
+
+			id := aws.StringValue(v.ContactListName)
+
+			log.Printf("[INFO] Deleting SESv2 Contact List (%s)", id)
+			r := ResourceContactList()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing SESv2 Contact Lists: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping SESv2 Contact Lists for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping SESv2 Contact Lists sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepDedicatedIPPools(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).SESV2Conn()
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &sesv2.ListDedicatedIpPoolsInput{}
+
+	err = conn.ListDedicatedIpPoolsPages(input, func(page *sesv2.ListDedicatedIpPoolsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DedicatedIpPools {
+			if v == nil {
+				continue
+			}
+
+			id := aws.StringValue(v)
+
+			log.Printf("[INFO] Deleting SESv2 Dedicated IP Pool (%s)", id)
+			r := ResourceDedicatedIPPool()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing SESv2 Dedicated IP Pools: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping SESv2 Dedicated IP Pools for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping SESv2 Dedicated IP Pools sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepEmailIdentities(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).SESV2Conn()
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &sesv2.ListEmailIdentitiesInput{}
+
+	err = conn.ListEmailIdentitiesPages(input, func(page *sesv2.ListEmailIdentitiesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.EmailIdentities {
+			if v == nil {
+				continue
+			}
+
+			id := aws.StringValue(v.IdentityName)
+
+			log.Printf("[INFO] Deleting SESv2 Email Identity (%s)", id)
+			r := ResourceEmailIdentity()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing SESv2 Email Identities: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping SESv2 Email Identities for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping SESv2 Email Identities sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
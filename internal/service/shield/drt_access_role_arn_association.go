@@ -0,0 +1,100 @@
+package shield
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDRTAccessRoleARNAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDRTAccessRoleARNAssociationCreate,
+		Read:   resourceDRTAccessRoleARNAssociationRead,
+		Delete: resourceDRTAccessRoleARNAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceDRTAccessRoleARNAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn()
+
+	roleARN := d.Get("role_arn").(string)
+	input := &shield.AssociateDRTRoleInput{
+		RoleArn: aws.String(roleARN),
+	}
+
+	log.Printf("[DEBUG] Creating Shield DRT Access Role ARN Association: %s", input)
+	_, err := conn.AssociateDRTRole(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Shield DRT Access Role ARN Association (%s): %w", roleARN, err)
+	}
+
+	d.SetId(roleARN)
+
+	return resourceDRTAccessRoleARNAssociationRead(d, meta)
+}
+
+func resourceDRTAccessRoleARNAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn()
+
+	output, err := conn.DescribeDRTAccess(&shield.DescribeDRTAccessInput{})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Shield DRT Access Role ARN Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield DRT Access Role ARN Association (%s): %w", d.Id(), err)
+	}
+
+	if roleARN := aws.StringValue(output.RoleArn); roleARN != d.Id() {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Shield DRT Access Role ARN Association (%s): associated role is %q", d.Id(), roleARN)
+		}
+
+		log.Printf("[WARN] Shield DRT Access Role ARN Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role_arn", output.RoleArn)
+
+	return nil
+}
+
+func resourceDRTAccessRoleARNAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn()
+
+	log.Printf("[DEBUG] Deleting Shield DRT Access Role ARN Association: %s", d.Id())
+	_, err := conn.DisassociateDRTRole(&shield.DisassociateDRTRoleInput{})
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Shield DRT Access Role ARN Association (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
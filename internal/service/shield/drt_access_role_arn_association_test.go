@@ -0,0 +1,148 @@
+package shield_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfshield "github.com/hashicorp/terraform-provider-aws/internal/service/shield"
+)
+
+func TestAccShieldDRTAccessRoleARNAssociation_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_shield_drt_access_role_arn_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, shield.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDRTAccessRoleARNAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDRTAccessRoleARNAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDRTAccessRoleARNAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccShieldDRTAccessRoleARNAssociation_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_shield_drt_access_role_arn_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, shield.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDRTAccessRoleARNAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDRTAccessRoleARNAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDRTAccessRoleARNAssociationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfshield.ResourceDRTAccessRoleARNAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDRTAccessRoleARNAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_drt_access_role_arn_association" {
+			continue
+		}
+
+		output, err := conn.DescribeDRTAccess(&shield.DescribeDRTAccessInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.RoleArn) == rs.Primary.ID {
+			return fmt.Errorf("Shield DRT Access Role ARN Association %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDRTAccessRoleARNAssociationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn()
+
+		output, err := conn.DescribeDRTAccess(&shield.DescribeDRTAccessInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.RoleArn) != rs.Primary.ID {
+			return fmt.Errorf("Shield DRT Access Role ARN Association %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccDRTAccessRoleARNAssociationConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "drt.shield.amazonaws.com"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSShieldDRTAccessPolicy"
+}
+
+resource "aws_shield_drt_access_role_arn_association" "test" {
+  role_arn = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName)
+}
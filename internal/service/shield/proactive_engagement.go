@@ -0,0 +1,211 @@
+package shield
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceProactiveEngagement() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProactiveEngagementPut,
+		Read:   resourceProactiveEngagementRead,
+		Update: resourceProactiveEngagementPut,
+		Delete: resourceProactiveEngagementDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"emergency_contact": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 10,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contact_notes": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"email_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 150),
+						},
+						"phone_number": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\+[1-9]\d{1,14}$`), "must be in E.164 format"),
+						},
+					},
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceProactiveEngagementPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn()
+
+	input := &shield.UpdateEmergencyContactSettingsInput{
+		EmergencyContactList: expandEmergencyContacts(d.Get("emergency_contact").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Updating Shield Emergency Contact Settings: %s", input)
+	if _, err := conn.UpdateEmergencyContactSettings(input); err != nil {
+		return fmt.Errorf("error updating Shield Emergency Contact Settings: %w", err)
+	}
+
+	if err := updateProactiveEngagementStatus(conn, d.Get("enabled").(bool)); err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).AccountID)
+	}
+
+	return resourceProactiveEngagementRead(d, meta)
+}
+
+func resourceProactiveEngagementRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn()
+
+	subscription, err := conn.DescribeSubscription(&shield.DescribeSubscriptionInput{})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Shield Proactive Engagement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Subscription: %w", err)
+	}
+
+	if subscription == nil || subscription.Subscription == nil {
+		return fmt.Errorf("error reading Shield Subscription: empty response")
+	}
+
+	output, err := conn.DescribeEmergencyContactSettings(&shield.DescribeEmergencyContactSettingsInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Emergency Contact Settings: %w", err)
+	}
+
+	if err := d.Set("emergency_contact", flattenEmergencyContacts(output.EmergencyContactList)); err != nil {
+		return fmt.Errorf("error setting emergency_contact: %w", err)
+	}
+
+	d.Set("enabled", aws.StringValue(subscription.Subscription.ProactiveEngagementStatus) == shield.ProactiveEngagementStatusEnabled)
+
+	return nil
+}
+
+func resourceProactiveEngagementDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ShieldConn()
+
+	err := updateProactiveEngagementStatus(conn, false)
+
+	if tfawserr.ErrCodeEquals(err, shield.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Shield Emergency Contact Settings")
+	_, err = conn.UpdateEmergencyContactSettings(&shield.UpdateEmergencyContactSettingsInput{
+		EmergencyContactList: []*shield.EmergencyContact{},
+	})
+
+	if err != nil {
+		return fmt.Errorf("error deleting Shield Emergency Contact Settings: %w", err)
+	}
+
+	return nil
+}
+
+// updateProactiveEngagementStatus enables or disables proactive engagement,
+// skipping the call if the subscription is already in the requested state.
+func updateProactiveEngagementStatus(conn *shield.Shield, enabled bool) error {
+	output, err := conn.DescribeSubscription(&shield.DescribeSubscriptionInput{})
+
+	if err != nil {
+		return fmt.Errorf("error reading Shield Subscription: %w", err)
+	}
+
+	status := aws.StringValue(output.Subscription.ProactiveEngagementStatus)
+
+	if enabled && status != shield.ProactiveEngagementStatusEnabled {
+		if _, err := conn.EnableProactiveEngagement(&shield.EnableProactiveEngagementInput{}); err != nil {
+			return fmt.Errorf("error enabling Shield Proactive Engagement: %w", err)
+		}
+	}
+
+	if !enabled && status == shield.ProactiveEngagementStatusEnabled {
+		if _, err := conn.DisableProactiveEngagement(&shield.DisableProactiveEngagementInput{}); err != nil {
+			return fmt.Errorf("error disabling Shield Proactive Engagement: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func expandEmergencyContacts(l []interface{}) []*shield.EmergencyContact {
+	emergencyContacts := make([]*shield.EmergencyContact, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		emergencyContact := &shield.EmergencyContact{
+			EmailAddress: aws.String(tfMap["email_address"].(string)),
+		}
+
+		if v, ok := tfMap["contact_notes"].(string); ok && v != "" {
+			emergencyContact.ContactNotes = aws.String(v)
+		}
+
+		if v, ok := tfMap["phone_number"].(string); ok && v != "" {
+			emergencyContact.PhoneNumber = aws.String(v)
+		}
+
+		emergencyContacts = append(emergencyContacts, emergencyContact)
+	}
+
+	return emergencyContacts
+}
+
+func flattenEmergencyContacts(apiObjects []*shield.EmergencyContact) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"contact_notes": aws.StringValue(apiObject.ContactNotes),
+			"email_address": aws.StringValue(apiObject.EmailAddress),
+			"phone_number":  aws.StringValue(apiObject.PhoneNumber),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,117 @@
+package shield_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/shield"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccShieldProactiveEngagement_basic(t *testing.T) {
+	resourceName := "aws_shield_proactive_engagement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(shield.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, shield.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckProactiveEngagementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProactiveEngagementConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProactiveEngagementEnabled(resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.contact_notes", "Primary"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.email_address", "primary@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.0.phone_number", "+12345678901"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.1.email_address", "secondary@example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProactiveEngagementConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProactiveEngagementEnabled(resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "emergency_contact.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckProactiveEngagementDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_shield_proactive_engagement" {
+			continue
+		}
+
+		output, err := conn.DescribeSubscription(&shield.DescribeSubscriptionInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.Subscription.ProactiveEngagementStatus) == shield.ProactiveEngagementStatusEnabled {
+			return fmt.Errorf("Shield Proactive Engagement %s still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckProactiveEngagementEnabled(name string, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[name]; !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ShieldConn()
+
+		output, err := conn.DescribeSubscription(&shield.DescribeSubscriptionInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.StringValue(output.Subscription.ProactiveEngagementStatus) == shield.ProactiveEngagementStatusEnabled; got != enabled {
+			return fmt.Errorf("Shield Proactive Engagement enabled is %t, expected %t", got, enabled)
+		}
+
+		return nil
+	}
+}
+
+func testAccProactiveEngagementConfig(enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_shield_proactive_engagement" "test" {
+  enabled = %[1]t
+
+  emergency_contact {
+    contact_notes = "Primary"
+    email_address = "primary@example.com"
+    phone_number  = "+12345678901"
+  }
+
+  emergency_contact {
+    email_address = "secondary@example.com"
+  }
+}
+`, enabled)
+}
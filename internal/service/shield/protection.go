@@ -47,7 +47,7 @@ func ResourceProtection() *schema.Resource {
 }
 
 func resourceProtectionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ShieldConn
+	conn := meta.(*conns.AWSClient).ShieldConn()
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
@@ -60,7 +60,7 @@ func resourceProtectionUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceProtectionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ShieldConn
+	conn := meta.(*conns.AWSClient).ShieldConn()
 
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -80,7 +80,7 @@ func resourceProtectionCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceProtectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ShieldConn
+	conn := meta.(*conns.AWSClient).ShieldConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -114,7 +114,7 @@ func resourceProtectionRead(d *schema.ResourceData, meta interface{}) error {
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -126,7 +126,7 @@ func resourceProtectionRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceProtectionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).ShieldConn
+	conn := meta.(*conns.AWSClient).ShieldConn()
 
 	input := &shield.DeleteProtectionInput{
 		ProtectionId: aws.String(d.Id()),
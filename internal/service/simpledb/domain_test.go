@@ -38,7 +38,7 @@ func TestAccSimpleDBDomain_basic(t *testing.T) {
 }
 
 func testAccCheckDomainDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SimpleDBConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SimpleDBConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_simpledb_domain" {
@@ -74,7 +74,7 @@ func testAccCheckDomainExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No SimpleDB domain with that name exists")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SimpleDBConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SimpleDBConn()
 		input := &simpledb.DomainMetadataInput{
 			DomainName: aws.String(rs.Primary.ID),
 		}
@@ -360,7 +360,7 @@ func testAccCheckPlatformApplicationExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("missing ID: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn()
 
 		input := &sns.GetPlatformApplicationAttributesInput{
 			PlatformApplicationArn: aws.String(rs.Primary.ID),
@@ -374,7 +374,7 @@ func testAccCheckPlatformApplicationExists(name string) resource.TestCheckFunc {
 }
 
 func testAccCheckPlatformApplicationDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sns_platform_application" {
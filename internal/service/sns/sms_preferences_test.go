@@ -129,7 +129,7 @@ func testAccCheckSMSPrefsDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn()
 		attrs, err := conn.GetSMSAttributes(&sns.GetSMSAttributesInput{})
 		if err != nil {
 			return fmt.Errorf("error getting SMS attributes: %s", err)
@@ -53,7 +53,7 @@ func sweepPlatformApplications(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SNSConn
+	conn := client.(*conns.AWSClient).SNSConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListPlatformApplicationsPages(&sns.ListPlatformApplicationsInput{}, func(page *sns.ListPlatformApplicationsOutput, lastPage bool) bool {
@@ -98,7 +98,7 @@ func sweepTopics(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).SNSConn
+	conn := client.(*conns.AWSClient).SNSConn()
 	var sweeperErrs *multierror.Error
 
 	err = conn.ListTopicsPages(&sns.ListTopicsInput{}, func(page *sns.ListTopicsOutput, lastPage bool) bool {
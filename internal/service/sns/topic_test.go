@@ -515,7 +515,7 @@ func testAccCheckNSTopicHasPolicy(n string, expectedPolicyText string) resource.
 			return fmt.Errorf("No SNS topic with that ARN exists")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn()
 
 		params := &sns.GetTopicAttributesInput{
 			TopicArn: aws.String(rs.Primary.ID),
@@ -557,7 +557,7 @@ func testAccCheckNSTopicHasDeliveryPolicy(n string, expectedPolicyText string) r
 			return fmt.Errorf("no Queue URL specified")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn()
 
 		params := &sns.GetTopicAttributesInput{
 			TopicArn: aws.String(rs.Primary.ID),
@@ -587,7 +587,7 @@ func testAccCheckNSTopicHasDeliveryPolicy(n string, expectedPolicyText string) r
 }
 
 func testAccCheckTopicDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sns_topic" {
@@ -622,7 +622,7 @@ func testAccCheckTopicExists(n string, attributes map[string]string) resource.Te
 			return fmt.Errorf("No SNS topic with that ARN exists")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SNSConn()
 
 		params := &sns.GetTopicAttributesInput{
 			TopicArn: aws.String(rs.Primary.ID),
@@ -119,9 +119,21 @@ var (
 			Default:  DefaultQueueReceiveMessageWaitTimeSeconds,
 		},
 
+		"redrive_allow_policy": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsJSON,
+			StateFunc: func(v interface{}) string {
+				json, _ := structure.NormalizeJsonString(v)
+				return json
+			},
+		},
+
 		"redrive_policy": {
 			Type:         schema.TypeString,
 			Optional:     true,
+			Computed:     true,
 			ValidateFunc: validation.StringIsJSON,
 			StateFunc: func(v interface{}) string {
 				json, _ := structure.NormalizeJsonString(v)
@@ -153,6 +165,7 @@ var (
 		"visibility_timeout_seconds":        sqs.QueueAttributeNameVisibilityTimeout,
 		"policy":                            sqs.QueueAttributeNamePolicy,
 		"redrive_policy":                    sqs.QueueAttributeNameRedrivePolicy,
+		"redrive_allow_policy":              sqs.QueueAttributeNameRedriveAllowPolicy,
 		"arn":                               sqs.QueueAttributeNameQueueArn,
 		"fifo_queue":                        sqs.QueueAttributeNameFifoQueue,
 		"content_based_deduplication":       sqs.QueueAttributeNameContentBasedDeduplication,
@@ -185,7 +198,7 @@ func ResourceQueue() *schema.Resource {
 }
 
 func resourceQueueCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SQSConn
+	conn := meta.(*conns.AWSClient).SQSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -260,7 +273,7 @@ func resourceQueueCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceQueueRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SQSConn
+	conn := meta.(*conns.AWSClient).SQSConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -315,7 +328,7 @@ func resourceQueueRead(d *schema.ResourceData, meta interface{}) error {
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -327,7 +340,7 @@ func resourceQueueRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceQueueUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SQSConn
+	conn := meta.(*conns.AWSClient).SQSConn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		attributes, err := sqsQueueAttributeMap.ResourceDataToApiAttributesUpdate(d)
@@ -366,7 +379,7 @@ func resourceQueueUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceQueueDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SQSConn
+	conn := meta.(*conns.AWSClient).SQSConn()
 
 	log.Printf("[DEBUG] Deleting SQS Queue: %s", d.Id())
 	_, err := conn.DeleteQueue(&sqs.DeleteQueueInput{
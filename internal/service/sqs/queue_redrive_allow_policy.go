@@ -0,0 +1,130 @@
+package sqs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var (
+	sqsQueueEmptyRedriveAllowPolicyAttributes = map[string]string{
+		sqs.QueueAttributeNameRedriveAllowPolicy: "",
+	}
+)
+
+func ResourceQueueRedriveAllowPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceQueueRedriveAllowPolicyUpsert,
+		Read:   resourceQueueRedriveAllowPolicyRead,
+		Update: resourceQueueRedriveAllowPolicyUpsert,
+		Delete: resourceQueueRedriveAllowPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"queue_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"redrive_allow_policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceQueueRedriveAllowPolicyUpsert(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn()
+
+	attributes := map[string]string{
+		sqs.QueueAttributeNameRedriveAllowPolicy: d.Get("redrive_allow_policy").(string),
+	}
+	url := d.Get("queue_url").(string)
+	input := &sqs.SetQueueAttributesInput{
+		Attributes: aws.StringMap(attributes),
+		QueueUrl:   aws.String(url),
+	}
+
+	log.Printf("[DEBUG] Setting SQS Queue Redrive Allow Policy: %s", input)
+	_, err := conn.SetQueueAttributes(input)
+
+	if err != nil {
+		return fmt.Errorf("error setting SQS Queue Redrive Allow Policy (%s): %w", url, err)
+	}
+
+	d.SetId(url)
+
+	err = waitQueueAttributesPropagated(conn, d.Id(), attributes)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for SQS Queue Redrive Allow Policy (%s) to be set: %w", d.Id(), err)
+	}
+
+	return resourceQueueRedriveAllowPolicyRead(d, meta)
+}
+
+func resourceQueueRedriveAllowPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn()
+
+	policy, err := FindQueueAttributeByURL(conn, d.Id(), sqs.QueueAttributeNameRedriveAllowPolicy)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SQS Queue Redrive Allow Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SQS Queue Redrive Allow Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("queue_url", d.Id())
+	d.Set("redrive_allow_policy", policy)
+
+	return nil
+}
+
+func resourceQueueRedriveAllowPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn()
+
+	log.Printf("[DEBUG] Deleting SQS Queue Redrive Allow Policy: %s", d.Id())
+	_, err := conn.SetQueueAttributes(&sqs.SetQueueAttributesInput{
+		Attributes: aws.StringMap(sqsQueueEmptyRedriveAllowPolicyAttributes),
+		QueueUrl:   aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sqs.ErrCodeQueueDoesNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SQS Queue Redrive Allow Policy (%s): %w", d.Id(), err)
+	}
+
+	err = waitQueueAttributesPropagated(conn, d.Id(), sqsQueueEmptyRedriveAllowPolicyAttributes)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for SQS Queue Redrive Allow Policy (%s) to delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package sqs_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sqs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+)
+
+func TestAccSQSQueueRedriveAllowPolicy_basic(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue_redrive_allow_policy.test"
+	queueResourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRedriveAllowPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(queueResourceName, &queueAttributes),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_url", queueResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "redrive_allow_policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccQueueRedriveAllowPolicyConfig(rName),
+				PlanOnly: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "redrive_allow_policy", queueResourceName, "redrive_allow_policy"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQSQueueRedriveAllowPolicy_disappears(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue_redrive_allow_policy.test"
+	queueResourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRedriveAllowPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(queueResourceName, &queueAttributes),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsqs.ResourceQueueRedriveAllowPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSQSQueueRedriveAllowPolicy_update(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue_redrive_allow_policy.test"
+	queueResourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRedriveAllowPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(queueResourceName, &queueAttributes),
+					resource.TestCheckResourceAttrSet(resourceName, "redrive_allow_policy"),
+				),
+			},
+			{
+				Config: testAccQueueRedriveAllowPolicyAllowAllConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "redrive_allow_policy", regexp.MustCompile(`"redrivePermission":"allowAll"`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccQueueRedriveAllowPolicyConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue" "source" {
+  name = "%[1]s-source"
+}
+
+resource "aws_sqs_queue_redrive_allow_policy" "test" {
+  queue_url = aws_sqs_queue.test.id
+
+  redrive_allow_policy = jsonencode({
+    redrivePermission = "byQueue"
+    sourceQueueArns   = [aws_sqs_queue.source.arn]
+  })
+}
+`, rName)
+}
+
+func testAccQueueRedriveAllowPolicyAllowAllConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue_redrive_allow_policy" "test" {
+  queue_url = aws_sqs_queue.test.id
+
+  redrive_allow_policy = jsonencode({
+    redrivePermission = "allowAll"
+  })
+}
+`, rName)
+}
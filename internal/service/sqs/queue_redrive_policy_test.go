@@ -0,0 +1,123 @@
+package sqs_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sqs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+)
+
+func TestAccSQSQueueRedrivePolicy_basic(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue_redrive_policy.test"
+	queueResourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRedrivePolicyConfig(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(queueResourceName, &queueAttributes),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_url", queueResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "redrive_policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccQueueRedrivePolicyConfig(rName, 3),
+				PlanOnly: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "redrive_policy", queueResourceName, "redrive_policy"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSQSQueueRedrivePolicy_disappears(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue_redrive_policy.test"
+	queueResourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRedrivePolicyConfig(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(queueResourceName, &queueAttributes),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsqs.ResourceQueueRedrivePolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSQSQueueRedrivePolicy_update(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue_redrive_policy.test"
+	queueResourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueRedrivePolicyConfig(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(queueResourceName, &queueAttributes),
+					resource.TestCheckResourceAttrSet(resourceName, "redrive_policy"),
+				),
+			},
+			{
+				Config: testAccQueueRedrivePolicyConfig(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "redrive_policy", regexp.MustCompile(`"maxReceiveCount":5`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccQueueRedrivePolicyConfig(rName string, maxReceiveCount int) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-dlq"
+}
+
+resource "aws_sqs_queue_redrive_policy" "test" {
+  queue_url = aws_sqs_queue.test.id
+
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.dlq.arn
+    maxReceiveCount     = %[2]d
+  })
+}
+`, rName, maxReceiveCount)
+}
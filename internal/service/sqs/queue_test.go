@@ -398,6 +398,33 @@ func TestAccSQSQueue_redrivePolicy(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueue_redriveAllowPolicy(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sqs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedriveAllowPolicyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &queueAttributes),
+					resource.TestCheckResourceAttrSet(resourceName, "redrive_allow_policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccSQSQueue_fifoQueue(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
@@ -668,7 +695,7 @@ func testAccCheckQueueExists(resourceName string, v *map[string]string) resource
 			return fmt.Errorf("No SQS Queue URL is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSConn()
 
 		output, err := tfsqs.FindQueueAttributesByURL(conn, rs.Primary.ID)
 
@@ -683,7 +710,7 @@ func testAccCheckQueueExists(resourceName string, v *map[string]string) resource
 }
 
 func testAccCheckQueueDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SQSConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SQSConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sqs_queue" {
@@ -854,6 +881,23 @@ resource "aws_sqs_queue" "dlq" {
 `, rName)
 }
 
+func testAccRedriveAllowPolicyConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = "%[1]s-1"
+
+  redrive_allow_policy = jsonencode({
+    redrivePermission = "byQueue"
+    sourceQueueArns   = [aws_sqs_queue.source.arn]
+  })
+}
+
+resource "aws_sqs_queue" "source" {
+  name = "%[1]s-2"
+}
+`, rName)
+}
+
 func testAccFIFOQueueConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {
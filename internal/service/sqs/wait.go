@@ -62,6 +62,10 @@ func waitQueueAttributesPropagated(conn *sqs.SQS, url string, expected map[strin
 				if !StringsEquivalent(g, e) {
 					return fmt.Errorf("SQS Queue redrive policies are not equivalent")
 				}
+			case sqs.QueueAttributeNameRedriveAllowPolicy:
+				if !StringsEquivalent(g, e) {
+					return fmt.Errorf("SQS Queue redrive allow policies are not equivalent")
+				}
 			default:
 				if g != e {
 					return fmt.Errorf("SQS Queue attribute (%s) got: %s, expected: %s", k, g, e)
@@ -28,6 +28,11 @@ func DataSourceParametersByPath() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"recursive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"types": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -39,6 +44,11 @@ func DataSourceParametersByPath() *schema.Resource {
 				Sensitive: true,
 				Elem:      &schema.Schema{Type: schema.TypeString},
 			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
 			"with_decryption": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -49,11 +59,12 @@ func DataSourceParametersByPath() *schema.Resource {
 }
 
 func dataSourceParametersReadByPath(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSMConn
+	conn := meta.(*conns.AWSClient).SSMConn()
 
 	path := d.Get("path").(string)
 	input := &ssm.GetParametersByPathInput{
 		Path:           aws.String(path),
+		Recursive:      aws.Bool(d.Get("recursive").(bool)),
 		WithDecryption: aws.Bool(d.Get("with_decryption").(bool)),
 	}
 
@@ -61,6 +72,7 @@ func dataSourceParametersReadByPath(d *schema.ResourceData, meta interface{}) er
 	names := make([]string, 0)
 	types := make([]string, 0)
 	values := make([]string, 0)
+	versions := make([]int64, 0)
 
 	err := conn.GetParametersByPathPages(input, func(page *ssm.GetParametersByPathOutput, lastPage bool) bool {
 		if page == nil {
@@ -72,6 +84,7 @@ func dataSourceParametersReadByPath(d *schema.ResourceData, meta interface{}) er
 			names = append(names, aws.StringValue(param.Name))
 			types = append(types, aws.StringValue(param.Type))
 			values = append(values, aws.StringValue(param.Value))
+			versions = append(versions, aws.Int64Value(param.Version))
 		}
 
 		return !lastPage
@@ -86,6 +99,7 @@ func dataSourceParametersReadByPath(d *schema.ResourceData, meta interface{}) er
 	d.Set("names", names)
 	d.Set("types", types)
 	d.Set("values", values)
+	d.Set("versions", versions)
 
 	return nil
 }
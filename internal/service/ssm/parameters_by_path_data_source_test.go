@@ -27,6 +27,7 @@ func TestAccSSMParametersByPathDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "names.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "types.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "with_decryption", "false"),
 				),
 			},
@@ -34,6 +35,34 @@ func TestAccSSMParametersByPathDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccSSMParametersByPathDataSource_recursive(t *testing.T) {
+	resourceName := "data.aws_ssm_parameters_by_path.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckParametersByPathDataSourceRecursiveConfig(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recursive", "false"),
+				),
+			},
+			{
+				Config: testAccCheckParametersByPathDataSourceRecursiveConfig(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "names.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "recursive", "true"),
+					resource.TestCheckResourceAttr(resourceName, "versions.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckParametersByPathDataSourceConfig(rName1, rName2 string, withDecryption bool) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_parameter" "test1" {
@@ -66,3 +95,29 @@ data "aws_ssm_parameters_by_path" "test" {
 }
 `, rName1, rName2, withDecryption)
 }
+
+func testAccCheckParametersByPathDataSourceRecursiveConfig(rName string, recursive bool) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "top_level" {
+  name  = "/%[1]s/top_param"
+  type  = "String"
+  value = "TestValueA"
+}
+
+resource "aws_ssm_parameter" "nested" {
+  name  = "/%[1]s/nested/param"
+  type  = "String"
+  value = "TestValueB"
+}
+
+data "aws_ssm_parameters_by_path" "test" {
+  path      = "/%[1]s"
+  recursive = %[2]t
+
+  depends_on = [
+    aws_ssm_parameter.top_level,
+    aws_ssm_parameter.nested,
+  ]
+}
+`, rName, recursive)
+}
@@ -35,7 +35,7 @@ func sweepMaintenanceWindows(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*conns.AWSClient).SSMConn
+	conn := client.(*conns.AWSClient).SSMConn()
 	input := &ssm.DescribeMaintenanceWindowsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -90,7 +90,7 @@ func sweepResourceDataSyncs(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*conns.AWSClient).SSMConn
+	conn := client.(*conns.AWSClient).SSMConn()
 	sweepResources := make([]*sweep.SweepResource, 0)
 	var errs *multierror.Error
 
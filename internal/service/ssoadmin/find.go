@@ -3,6 +3,9 @@ package ssoadmin
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // FindAccountAssignment returns the account assigned to a permission set within a specified SSO instance.
@@ -69,3 +72,29 @@ func FindManagedPolicy(conn *ssoadmin.SSOAdmin, managedPolicyArn, permissionSetA
 
 	return attachedPolicy, err
 }
+
+// FindInstanceAccessControlAttributes returns the attribute-based access control configuration of a specified SSO instance.
+func FindInstanceAccessControlAttributes(conn *ssoadmin.SSOAdmin, instanceArn string) (*ssoadmin.DescribeInstanceAccessControlAttributeConfigurationOutput, error) {
+	input := &ssoadmin.DescribeInstanceAccessControlAttributeConfigurationInput{
+		InstanceArn: aws.String(instanceArn),
+	}
+
+	output, err := conn.DescribeInstanceAccessControlAttributeConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.InstanceAccessControlAttributeConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
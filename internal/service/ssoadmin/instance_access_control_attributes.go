@@ -0,0 +1,216 @@
+package ssoadmin
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceInstanceAccessControlAttributes() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInstanceAccessControlAttributesCreate,
+		Read:   resourceInstanceAccessControlAttributesRead,
+		Update: resourceInstanceAccessControlAttributesUpdate,
+		Delete: resourceInstanceAccessControlAttributesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"attribute": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"value": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(0, 256),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceInstanceAccessControlAttributesCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
+
+	instanceArn := d.Get("instance_arn").(string)
+
+	input := &ssoadmin.CreateInstanceAccessControlAttributeConfigurationInput{
+		InstanceArn: aws.String(instanceArn),
+		InstanceAccessControlAttributeConfiguration: &ssoadmin.InstanceAccessControlAttributeConfiguration{
+			AccessControlAttributes: expandAccessControlAttributes(d.Get("attribute").(*schema.Set).List()),
+		},
+	}
+
+	_, err := conn.CreateInstanceAccessControlAttributeConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SSO Instance Access Control Attributes (%s): %w", instanceArn, err)
+	}
+
+	d.SetId(instanceArn)
+
+	return resourceInstanceAccessControlAttributesRead(d, meta)
+}
+
+func resourceInstanceAccessControlAttributesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
+
+	output, err := FindInstanceAccessControlAttributes(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSO Instance Access Control Attributes (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSO Instance Access Control Attributes (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("attribute", flattenAccessControlAttributes(output.InstanceAccessControlAttributeConfiguration.AccessControlAttributes)); err != nil {
+		return fmt.Errorf("error setting attribute: %w", err)
+	}
+	d.Set("instance_arn", d.Id())
+	d.Set("status", output.Status)
+	d.Set("status_reason", output.StatusReason)
+
+	return nil
+}
+
+func resourceInstanceAccessControlAttributesUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
+
+	input := &ssoadmin.UpdateInstanceAccessControlAttributeConfigurationInput{
+		InstanceArn: aws.String(d.Id()),
+		InstanceAccessControlAttributeConfiguration: &ssoadmin.InstanceAccessControlAttributeConfiguration{
+			AccessControlAttributes: expandAccessControlAttributes(d.Get("attribute").(*schema.Set).List()),
+		},
+	}
+
+	_, err := conn.UpdateInstanceAccessControlAttributeConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating SSO Instance Access Control Attributes (%s): %w", d.Id(), err)
+	}
+
+	return resourceInstanceAccessControlAttributesRead(d, meta)
+}
+
+func resourceInstanceAccessControlAttributesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
+
+	input := &ssoadmin.DeleteInstanceAccessControlAttributeConfigurationInput{
+		InstanceArn: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteInstanceAccessControlAttributeConfiguration(input)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SSO Instance Access Control Attributes (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAccessControlAttributes(tfList []interface{}) []*ssoadmin.AccessControlAttribute {
+	var apiObjects []*ssoadmin.AccessControlAttribute
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssoadmin.AccessControlAttribute{
+			Key:   aws.String(tfMap["key"].(string)),
+			Value: &ssoadmin.AccessControlAttributeValue{},
+		}
+
+		for _, vRaw := range tfMap["value"].(*schema.Set).List() {
+			if v, ok := vRaw.(map[string]interface{}); ok {
+				apiObject.Value.Source = flex.ExpandStringSet(v["source"].(*schema.Set))
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAccessControlAttributes(apiObjects []*ssoadmin.AccessControlAttribute) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"key": aws.StringValue(apiObject.Key),
+		}
+
+		if v := apiObject.Value; v != nil {
+			tfMap["value"] = []interface{}{
+				map[string]interface{}{
+					"source": flex.FlattenStringSet(v.Source),
+				},
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,139 @@
+package ssoadmin_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// An SSO instance has at most one access control attribute configuration, so these tests must not run in parallel.
+
+func TestAccSSOAdminInstanceAccessControlAttributes_basic(t *testing.T) {
+	resourceName := "aws_ssoadmin_instance_access_control_attributes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceAccessControlAttributesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAccessControlAttributesConfig("name", "$${path:name.givenName}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceAccessControlAttributesExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute.*", map[string]string{
+						"key":     "name",
+						"value.#": "1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "status", ssoadmin.InstanceAccessControlAttributeConfigurationStatusEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstanceAccessControlAttributesConfig("email", "$${path:emails[primary eq true].value}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceAccessControlAttributesExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute.*", map[string]string{
+						"key":     "email",
+						"value.#": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminInstanceAccessControlAttributes_disappears(t *testing.T) {
+	resourceName := "aws_ssoadmin_instance_access_control_attributes.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceAccessControlAttributesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAccessControlAttributesConfig("name", "$${path:name.givenName}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceAccessControlAttributesExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssoadmin.ResourceInstanceAccessControlAttributes(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceAccessControlAttributesDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_instance_access_control_attributes" {
+			continue
+		}
+
+		_, err := tfssoadmin.FindInstanceAccessControlAttributes(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSO Instance Access Control Attributes (%s) still exist", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckInstanceAccessControlAttributesExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource (%s) ID not set", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn()
+
+		_, err := tfssoadmin.FindInstanceAccessControlAttributes(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccInstanceAccessControlAttributesConfig(key, source string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_instance_access_control_attributes" "test" {
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+
+  attribute {
+    key = %[1]q
+
+    value {
+      source = [%[2]q]
+    }
+  }
+}
+`, key, source)
+}
@@ -47,7 +47,7 @@ func ResourcePermissionSetInlinePolicy() *schema.Resource {
 }
 
 func resourcePermissionSetInlinePolicyPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSOAdminConn
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
 
 	instanceArn := d.Get("instance_arn").(string)
 	permissionSetArn := d.Get("permission_set_arn").(string)
@@ -74,7 +74,7 @@ func resourcePermissionSetInlinePolicyPut(d *schema.ResourceData, meta interface
 }
 
 func resourcePermissionSetInlinePolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSOAdminConn
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
 
 	permissionSetArn, instanceArn, err := ParseResourceID(d.Id())
 	if err != nil {
@@ -110,7 +110,7 @@ func resourcePermissionSetInlinePolicyRead(d *schema.ResourceData, meta interfac
 }
 
 func resourcePermissionSetInlinePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SSOAdminConn
+	conn := meta.(*conns.AWSClient).SSOAdminConn()
 
 	permissionSetArn, instanceArn, err := ParseResourceID(d.Id())
 	if err != nil {
@@ -204,6 +204,11 @@ func ResourceGateway() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"smb_gateway_admins": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"average_download_rate_limit_in_bits_per_sec": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -243,7 +248,7 @@ func ResourceGateway() *schema.Resource {
 }
 
 func resourceGatewayCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).StorageGatewayConn
+	conn := meta.(*conns.AWSClient).StorageGatewayConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	region := meta.(*conns.AWSClient).Region
@@ -420,6 +425,21 @@ func resourceGatewayCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if v, ok := d.GetOk("smb_gateway_admins"); ok && v.(*schema.Set).Len() > 0 {
+		input := &storagegateway.UpdateSMBLocalGroupsInput{
+			GatewayARN: aws.String(d.Id()),
+			SMBLocalGroups: &storagegateway.SMBLocalGroups{
+				GatewayAdmins: flex.ExpandStringSet(v.(*schema.Set)),
+			},
+		}
+
+		log.Printf("[DEBUG] Storage Gateway Gateway %q setting SMB Local Groups", input)
+		_, err := conn.UpdateSMBLocalGroups(input)
+		if err != nil {
+			return fmt.Errorf("error updating Storage Gateway Gateway (%s) SMB local groups: %w", d.Id(), err)
+		}
+	}
+
 	bandwidthInput := &storagegateway.UpdateBandwidthRateLimitInput{
 		GatewayARN: aws.String(d.Id()),
 	}
@@ -444,7 +464,7 @@ func resourceGatewayCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).StorageGatewayConn
+	conn := meta.(*conns.AWSClient).StorageGatewayConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -468,7 +488,7 @@ func resourceGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -564,6 +584,14 @@ func resourceGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("cloudwatch_log_group_arn", output.CloudWatchLogGroupARN)
 	d.Set("smb_security_strategy", smbSettingsOutput.SMBSecurityStrategy)
 	d.Set("smb_file_share_visibility", smbSettingsOutput.FileSharesVisible)
+
+	var gatewayAdmins []*string
+	if smbSettingsOutput.SMBLocalGroups != nil {
+		gatewayAdmins = smbSettingsOutput.SMBLocalGroups.GatewayAdmins
+	}
+	if err := d.Set("smb_gateway_admins", aws.StringValueSlice(gatewayAdmins)); err != nil {
+		return fmt.Errorf("error setting smb_gateway_admins: %w", err)
+	}
 	d.Set("ec2_instance_id", output.Ec2InstanceId)
 	d.Set("endpoint_type", output.EndpointType)
 	d.Set("host_environment", output.HostEnvironment)
@@ -591,7 +619,7 @@ func resourceGatewayRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).StorageGatewayConn
+	conn := meta.(*conns.AWSClient).StorageGatewayConn()
 
 	if d.HasChanges("gateway_name", "gateway_timezone", "cloudwatch_log_group_arn") {
 		input := &storagegateway.UpdateGatewayInformationInput{
@@ -667,6 +695,21 @@ func resourceGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("smb_gateway_admins") {
+		input := &storagegateway.UpdateSMBLocalGroupsInput{
+			GatewayARN: aws.String(d.Id()),
+			SMBLocalGroups: &storagegateway.SMBLocalGroups{
+				GatewayAdmins: flex.ExpandStringSet(d.Get("smb_gateway_admins").(*schema.Set)),
+			},
+		}
+
+		log.Printf("[DEBUG] Storage Gateway Gateway %q updating SMB Local Groups", input)
+		_, err := conn.UpdateSMBLocalGroups(input)
+		if err != nil {
+			return fmt.Errorf("error updating Storage Gateway Gateway (%s) SMB local groups: %w", d.Id(), err)
+		}
+	}
+
 	if d.HasChanges("average_download_rate_limit_in_bits_per_sec",
 		"average_upload_rate_limit_in_bits_per_sec") {
 
@@ -721,7 +764,7 @@ func resourceGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceGatewayDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).StorageGatewayConn
+	conn := meta.(*conns.AWSClient).StorageGatewayConn()
 
 	input := &storagegateway.DeleteGatewayInput{
 		GatewayARN: aws.String(d.Id()),
@@ -635,6 +635,45 @@ func TestAccStorageGatewayGateway_smbVisibility(t *testing.T) {
 	})
 }
 
+func TestAccStorageGatewayGateway_smbGatewayAdmins(t *testing.T) {
+	var gateway storagegateway.DescribeGatewayInformationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_storagegateway_gateway.test"
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, storagegateway.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewaySMBGatewayAdmins1Config(rName, domainName, "Administrator"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "smb_gateway_admins.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "smb_gateway_admins.*", "Administrator"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_key", "gateway_ip_address", "smb_active_directory_settings"},
+			},
+			{
+				Config: testAccGatewaySMBGatewayAdmins2Config(rName, domainName, "Administrator", "Admin"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "smb_gateway_admins.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "smb_gateway_admins.*", "Administrator"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "smb_gateway_admins.*", "Admin"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccStorageGatewayGateway_disappears(t *testing.T) {
 	var gateway storagegateway.DescribeGatewayInformationOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -788,7 +827,7 @@ func TestAccStorageGatewayGateway_bandwidthAll(t *testing.T) {
 }
 
 func testAccCheckGatewayDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).StorageGatewayConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).StorageGatewayConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_storagegateway_gateway" {
@@ -820,7 +859,7 @@ func testAccCheckGatewayExists(resourceName string, gateway *storagegateway.Desc
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).StorageGatewayConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).StorageGatewayConn()
 		input := &storagegateway.DescribeGatewayInformationInput{
 			GatewayARN: aws.String(rs.Primary.ID),
 		}
@@ -1320,6 +1359,48 @@ resource "aws_storagegateway_gateway" "test" {
 `, rName, visible)
 }
 
+func testAccGatewaySMBGatewayAdmins1Config(rName, domainName, admin1 string) string {
+	return acctest.ConfigCompose(
+		testAccGatewaySMBActiveDirectorySettingsBaseConfig(rName),
+		testAccGatewayConfig_DirectoryServiceSimpleDirectory(rName, domainName),
+		fmt.Sprintf(`
+resource "aws_storagegateway_gateway" "test" {
+  gateway_ip_address = aws_instance.test.public_ip
+  gateway_name       = %[1]q
+  gateway_timezone   = "GMT"
+  gateway_type       = "FILE_S3"
+  smb_gateway_admins = [%[2]q]
+
+  smb_active_directory_settings {
+    domain_name = aws_directory_service_directory.test.name
+    password    = aws_directory_service_directory.test.password
+    username    = "Administrator"
+  }
+}
+`, rName, admin1))
+}
+
+func testAccGatewaySMBGatewayAdmins2Config(rName, domainName, admin1, admin2 string) string {
+	return acctest.ConfigCompose(
+		testAccGatewaySMBActiveDirectorySettingsBaseConfig(rName),
+		testAccGatewayConfig_DirectoryServiceSimpleDirectory(rName, domainName),
+		fmt.Sprintf(`
+resource "aws_storagegateway_gateway" "test" {
+  gateway_ip_address = aws_instance.test.public_ip
+  gateway_name       = %[1]q
+  gateway_timezone   = "GMT"
+  gateway_type       = "FILE_S3"
+  smb_gateway_admins = [%[2]q, %[3]q]
+
+  smb_active_directory_settings {
+    domain_name = aws_directory_service_directory.test.name
+    password    = aws_directory_service_directory.test.password
+    username    = "Administrator"
+  }
+}
+`, rName, admin1, admin2))
+}
+
 func testAccGatewayTags1Config(rName, tagKey1, tagValue1 string) string {
 	return testAcc_TapeAndVolumeGatewayBase(rName) + fmt.Sprintf(`
 resource "aws_storagegateway_gateway" "test" {
@@ -188,7 +188,7 @@ func TestAccSWFDomain_description(t *testing.T) {
 }
 
 func testAccCheckDomainDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SWFConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SWFConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_swf_domain" {
@@ -225,7 +225,7 @@ func testAccCheckDomainExists(n string) resource.TestCheckFunc {
 		}
 
 		name := rs.Primary.ID
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SWFConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SWFConn()
 
 		input := &swf.DescribeDomainInput{
 			Name: aws.String(name),
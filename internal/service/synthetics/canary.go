@@ -40,6 +40,34 @@ func ResourceCanary() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"artifact_config": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_encryption": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encryption_mode": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(synthetics.EncryptionMode_Values(), false),
+									},
+									"kms_key_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"artifact_s3_location": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -226,7 +254,7 @@ func ResourceCanary() *schema.Resource {
 }
 
 func resourceCanaryCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SyntheticsConn
+	conn := meta.(*conns.AWSClient).SyntheticsConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -246,6 +274,10 @@ func resourceCanaryCreate(d *schema.ResourceData, meta interface{}) error {
 
 	input.Code = code
 
+	if v, ok := d.GetOk("artifact_config"); ok {
+		input.ArtifactConfig = expandCanaryArtifactConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("run_config"); ok {
 		input.RunConfig = expandCanaryRunConfig(v.([]interface{}))
 	}
@@ -315,7 +347,7 @@ func resourceCanaryCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCanaryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SyntheticsConn
+	conn := meta.(*conns.AWSClient).SyntheticsConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -339,6 +371,11 @@ func resourceCanaryRead(d *schema.ResourceData, meta interface{}) error {
 		Resource:  fmt.Sprintf("canary:%s", aws.StringValue(canary.Name)),
 	}.String()
 	d.Set("arn", canaryArn)
+
+	if err := d.Set("artifact_config", flattenCanaryArtifactConfig(canary.ArtifactConfig)); err != nil {
+		return fmt.Errorf("error setting artifact_config: %w", err)
+	}
+
 	d.Set("artifact_s3_location", canary.ArtifactS3Location)
 	d.Set("engine_arn", canary.EngineArn)
 	d.Set("execution_role_arn", canary.ExecutionRoleArn)
@@ -369,7 +406,7 @@ func resourceCanaryRead(d *schema.ResourceData, meta interface{}) error {
 	tags := KeyValueTags(canary.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -381,13 +418,17 @@ func resourceCanaryRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCanaryUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SyntheticsConn
+	conn := meta.(*conns.AWSClient).SyntheticsConn()
 
 	if d.HasChangesExcept("tags", "tags_all", "start_canary") {
 		input := &synthetics.UpdateCanaryInput{
 			Name: aws.String(d.Id()),
 		}
 
+		if d.HasChange("artifact_config") {
+			input.ArtifactConfig = expandCanaryArtifactConfig(d.Get("artifact_config").([]interface{}))
+		}
+
 		if d.HasChange("vpc_config") {
 			input.VpcConfig = expandCanaryVPCConfig(d.Get("vpc_config").([]interface{}))
 		}
@@ -487,7 +528,7 @@ func resourceCanaryUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceCanaryDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).SyntheticsConn
+	conn := meta.(*conns.AWSClient).SyntheticsConn()
 
 	if status := d.Get("status").(string); status == synthetics.CanaryStateRunning {
 		if err := syntheticsStopCanary(d.Id(), conn); err != nil {
@@ -542,6 +583,50 @@ func expandCanaryCode(d *schema.ResourceData) (*synthetics.CanaryCodeInput, erro
 	return codeConfig, nil
 }
 
+func expandCanaryArtifactConfig(l []interface{}) *synthetics.ArtifactConfigInput_ {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &synthetics.ArtifactConfigInput_{}
+
+	if v, ok := m["s3_encryption"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		encryptionConfig := &synthetics.S3EncryptionConfig{}
+
+		if v, ok := tfMap["encryption_mode"].(string); ok && v != "" {
+			encryptionConfig.EncryptionMode = aws.String(v)
+		}
+
+		if v, ok := tfMap["kms_key_arn"].(string); ok && v != "" {
+			encryptionConfig.KmsKeyArn = aws.String(v)
+		}
+
+		config.S3Encryption = encryptionConfig
+	}
+
+	return config
+}
+
+func flattenCanaryArtifactConfig(artifactConfig *synthetics.ArtifactConfigOutput_) []interface{} {
+	if artifactConfig == nil || artifactConfig.S3Encryption == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"s3_encryption": []interface{}{
+			map[string]interface{}{
+				"encryption_mode": aws.StringValue(artifactConfig.S3Encryption.EncryptionMode),
+				"kms_key_arn":     aws.StringValue(artifactConfig.S3Encryption.KmsKeyArn),
+			},
+		},
+	}
+
+	return []interface{}{m}
+}
+
 func expandCanarySchedule(l []interface{}) *synthetics.CanaryScheduleInput {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -348,6 +348,47 @@ func TestAccSyntheticsCanary_runTracing(t *testing.T) {
 	})
 }
 
+func TestAccSyntheticsCanary_artifactEncryption(t *testing.T) {
+	var conf synthetics.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, synthetics.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCanaryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCanaryArtifactEncryptionConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "artifact_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "artifact_config.0.s3_encryption.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "artifact_config.0.s3_encryption.0.encryption_mode", synthetics.EncryptionModeSseS3),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary"},
+			},
+			{
+				Config: testAccCanaryArtifactEncryptionKMSConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "artifact_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "artifact_config.0.s3_encryption.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "artifact_config.0.s3_encryption.0.encryption_mode", synthetics.EncryptionModeSseKms),
+					resource.TestCheckResourceAttrPair(resourceName, "artifact_config.0.s3_encryption.0.kms_key_arn", kmsKeyResourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSyntheticsCanary_vpc(t *testing.T) {
 	var conf synthetics.Canary
 	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
@@ -467,7 +508,7 @@ func TestAccSyntheticsCanary_disappears(t *testing.T) {
 }
 
 func testAccCheckCanaryDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).SyntheticsConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SyntheticsConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_synthetics_canary" {
@@ -501,7 +542,7 @@ func testAccCheckCanaryExists(n string, canary *synthetics.Canary) resource.Test
 			return fmt.Errorf("No Synthetics Canary ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).SyntheticsConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SyntheticsConn()
 
 		output, err := tfsynthetics.FindCanaryByName(conn, rs.Primary.ID)
 
@@ -526,7 +567,7 @@ func testAccCheckCanaryDeleteImplicitResources(n string) resource.TestCheckFunc
 			return fmt.Errorf("synthetics Canary name not set")
 		}
 
-		lambdaConn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+		lambdaConn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn()
 
 		layerArn := rs.Primary.Attributes["source_location_arn"]
 		layerArnObj, err := arn.Parse(layerArn)
@@ -747,6 +788,58 @@ resource "aws_synthetics_canary" "test" {
 `, rName, tracing))
 }
 
+func testAccCanaryArtifactEncryptionConfig(rName string) string {
+	return acctest.ConfigCompose(testAccCanaryBaseConfig(rName), fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/lambdatest.zip"
+  runtime_version      = "syn-nodejs-puppeteer-3.2"
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  artifact_config {
+    s3_encryption {
+      encryption_mode = "SSE_S3"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccCanaryArtifactEncryptionKMSConfig(rName string) string {
+	return acctest.ConfigCompose(testAccCanaryBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/lambdatest.zip"
+  runtime_version      = "syn-nodejs-puppeteer-3.2"
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  artifact_config {
+    s3_encryption {
+      encryption_mode = "SSE_KMS"
+      kms_key_arn     = aws_kms_key.test.arn
+    }
+  }
+}
+`, rName))
+}
+
 func testAccCanaryBasicConfig(rName string) string {
 	return acctest.ConfigCompose(testAccCanaryBaseConfig(rName), fmt.Sprintf(`
 resource "aws_synthetics_canary" "test" {
@@ -187,12 +187,40 @@ func ResourceServer() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+
+			"workflow_details": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"on_upload": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"execution_role": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"workflow_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func resourceServerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).TransferConn
+	conn := meta.(*conns.AWSClient).TransferConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
@@ -265,6 +293,10 @@ func resourceServerCreate(d *schema.ResourceData, meta interface{}) error {
 		input.IdentityProviderDetails.Url = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("workflow_details"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.WorkflowDetails = expandTransferWorkflowDetails(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -310,7 +342,7 @@ func resourceServerCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceServerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).TransferConn
+	conn := meta.(*conns.AWSClient).TransferConn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -341,7 +373,7 @@ func resourceServerRead(d *schema.ResourceData, meta interface{}) error {
 		// Security Group IDs are not returned for VPC endpoints.
 		if aws.StringValue(output.EndpointType) == transfer.EndpointTypeVpc && len(output.EndpointDetails.SecurityGroupIds) == 0 {
 			vpcEndpointID := aws.StringValue(output.EndpointDetails.VpcEndpointId)
-			output, err := tfec2.FindVPCEndpointByID(meta.(*conns.AWSClient).EC2Conn, vpcEndpointID)
+			output, err := tfec2.FindVPCEndpointByID(meta.(*conns.AWSClient).EC2Conn(), vpcEndpointID)
 
 			if err != nil {
 				return fmt.Errorf("error reading Transfer Server (%s) VPC Endpoint (%s): %w", d.Id(), vpcEndpointID, err)
@@ -374,11 +406,14 @@ func resourceServerRead(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		d.Set("url", "")
 	}
+	if err := d.Set("workflow_details", flattenTransferWorkflowDetails(output.WorkflowDetails)); err != nil {
+		return fmt.Errorf("error setting workflow_details: %w", err)
+	}
 
 	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -390,7 +425,7 @@ func resourceServerRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceServerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).TransferConn
+	conn := meta.(*conns.AWSClient).TransferConn()
 
 	if d.HasChangesExcept("tags", "tags_all") {
 		var newEndpointTypeVpc bool
@@ -465,7 +500,7 @@ func resourceServerUpdate(d *schema.ResourceData, meta interface{}) error {
 			// You can edit the SecurityGroupIds property in the UpdateServer API only if you are changing the EndpointType from PUBLIC or VPC_ENDPOINT to VPC.
 			// To change security groups associated with your server's VPC endpoint after creation, use the Amazon EC2 ModifyVpcEndpoint API.
 			if d.HasChange("endpoint_details.0.security_group_ids") && newEndpointTypeVpc && oldEndpointTypeVpc {
-				conn := meta.(*conns.AWSClient).EC2Conn
+				conn := meta.(*conns.AWSClient).EC2Conn()
 
 				vpcEndpointID := d.Get("endpoint_details.0.vpc_endpoint_id").(string)
 				input := &ec2.ModifyVpcEndpointInput{
@@ -538,6 +573,19 @@ func resourceServerUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.SecurityPolicyName = aws.String(d.Get("security_policy_name").(string))
 		}
 
+		if d.HasChange("workflow_details") {
+			if v, ok := d.GetOk("workflow_details"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.WorkflowDetails = expandTransferWorkflowDetails(v.([]interface{})[0].(map[string]interface{}))
+			}
+
+			// Workflow details are removed by sending an empty OnUpload list.
+			if input.WorkflowDetails == nil || input.WorkflowDetails.OnUpload == nil {
+				input.WorkflowDetails = &transfer.WorkflowDetails{
+					OnUpload: []*transfer.WorkflowDetail{},
+				}
+			}
+		}
+
 		if offlineUpdate {
 			if err := stopTransferServer(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return err
@@ -595,7 +643,7 @@ func resourceServerUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceServerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).TransferConn
+	conn := meta.(*conns.AWSClient).TransferConn()
 
 	if d.Get("force_destroy").(bool) && d.Get("identity_provider_type").(string) == transfer.IdentityProviderTypeServiceManaged {
 		input := &transfer.ListUsersInput{
@@ -717,6 +765,50 @@ func flattenTransferEndpointDetails(apiObject *transfer.EndpointDetails, securit
 	return tfMap
 }
 
+func expandTransferWorkflowDetails(tfMap map[string]interface{}) *transfer.WorkflowDetails {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &transfer.WorkflowDetails{}
+
+	if v, ok := tfMap["on_upload"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.OnUpload = append(apiObject.OnUpload, &transfer.WorkflowDetail{
+				ExecutionRole: aws.String(tfMap["execution_role"].(string)),
+				WorkflowId:    aws.String(tfMap["workflow_id"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenTransferWorkflowDetails(apiObject *transfer.WorkflowDetails) []interface{} {
+	if apiObject == nil || len(apiObject.OnUpload) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.OnUpload {
+		tfList = append(tfList, map[string]interface{}{
+			"execution_role": aws.StringValue(apiObject.ExecutionRole),
+			"workflow_id":    aws.StringValue(apiObject.WorkflowId),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"on_upload": tfList,
+	}}
+}
+
 func stopTransferServer(conn *transfer.Transfer, serverID string, timeout time.Duration) error {
 	input := &transfer.StopServerInput{
 		ServerId: aws.String(serverID),
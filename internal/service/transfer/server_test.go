@@ -910,6 +910,44 @@ func testAccServer_vpcEndpointID(t *testing.T) {
 	})
 }
 
+func testAccServer_workflowDetails(t *testing.T) {
+	var conf transfer.DescribedServer
+	resourceName := "aws_transfer_server.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transfer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerWorkflowDetailsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "workflow_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "workflow_details.0.on_upload.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "workflow_details.0.on_upload.0.execution_role", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "workflow_details.0.on_upload.0.workflow_id", "aws_transfer_workflow.test", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+			{
+				Config: testAccServerWorkflowDetailsRemovedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "workflow_details.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckServerExists(n string, v *transfer.DescribedServer) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -921,7 +959,7 @@ func testAccCheckServerExists(n string, v *transfer.DescribedServer) resource.Te
 			return fmt.Errorf("No Transfer Server ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn()
 
 		output, err := tftransfer.FindServerByID(conn, rs.Primary.ID)
 
@@ -936,7 +974,7 @@ func testAccCheckServerExists(n string, v *transfer.DescribedServer) resource.Te
 }
 
 func testAccCheckServerDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_transfer_server" {
@@ -960,7 +998,7 @@ func testAccCheckServerDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheck(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn()
 
 	input := &transfer.ListServersInput{}
 
@@ -1546,3 +1584,42 @@ resource "aws_transfer_server" "test" {
 }
 `, rName))
 }
+
+func testAccServerBaseWorkflowConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccServerBaseLoggingRoleConfig(rName),
+		fmt.Sprintf(`
+resource "aws_transfer_workflow" "test" {
+  steps {
+    delete_step_details {
+      name = %[1]q
+    }
+
+    type = "DELETE"
+  }
+}
+`, rName))
+}
+
+func testAccServerWorkflowDetailsConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccServerBaseWorkflowConfig(rName),
+		`
+resource "aws_transfer_server" "test" {
+  workflow_details {
+    on_upload {
+      execution_role = aws_iam_role.test.arn
+      workflow_id    = aws_transfer_workflow.test.id
+    }
+  }
+}
+`)
+}
+
+func testAccServerWorkflowDetailsRemovedConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccServerBaseWorkflowConfig(rName),
+		`
+resource "aws_transfer_server" "test" {}
+`)
+}
@@ -19,6 +19,14 @@ func init() {
 		Name: "aws_transfer_server",
 		F:    sweepServers,
 	})
+
+	resource.AddTestSweepers("aws_transfer_workflow", &resource.Sweeper{
+		Name: "aws_transfer_workflow",
+		F:    sweepWorkflows,
+		Dependencies: []string{
+			"aws_transfer_server",
+		},
+	})
 }
 
 func sweepServers(region string) error {
@@ -26,7 +34,7 @@ func sweepServers(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).TransferConn
+	conn := client.(*conns.AWSClient).TransferConn()
 	input := &transfer.ListServersInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
@@ -65,3 +73,46 @@ func sweepServers(region string) error {
 
 	return nil
 }
+
+func sweepWorkflows(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).TransferConn()
+	input := &transfer.ListWorkflowsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListWorkflowsPages(input, func(page *transfer.ListWorkflowsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, workflow := range page.Workflows {
+			r := ResourceWorkflow()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(workflow.WorkflowId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Transfer Workflow sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing Transfer Workflows (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping Transfer Workflows (%s): %w", region, err)
+	}
+
+	return nil
+}
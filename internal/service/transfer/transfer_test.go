@@ -34,6 +34,7 @@ func TestAccTransfer_serial(t *testing.T) {
 			"VPCAddressAllocationIDsSecurityGroupIDs":                testAccServer_vpcAddressAllocationIds_securityGroupIDs,
 			"VPCEndpointID":                                          testAccServer_vpcEndpointID,
 			"VPCSecurityGroupIDs":                                    testAccServer_vpcSecurityGroupIDs,
+			"WorkflowDetails":                                        testAccServer_workflowDetails,
 		},
 		"SSHKey": {
 			"basic": testAccSSHKey_basic,
@@ -0,0 +1,652 @@
+package transfer
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/transfer"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceWorkflow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWorkflowCreate,
+		Read:   resourceWorkflowRead,
+		Update: resourceWorkflowUpdate,
+		Delete: resourceWorkflowDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"on_exception_steps": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 8,
+				Elem:     workflowStepSchema(),
+			},
+			"steps": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 8,
+				Elem:     workflowStepSchema(),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func workflowStepSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"copy_step_details": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_file_location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"efs_file_location": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"file_system_id": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"path": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(1, 65536),
+												},
+											},
+										},
+									},
+									"s3_file_location": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(3, 63),
+												},
+												"key": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.StringLenBetween(0, 1024),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 30),
+						},
+						"overwrite_existing": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      transfer.OverwriteExistingFalse,
+							ValidateFunc: validation.StringInSlice(transfer.OverwriteExisting_Values(), false),
+						},
+					},
+				},
+			},
+			"custom_step_details": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 30),
+						},
+						"target": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"timeout_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 1800),
+						},
+					},
+				},
+			},
+			"delete_step_details": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 30),
+						},
+					},
+				},
+			},
+			"tag_step_details": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 30),
+						},
+						"tags": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 10,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(0, 256),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(transfer.WorkflowStepType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceWorkflowCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TransferConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &transfer.CreateWorkflowInput{}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("on_exception_steps"); ok && len(v.([]interface{})) > 0 {
+		input.OnExceptionSteps = expandTransferWorkflowSteps(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("steps"); ok && len(v.([]interface{})) > 0 {
+		input.Steps = expandTransferWorkflowSteps(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Transfer Workflow: %s", input)
+	output, err := conn.CreateWorkflow(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Transfer Workflow: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.WorkflowId))
+
+	return resourceWorkflowRead(d, meta)
+}
+
+func resourceWorkflowRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TransferConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindWorkflowByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Transfer Workflow (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Transfer Workflow (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("description", output.Description)
+	if err := d.Set("on_exception_steps", flattenTransferWorkflowSteps(output.OnExceptionSteps)); err != nil {
+		return fmt.Errorf("error setting on_exception_steps: %w", err)
+	}
+	if err := d.Set("steps", flattenTransferWorkflowSteps(output.Steps)); err != nil {
+		return fmt.Errorf("error setting steps: %w", err)
+	}
+
+	tags := KeyValueTags(output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceWorkflowUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TransferConn()
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating tags: %w", err)
+		}
+	}
+
+	return resourceWorkflowRead(d, meta)
+}
+
+func resourceWorkflowDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).TransferConn()
+
+	log.Printf("[DEBUG] Deleting Transfer Workflow: (%s)", d.Id())
+	_, err := conn.DeleteWorkflow(&transfer.DeleteWorkflowInput{
+		WorkflowId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, transfer.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Transfer Workflow (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandTransferWorkflowSteps(tfList []interface{}) []*transfer.WorkflowStep {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*transfer.WorkflowStep
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &transfer.WorkflowStep{
+			Type: aws.String(tfMap["type"].(string)),
+		}
+
+		if v, ok := tfMap["copy_step_details"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.CopyStepDetails = expandTransferCopyStepDetails(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["custom_step_details"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.CustomStepDetails = expandTransferCustomStepDetails(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["delete_step_details"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.DeleteStepDetails = expandTransferDeleteStepDetails(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["tag_step_details"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.TagStepDetails = expandTransferTagStepDetails(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTransferWorkflowSteps(apiObjects []*transfer.WorkflowStep) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"type": aws.StringValue(apiObject.Type),
+		}
+
+		if apiObject.CopyStepDetails != nil {
+			tfMap["copy_step_details"] = flattenTransferCopyStepDetails(apiObject.CopyStepDetails)
+		}
+
+		if apiObject.CustomStepDetails != nil {
+			tfMap["custom_step_details"] = flattenTransferCustomStepDetails(apiObject.CustomStepDetails)
+		}
+
+		if apiObject.DeleteStepDetails != nil {
+			tfMap["delete_step_details"] = flattenTransferDeleteStepDetails(apiObject.DeleteStepDetails)
+		}
+
+		if apiObject.TagStepDetails != nil {
+			tfMap["tag_step_details"] = flattenTransferTagStepDetails(apiObject.TagStepDetails)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandTransferCopyStepDetails(tfMap map[string]interface{}) *transfer.CopyStepDetails {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &transfer.CopyStepDetails{}
+
+	if v, ok := tfMap["destination_file_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DestinationFileLocation = expandTransferInputFileLocation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["overwrite_existing"].(string); ok && v != "" {
+		apiObject.OverwriteExisting = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenTransferCopyStepDetails(apiObject *transfer.CopyStepDetails) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DestinationFileLocation; v != nil {
+		tfMap["destination_file_location"] = flattenTransferInputFileLocation(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.OverwriteExisting; v != nil {
+		tfMap["overwrite_existing"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandTransferInputFileLocation(tfMap map[string]interface{}) *transfer.InputFileLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &transfer.InputFileLocation{}
+
+	if v, ok := tfMap["efs_file_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		efsFileLocation := &transfer.EfsFileLocation{}
+
+		if v, ok := tfMap["file_system_id"].(string); ok && v != "" {
+			efsFileLocation.FileSystemId = aws.String(v)
+		}
+
+		if v, ok := tfMap["path"].(string); ok && v != "" {
+			efsFileLocation.Path = aws.String(v)
+		}
+
+		apiObject.EfsFileLocation = efsFileLocation
+	}
+
+	if v, ok := tfMap["s3_file_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		s3FileLocation := &transfer.S3InputFileLocation{}
+
+		if v, ok := tfMap["bucket"].(string); ok && v != "" {
+			s3FileLocation.Bucket = aws.String(v)
+		}
+
+		if v, ok := tfMap["key"].(string); ok && v != "" {
+			s3FileLocation.Key = aws.String(v)
+		}
+
+		apiObject.S3FileLocation = s3FileLocation
+	}
+
+	return apiObject
+}
+
+func flattenTransferInputFileLocation(apiObject *transfer.InputFileLocation) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.EfsFileLocation; v != nil {
+		tfMap["efs_file_location"] = []interface{}{map[string]interface{}{
+			"file_system_id": aws.StringValue(v.FileSystemId),
+			"path":           aws.StringValue(v.Path),
+		}}
+	}
+
+	if v := apiObject.S3FileLocation; v != nil {
+		tfMap["s3_file_location"] = []interface{}{map[string]interface{}{
+			"bucket": aws.StringValue(v.Bucket),
+			"key":    aws.StringValue(v.Key),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandTransferCustomStepDetails(tfMap map[string]interface{}) *transfer.CustomStepDetails {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &transfer.CustomStepDetails{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["target"].(string); ok && v != "" {
+		apiObject.Target = aws.String(v)
+	}
+
+	if v, ok := tfMap["timeout_seconds"].(int); ok && v > 0 {
+		apiObject.TimeoutSeconds = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenTransferCustomStepDetails(apiObject *transfer.CustomStepDetails) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Target; v != nil {
+		tfMap["target"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TimeoutSeconds; v != nil {
+		tfMap["timeout_seconds"] = aws.Int64Value(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandTransferDeleteStepDetails(tfMap map[string]interface{}) *transfer.DeleteStepDetails {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &transfer.DeleteStepDetails{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenTransferDeleteStepDetails(apiObject *transfer.DeleteStepDetails) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandTransferTagStepDetails(tfMap map[string]interface{}) *transfer.TagStepDetails {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &transfer.TagStepDetails{}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["tags"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.Tags = append(apiObject.Tags, &transfer.S3Tag{
+				Key:   aws.String(tfMap["key"].(string)),
+				Value: aws.String(tfMap["value"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenTransferTagStepDetails(apiObject *transfer.TagStepDetails) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Name; v != nil {
+		tfMap["name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Tags; len(v) > 0 {
+		var tfList []interface{}
+
+		for _, apiObject := range v {
+			tfList = append(tfList, map[string]interface{}{
+				"key":   aws.StringValue(apiObject.Key),
+				"value": aws.StringValue(apiObject.Value),
+			})
+		}
+
+		tfMap["tags"] = tfList
+	}
+
+	return []interface{}{tfMap}
+}
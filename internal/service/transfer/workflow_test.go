@@ -0,0 +1,348 @@
+package transfer_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/transfer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftransfer "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccTransferWorkflow_basic(t *testing.T) {
+	var conf transfer.DescribedWorkflow
+	resourceName := "aws_transfer_workflow.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transfer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(resourceName, &conf),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "transfer", regexp.MustCompile(`workflow/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "on_exception_steps.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "steps.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "steps.0.type", "DELETE"),
+					resource.TestCheckResourceAttr(resourceName, "steps.0.delete_step_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "steps.0.delete_step_details.0.name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTransferWorkflow_disappears(t *testing.T) {
+	var conf transfer.DescribedWorkflow
+	resourceName := "aws_transfer_workflow.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transfer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(resourceName, &conf),
+					acctest.CheckResourceDisappears(acctest.Provider, tftransfer.ResourceWorkflow(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTransferWorkflow_allSteps(t *testing.T) {
+	var conf transfer.DescribedWorkflow
+	resourceName := "aws_transfer_workflow.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transfer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowAllStepsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "description", "testing"),
+					resource.TestCheckResourceAttr(resourceName, "on_exception_steps.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on_exception_steps.0.type", "DELETE"),
+					resource.TestCheckResourceAttr(resourceName, "steps.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "steps.0.type", "COPY"),
+					resource.TestCheckResourceAttr(resourceName, "steps.0.copy_step_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "steps.0.copy_step_details.0.destination_file_location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "steps.0.copy_step_details.0.destination_file_location.0.s3_file_location.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "steps.0.copy_step_details.0.destination_file_location.0.s3_file_location.0.bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "steps.0.copy_step_details.0.destination_file_location.0.s3_file_location.0.key", "archive/"),
+					resource.TestCheckResourceAttr(resourceName, "steps.0.copy_step_details.0.overwrite_existing", "TRUE"),
+					resource.TestCheckResourceAttr(resourceName, "steps.1.type", "TAG"),
+					resource.TestCheckResourceAttr(resourceName, "steps.1.tag_step_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "steps.1.tag_step_details.0.tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "steps.1.tag_step_details.0.tags.0.key", "Name"),
+					resource.TestCheckResourceAttr(resourceName, "steps.1.tag_step_details.0.tags.0.value", "Archived"),
+					resource.TestCheckResourceAttr(resourceName, "steps.2.type", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "steps.2.custom_step_details.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "steps.2.custom_step_details.0.target", "aws_lambda_function.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "steps.2.custom_step_details.0.timeout_seconds", "1001"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccTransferWorkflow_tags(t *testing.T) {
+	var conf transfer.DescribedWorkflow
+	resourceName := "aws_transfer_workflow.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, transfer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkflowTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccWorkflowTags1Config(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWorkflowExists(n string, v *transfer.DescribedWorkflow) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Transfer Workflow ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn()
+
+		output, err := tftransfer.FindWorkflowByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckWorkflowDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TransferConn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_transfer_workflow" {
+			continue
+		}
+
+		_, err := tftransfer.FindWorkflowByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Transfer Workflow %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccWorkflowConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_workflow" "test" {
+  steps {
+    delete_step_details {
+      name = %[1]q
+    }
+
+    type = "DELETE"
+  }
+}
+`, rName)
+}
+
+func testAccWorkflowAllStepsConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {
+      "Service": "lambda.amazonaws.com"
+    },
+    "Action": "sts:AssumeRole"
+  }]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "index.handler"
+  runtime       = "nodejs12.x"
+}
+
+resource "aws_transfer_workflow" "test" {
+  description = "testing"
+
+  steps {
+    copy_step_details {
+      destination_file_location {
+        s3_file_location {
+          bucket = aws_s3_bucket.test.bucket
+          key    = "archive/"
+        }
+      }
+
+      name               = "copy"
+      overwrite_existing = "TRUE"
+    }
+
+    type = "COPY"
+  }
+
+  steps {
+    tag_step_details {
+      name = "tag"
+
+      tags {
+        key   = "Name"
+        value = "Archived"
+      }
+    }
+
+    type = "TAG"
+  }
+
+  steps {
+    custom_step_details {
+      name            = "custom"
+      target          = aws_lambda_function.test.arn
+      timeout_seconds = 1001
+    }
+
+    type = "CUSTOM"
+  }
+
+  on_exception_steps {
+    delete_step_details {
+      name = "delete"
+    }
+
+    type = "DELETE"
+  }
+}
+`, rName)
+}
+
+func testAccWorkflowTags1Config(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_workflow" "test" {
+  steps {
+    delete_step_details {
+      name = %[1]q
+    }
+
+    type = "DELETE"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccWorkflowTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_workflow" "test" {
+  steps {
+    delete_step_details {
+      name = %[1]q
+    }
+
+    type = "DELETE"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -71,7 +71,7 @@ func ResourceRegexMatchSet() *schema.Resource {
 }
 
 func resourceRegexMatchSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).WAFRegionalConn
+	conn := meta.(*conns.AWSClient).WAFRegionalConn()
 	region := meta.(*conns.AWSClient).Region
 
 	log.Printf("[INFO] Creating WAF Regional Regex Match Set: %s", d.Get("name").(string))
@@ -95,7 +95,7 @@ func resourceRegexMatchSetCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceRegexMatchSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).WAFRegionalConn
+	conn := meta.(*conns.AWSClient).WAFRegionalConn()
 
 	log.Printf("[INFO] Reading WAF Regional Regex Match Set: %s", d.Get("name").(string))
 	set, err := FindRegexMatchSetByID(conn, d.Id())
@@ -115,7 +115,7 @@ func resourceRegexMatchSetRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceRegexMatchSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).WAFRegionalConn
+	conn := meta.(*conns.AWSClient).WAFRegionalConn()
 	region := meta.(*conns.AWSClient).Region
 
 	log.Printf("[INFO] Updating WAF Regional Regex Match Set: %s", d.Get("name").(string))
@@ -138,7 +138,7 @@ func resourceRegexMatchSetUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceRegexMatchSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).WAFRegionalConn
+	conn := meta.(*conns.AWSClient).WAFRegionalConn()
 	region := meta.(*conns.AWSClient).Region
 
 	err := DeleteRegexMatchSetResource(conn, region, "global", d.Id(), getRegexMatchTuplesFromResourceData(d))
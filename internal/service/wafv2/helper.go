@@ -289,6 +289,7 @@ func wafv2FieldToMatchBaseSchema() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"all_query_arguments": wafv2EmptySchema(),
 			"body":                wafv2EmptySchema(),
+			"json_body":           wafv2JsonBodySchema(),
 			"method":              wafv2EmptySchema(),
 			"query_string":        wafv2EmptySchema(),
 			"single_header": {
@@ -343,6 +344,50 @@ func wafv2FieldToMatchSchema() *schema.Schema {
 	}
 }
 
+func wafv2JsonBodySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"invalid_fallback_behavior": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(wafv2.BodyParsingFallbackBehavior_Values(), false),
+				},
+				"match_pattern": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"all": wafv2EmptySchema(),
+							"included_paths": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MinItems: 1,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+									ValidateFunc: validation.All(
+										validation.StringLenBetween(1, 512),
+										validation.StringMatch(regexp.MustCompile(`^([/].*)?$`), "must be empty or begin with a forward slash"),
+									),
+								},
+							},
+						},
+					},
+				},
+				"match_scope": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(wafv2.JsonMatchScope_Values(), false),
+				},
+			},
+		},
+	}
+}
+
 func wafv2ForwardedIPConfig() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -836,6 +881,10 @@ func expandWafv2FieldToMatch(l []interface{}) *wafv2.FieldToMatch {
 		f.Body = &wafv2.Body{}
 	}
 
+	if v, ok := m["json_body"]; ok && len(v.([]interface{})) > 0 {
+		f.JsonBody = expandWafv2JsonBody(v.([]interface{}))
+	}
+
 	if v, ok := m["method"]; ok && len(v.([]interface{})) > 0 {
 		f.Method = &wafv2.Method{}
 	}
@@ -859,6 +908,44 @@ func expandWafv2FieldToMatch(l []interface{}) *wafv2.FieldToMatch {
 	return f
 }
 
+func expandWafv2JsonBody(l []interface{}) *wafv2.JsonBody {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	jsonBody := &wafv2.JsonBody{
+		MatchPattern: expandWafv2JsonMatchPattern(m["match_pattern"].([]interface{})),
+		MatchScope:   aws.String(m["match_scope"].(string)),
+	}
+
+	if v, ok := m["invalid_fallback_behavior"].(string); ok && v != "" {
+		jsonBody.InvalidFallbackBehavior = aws.String(v)
+	}
+
+	return jsonBody
+}
+
+func expandWafv2JsonMatchPattern(l []interface{}) *wafv2.JsonMatchPattern {
+	if len(l) == 0 || l[0] == nil {
+		return &wafv2.JsonMatchPattern{}
+	}
+
+	m := l[0].(map[string]interface{})
+	jsonMatchPattern := &wafv2.JsonMatchPattern{}
+
+	if v, ok := m["all"].([]interface{}); ok && len(v) > 0 {
+		jsonMatchPattern.All = &wafv2.All{}
+	}
+
+	if v, ok := m["included_paths"].([]interface{}); ok && len(v) > 0 {
+		jsonMatchPattern.IncludedPaths = flex.ExpandStringList(v)
+	}
+
+	return jsonMatchPattern
+}
+
 func expandWafv2ForwardedIPConfig(l []interface{}) *wafv2.ForwardedIPConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -1293,6 +1380,10 @@ func flattenWafv2FieldToMatch(f *wafv2.FieldToMatch) interface{} {
 		m["body"] = make([]map[string]interface{}, 1)
 	}
 
+	if f.JsonBody != nil {
+		m["json_body"] = flattenWafv2JsonBody(f.JsonBody)
+	}
+
 	if f.Method != nil {
 		m["method"] = make([]map[string]interface{}, 1)
 	}
@@ -1316,6 +1407,38 @@ func flattenWafv2FieldToMatch(f *wafv2.FieldToMatch) interface{} {
 	return []interface{}{m}
 }
 
+func flattenWafv2JsonBody(b *wafv2.JsonBody) interface{} {
+	if b == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"invalid_fallback_behavior": aws.StringValue(b.InvalidFallbackBehavior),
+		"match_pattern":             flattenWafv2JsonMatchPattern(b.MatchPattern),
+		"match_scope":               aws.StringValue(b.MatchScope),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenWafv2JsonMatchPattern(p *wafv2.JsonMatchPattern) interface{} {
+	if p == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if p.All != nil {
+		m["all"] = make([]map[string]interface{}, 1)
+	}
+
+	if p.IncludedPaths != nil {
+		m["included_paths"] = flex.FlattenStringList(p.IncludedPaths)
+	}
+
+	return []interface{}{m}
+}
+
 func flattenWafv2ForwardedIPConfig(f *wafv2.ForwardedIPConfig) interface{} {
 	if f == nil {
 		return []interface{}{}
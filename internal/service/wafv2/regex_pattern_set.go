@@ -92,7 +92,7 @@ func ResourceRegexPatternSet() *schema.Resource {
 }
 
 func resourceRegexPatternSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).WAFV2Conn
+	conn := meta.(*conns.AWSClient).WAFV2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 	params := &wafv2.CreateRegexPatternSetInput{
@@ -129,7 +129,7 @@ func resourceRegexPatternSetCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceRegexPatternSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).WAFV2Conn
+	conn := meta.(*conns.AWSClient).WAFV2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 	params := &wafv2.GetRegexPatternSetInput{
@@ -169,7 +169,7 @@ func resourceRegexPatternSetRead(d *schema.ResourceData, meta interface{}) error
 	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+	if err := d.Set("tags", tags.ResolveDuplicates(defaultTagsConfig, d.Get("tags").(map[string]interface{})).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %w", err)
 	}
 
@@ -181,7 +181,7 @@ func resourceRegexPatternSetRead(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceRegexPatternSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).WAFV2Conn
+	conn := meta.(*conns.AWSClient).WAFV2Conn()
 
 	log.Printf("[INFO] Updating WAFv2 RegexPatternSet %s", d.Id())
 
@@ -218,7 +218,7 @@ func resourceRegexPatternSetUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceRegexPatternSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).WAFV2Conn
+	conn := meta.(*conns.AWSClient).WAFV2Conn()
 
 	log.Printf("[INFO] Deleting WAFv2 RegexPatternSet %s", d.Id())
 	params := &wafv2.DeleteRegexPatternSetInput{
@@ -0,0 +1,49 @@
+package wafv2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// validWebACLAssociationResourceARN validates that the value is the ARN of a regional resource
+// that can be associated with a WAFv2 Web ACL: an Application Load Balancer, an API Gateway REST API stage,
+// an AppSync GraphQL API, a Cognito user pool, an App Runner service or a Verified Access instance.
+func validWebACLAssociationResourceARN(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	parsedARN, err := arn.Parse(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+		return
+	}
+
+	var valid bool
+
+	switch parsedARN.Service {
+	case "apigateway":
+		valid = strings.HasPrefix(parsedARN.Resource, "/restapis/")
+	case "appsync":
+		valid = strings.HasPrefix(parsedARN.Resource, "apis/")
+	case "apprunner":
+		valid = strings.HasPrefix(parsedARN.Resource, "service/")
+	case "cognito-idp":
+		valid = strings.HasPrefix(parsedARN.Resource, "userpool/")
+	case "ec2":
+		valid = strings.HasPrefix(parsedARN.Resource, "verified-access-instance/")
+	case "elasticloadbalancing":
+		valid = strings.HasPrefix(parsedARN.Resource, "loadbalancer/app/")
+	}
+
+	if !valid {
+		errors = append(errors, fmt.Errorf("%q (%s) must be the ARN of an Application Load Balancer, API Gateway REST API stage, AppSync GraphQL API, Cognito user pool, App Runner service or Verified Access instance", k, value))
+	}
+
+	return
+}
@@ -0,0 +1,38 @@
+package wafv2
+
+import (
+	"testing"
+)
+
+func TestValidWebACLAssociationResourceARN(t *testing.T) {
+	validARNs := []string{
+		"arn:aws:apigateway:us-west-2::/restapis/a1b2c3d4e5/stages/prod",                                           //lintignore:AWSAT003,AWSAT005
+		"arn:aws:appsync:us-west-2:123456789012:apis/abcdefghijklmnopqrstuvwxyz",                                   //lintignore:AWSAT003,AWSAT005
+		"arn:aws:apprunner:us-west-2:123456789012:service/example/8fe1e10304f84fd2b0df550fe98a71fa",                //lintignore:AWSAT003,AWSAT005
+		"arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_aBCDeFgHi",                                  //lintignore:AWSAT003,AWSAT005
+		"arn:aws:ec2:us-west-2:123456789012:verified-access-instance/vai-0ce000c0b7643abea",                        //lintignore:AWSAT003,AWSAT005
+		"arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/example/50dc6c495c0c9188",            //lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:elasticloadbalancing:us-gov-west-1:123456789012:loadbalancer/app/example/50dc6c495c0c9188", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validARNs {
+		_, errors := validWebACLAssociationResourceARN(v, "resource_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Web ACL association resource ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"",
+		"not-an-arn",
+		"arn:aws:cloudfront::123456789012:distribution/EDFDVBD632BHDS5",                                 //lintignore:AWSAT005
+		"arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/net/example/50dc6c495c0c9188", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:ec2:us-west-2:123456789012:instance/i-1234567890abcdef0",                               //lintignore:AWSAT003,AWSAT005
+		"arn:aws:cognito-idp:us-west-2:123456789012:identitypool/example",                               //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range invalidARNs {
+		_, errors := validWebACLAssociationResourceARN(v, "resource_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Web ACL association resource ARN", v)
+		}
+	}
+}
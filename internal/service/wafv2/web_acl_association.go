@@ -42,7 +42,7 @@ func ResourceWebACLAssociation() *schema.Resource {
 				Type:         schema.TypeString,
 				ForceNew:     true,
 				Required:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: validWebACLAssociationResourceARN,
 			},
 			"web_acl_arn": {
 				Type:         schema.TypeString,
@@ -55,7 +55,7 @@ func ResourceWebACLAssociation() *schema.Resource {
 }
 
 func resourceWebACLAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).WAFV2Conn
+	conn := meta.(*conns.AWSClient).WAFV2Conn()
 	resourceArn := d.Get("resource_arn").(string)
 	webAclArn := d.Get("web_acl_arn").(string)
 	params := &wafv2.AssociateWebACLInput{
@@ -87,7 +87,7 @@ func resourceWebACLAssociationCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceWebACLAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).WAFV2Conn
+	conn := meta.(*conns.AWSClient).WAFV2Conn()
 	resourceArn := d.Get("resource_arn").(string)
 	webAclArn := d.Get("web_acl_arn").(string)
 	params := &wafv2.GetWebACLForResourceInput{
@@ -107,13 +107,17 @@ func resourceWebACLAssociationRead(d *schema.ResourceData, meta interface{}) err
 	if resp == nil || resp.WebACL == nil {
 		log.Printf("[WARN] WAFv2 Web ACL associated resource (%s) not found, removing from state", resourceArn)
 		d.SetId("")
+		return nil
 	}
 
+	// The resource may have been associated with a different Web ACL outside of Terraform.
+	d.Set("web_acl_arn", resp.WebACL.ARN)
+
 	return nil
 }
 
 func resourceWebACLAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).WAFV2Conn
+	conn := meta.(*conns.AWSClient).WAFV2Conn()
 
 	log.Printf("[INFO] Deleting WAFv2 Web ACL Association %s", d.Id())
 
@@ -83,13 +83,97 @@ func TestAccWAFV2WebACLAssociation_disappears(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2WebACLAssociation_appSync(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckScopeRegional(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, wafv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWebACLAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLAssociationAppSyncConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLAssociationExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "resource_arn", "appsync", regexp.MustCompile(`apis/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "web_acl_arn", "aws_wafv2_web_acl.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWebACLAssociationImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccWAFV2WebACLAssociation_cognitoUserPool(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckScopeRegional(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, wafv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWebACLAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLAssociationCognitoUserPoolConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLAssociationExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "resource_arn", "cognito-idp", regexp.MustCompile(`userpool/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "web_acl_arn", "aws_wafv2_web_acl.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWebACLAssociationImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func TestAccWAFV2WebACLAssociation_appRunner(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckScopeRegional(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, wafv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckWebACLAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLAssociationAppRunnerConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebACLAssociationExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "resource_arn", "apprunner", regexp.MustCompile(`service/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "web_acl_arn", "aws_wafv2_web_acl.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWebACLAssociationImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
 func testAccCheckWebACLAssociationDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_wafv2_web_acl_association" {
 			continue
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFV2Conn()
 		resp, err := conn.GetWebACLForResource(&wafv2.GetWebACLForResourceInput{
 			ResourceArn: aws.String(rs.Primary.Attributes["resource_arn"]),
 		})
@@ -186,6 +270,78 @@ resource "aws_wafv2_web_acl_association" "test" {
 `, name, name, name)
 }
 
+func testAccWebACLAssociationWebACLBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name  = %[1]q
+  scope = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, rName)
+}
+
+func testAccWebACLAssociationAppSyncConfig(rName string) string {
+	return acctest.ConfigCompose(testAccWebACLAssociationWebACLBaseConfig(rName), fmt.Sprintf(`
+resource "aws_appsync_graphql_api" "test" {
+  authentication_type = "API_KEY"
+  name                = %[1]q
+}
+
+resource "aws_wafv2_web_acl_association" "test" {
+  resource_arn = aws_appsync_graphql_api.test.arn
+  web_acl_arn  = aws_wafv2_web_acl.test.arn
+}
+`, rName))
+}
+
+func testAccWebACLAssociationCognitoUserPoolConfig(rName string) string {
+	return acctest.ConfigCompose(testAccWebACLAssociationWebACLBaseConfig(rName), fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_wafv2_web_acl_association" "test" {
+  resource_arn = aws_cognito_user_pool.test.arn
+  web_acl_arn  = aws_wafv2_web_acl.test.arn
+}
+`, rName))
+}
+
+func testAccWebACLAssociationAppRunnerConfig(rName string) string {
+	return acctest.ConfigCompose(testAccWebACLAssociationWebACLBaseConfig(rName), fmt.Sprintf(`
+resource "aws_apprunner_service" "test" {
+  service_name = %[1]q
+
+  source_configuration {
+    auto_deployments_enabled = false
+
+    image_repository {
+      image_configuration {
+        port = "80"
+      }
+
+      image_identifier      = "public.ecr.aws/nginx/nginx:latest"
+      image_repository_type = "ECR_PUBLIC"
+    }
+  }
+}
+
+resource "aws_wafv2_web_acl_association" "test" {
+  resource_arn = aws_apprunner_service.test.arn
+  web_acl_arn  = aws_wafv2_web_acl.test.arn
+}
+`, rName))
+}
+
 func testAccWebACLAssociationImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -676,7 +676,7 @@ func TestFlattenWorkspaceCreationProperties(t *testing.T) {
 }
 
 func testAccCheckDirectoryDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_workspaces_directory" {
@@ -710,7 +710,7 @@ func testAccCheckDirectoryExists(n string, v *workspaces.WorkspaceDirectory) res
 			return fmt.Errorf("No WorkSpaces Directory ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn()
 
 		output, err := tfworkspaces.FindDirectoryByID(conn, rs.Primary.ID)
 
@@ -725,7 +725,7 @@ func testAccCheckDirectoryExists(n string, v *workspaces.WorkspaceDirectory) res
 }
 
 func testAccPreCheckWorkspacesDirectory(t *testing.T) {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn
+	conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesConn()
 
 	input := &workspaces.DescribeWorkspaceDirectoriesInput{}
 
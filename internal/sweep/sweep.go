@@ -10,6 +10,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -30,6 +31,14 @@ const (
 
 const defaultSweeperAssumeRoleDurationSeconds = 3600
 
+const (
+	// Default maximum number of resources deleted concurrently by SweepOrchestratorContext
+	defaultSweepConcurrency = 20
+
+	// How often SweepOrchestratorContext logs its progress
+	sweepProgressInterval = 30 * time.Second
+)
+
 // SweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
 var SweeperClients map[string]interface{}
@@ -41,7 +50,7 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 		return client, nil
 	}
 
-	_, _, err := conns.RequireOneOfEnvVar([]string{conns.EnvVarProfile, conns.EnvVarAccessKeyId, conns.EnvVarContainerCredentialsFullUri}, "credentials for running sweepers")
+	_, _, err := conns.RequireOneOfEnvVar([]string{conns.EnvVarProfile, conns.EnvVarAccessKeyId, conns.EnvVarContainerCredentialsFullUri, conns.EnvVarAssumeRoleWithWebIdentityARN}, "credentials for running sweepers")
 	if err != nil {
 		return nil, err
 	}
@@ -58,6 +67,29 @@ func SharedRegionalSweepClient(region string) (interface{}, error) {
 		Region:     region,
 	}
 
+	if role := os.Getenv(conns.EnvVarAssumeRoleWithWebIdentityARN); role != "" {
+		tokenFile, err := conns.RequireEnvVar(conns.EnvVarAssumeRoleWithWebIdentityTokenFile, "web identity token file when using "+conns.EnvVarAssumeRoleWithWebIdentityARN)
+		if err != nil {
+			return nil, err
+		}
+
+		conf.AssumeRoleWithWebIdentityARN = role
+		conf.AssumeRoleWithWebIdentityTokenFile = tokenFile
+
+		conf.AssumeRoleWithWebIdentityDurationSeconds = defaultSweeperAssumeRoleDurationSeconds
+		if v := os.Getenv(conns.EnvVarAssumeRoleWithWebIdentityDuration); v != "" {
+			d, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarAssumeRoleWithWebIdentityDuration, err)
+			}
+			conf.AssumeRoleWithWebIdentityDurationSeconds = d
+		}
+
+		if v := os.Getenv(conns.EnvVarAssumeRoleWithWebIdentitySessionName); v != "" {
+			conf.AssumeRoleWithWebIdentitySessionName = v
+		}
+	}
+
 	if role := os.Getenv(conns.EnvVarAssumeRoleARN); role != "" {
 		conf.AssumeRoleARN = role
 
@@ -108,13 +140,49 @@ func SweepOrchestrator(sweepResources []*SweepResource) error {
 	return SweepOrchestratorContext(context.Background(), sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
 }
 
+// SweepOrchestratorContext deletes the specified resources, at most
+// TF_AWS_SWEEP_CONCURRENCY (default 20) at a time, retrying throttling errors.
+// Progress is logged periodically and a summary is logged on completion.
 func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
+	concurrency, err := sweepConcurrency()
+
+	if err != nil {
+		return err
+	}
+
+	total := len(sweepResources)
+	start := time.Now()
+	var swept, failed int64
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		ticker := time.NewTicker(sweepProgressInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				n := atomic.LoadInt64(&swept)
+				log.Printf("[INFO] Sweeping resources: %d swept (%d failed), %d remaining, %s elapsed", n, atomic.LoadInt64(&failed), int64(total)-n, time.Since(start).Round(time.Second))
+			}
+		}
+	}()
+
 	var g multierror.Group
+	sem := make(chan struct{}, concurrency)
 
 	for _, sweepResource := range sweepResources {
 		sweepResource := sweepResource
 
+		sem <- struct{}{}
+
 		g.Go(func() error {
+			defer func() { <-sem }()
+
 			err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
 				err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 
@@ -134,11 +202,44 @@ func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResour
 				err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 			}
 
+			if err != nil {
+				atomic.AddInt64(&failed, 1)
+			}
+			atomic.AddInt64(&swept, 1)
+
 			return err
 		})
 	}
 
-	return g.Wait().ErrorOrNil()
+	err = g.Wait().ErrorOrNil()
+
+	if total > 0 {
+		elapsed := time.Since(start)
+		log.Printf("[INFO] Swept %d resources (%d failed) in %s (average %s per resource, concurrency %d)", total, failed, elapsed.Round(time.Millisecond), (elapsed / time.Duration(total)).Round(time.Millisecond), concurrency)
+	}
+
+	return err
+}
+
+// sweepConcurrency returns the maximum number of resources to delete concurrently.
+func sweepConcurrency() (int, error) {
+	v := os.Getenv(conns.EnvVarSweepConcurrency)
+
+	if v == "" {
+		return defaultSweepConcurrency, nil
+	}
+
+	n, err := strconv.Atoi(v)
+
+	if err != nil {
+		return 0, fmt.Errorf("environment variable %s: %w", conns.EnvVarSweepConcurrency, err)
+	}
+
+	if n < 1 {
+		return 0, fmt.Errorf("environment variable %s: must be at least 1, got: %d", conns.EnvVarSweepConcurrency, n)
+	}
+
+	return n, nil
 }
 
 // Check sweeper API call error for reasons to skip sweeping
@@ -13,6 +13,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/apigateway"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/apigatewayv2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/appconfig"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/appmesh"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
@@ -78,6 +79,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/qldb"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight"
@@ -91,8 +93,10 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/schemas"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalogappregistry"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ses"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sesv2"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
@@ -488,6 +488,31 @@ func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
 	return result
 }
 
+// ResolveDuplicates returns tags not present in a DefaultConfig object
+// in addition to tags with key/value pairs that override those in a DefaultConfig,
+// like RemoveDefaultConfig, but keeps any tags equivalent to those in the DefaultConfig
+// that are also present with the same value in the given resource-level tags
+// configuration (e.g. d.Get("tags")), preventing a perpetual difference.
+func (tags KeyValueTags) ResolveDuplicates(dc *DefaultConfig, configTags map[string]interface{}) KeyValueTags {
+	result := tags.RemoveDefaultConfig(dc)
+
+	if len(configTags) == 0 {
+		return result
+	}
+
+	for k, v := range New(configTags) {
+		if _, ok := result[k]; ok {
+			continue
+		}
+
+		if tagVal, ok := tags[k]; ok && tagVal.Equal(v) {
+			result[k] = tagVal
+		}
+	}
+
+	return result
+}
+
 // String returns the default string representation of the KeyValueTags.
 func (tags KeyValueTags) String() string {
 	var builder strings.Builder
@@ -2120,6 +2120,134 @@ func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsResolveDuplicates(t *testing.T) {
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		configTags    map[string]interface{}
+		want          map[string]string
+	}{
+		{
+			name: "no config",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: nil,
+			configTags:    nil,
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "no resource tags configured",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			configTags: nil,
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "keys all duplicated",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			configTags: map[string]interface{}{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "keys some duplicated",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			configTags: map[string]interface{}{
+				"key1": "value1",
+				"key3": "value3",
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "keys some overridden",
+			tags: New(map[string]string{
+				"key1": "value2",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			configTags: map[string]interface{}{
+				"key1": "value2",
+			},
+			want: map[string]string{
+				"key1": "value2",
+			},
+		},
+		{
+			name: "configured key not present",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			configTags: map[string]interface{}{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.ResolveDuplicates(testCase.defaultConfig, testCase.configTags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsUrlEncode(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -26,10 +26,6 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 
 	resourceTags := tftags.New(diff.Get("tags").(map[string]interface{}))
 
-	if defaultTagsConfig.TagsEqual(resourceTags) {
-		return fmt.Errorf(`"tags" are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`)
-	}
-
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff